@@ -25,7 +25,8 @@ Most admin operations require account-level permissions.
 Examples:
   canvas accounts list
   canvas accounts get 1
-  canvas accounts sub 1 --recursive`,
+  canvas accounts sub 1 --recursive
+  canvas accounts courses 1 --term "Fall 2026"`,
 }
 
 func init() {
@@ -33,6 +34,7 @@ func init() {
 	accountsCmd.AddCommand(newAccountsListCmd())
 	accountsCmd.AddCommand(newAccountsGetCmd())
 	accountsCmd.AddCommand(newAccountsSubAccountsCmd())
+	accountsCmd.AddCommand(newAccountsCoursesCmd())
 }
 
 func newAccountsListCmd() *cobra.Command {
@@ -141,6 +143,61 @@ Examples:
 	return cmd
 }
 
+func newAccountsCoursesCmd() *cobra.Command {
+	opts := &options.CoursesListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "courses [account-id]",
+		Short: "List courses in an account",
+		Long: `List the courses in an account, optionally limited to one enrollment term.
+
+The term can be given by ID, SIS term ID or name. Terms belong to the root
+account, so a sub-account's term is looked up there.
+
+If the account ID is not specified, uses the default account ID from config.
+
+Examples:
+  canvas accounts courses 1
+  canvas accounts courses 1 --term "Fall 2026"
+  canvas accounts courses --term 2026FA --search Biology`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var accountID int64
+			if len(args) > 0 {
+				id, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid account ID: %s", args[0])
+				}
+				accountID = id
+			}
+
+			accountID, err := resolveAccountID(accountID, "accounts courses")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Term, "term", "", "Filter by enrollment term (ID, SIS term ID or name)")
+	cmd.Flags().StringVar(&opts.SearchTerm, "search", "", "Search by course name or code")
+	cmd.Flags().StringSliceVar(&opts.State, "state", []string{}, "Filter by course state (comma-separated: available, completed, unpublished, deleted)")
+	cmd.Flags().StringSliceVar(&opts.Include, "include", []string{}, "Additional data to include (comma-separated)")
+
+	return cmd
+}
+
 func runAccountsList(ctx context.Context, client *api.Client, opts *options.AccountsListOptions) error {
 	logger := logging.NewCommandLogger(verbose)
 	logger.LogCommandStart(ctx, "accounts.list", map[string]interface{}{
@@ -41,8 +41,9 @@ func newAssignmentsListCmd() *cobra.Command {
 	opts := &options.AssignmentsListOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "list",
-		Short: "List assignments in a course",
+		Use:         "list",
+		Short:       "List assignments in a course",
+		Annotations: streamingAnnotations(),
 		Long: `List all assignments in a Canvas course.
 
 You can filter assignments by search term, bucket, and order.
@@ -316,6 +317,21 @@ func runAssignmentsList(ctx context.Context, client *api.Client, opts *options.A
 		Include:    opts.Include,
 	}
 
+	// Stream assignments page by page for NDJSON output
+	if isStreamingOutput() {
+		count, err := streamNDJSON(assignmentsService.ListIter(ctx, opts.CourseID, apiOpts))
+		if err != nil {
+			logger.LogCommandError(ctx, "assignments.list", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"written":   count,
+			})
+			return fmt.Errorf("failed to list assignments: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "assignments.list", count)
+		return nil
+	}
+
 	assignments, err := assignmentsService.List(ctx, opts.CourseID, apiOpts)
 	if err != nil {
 		logger.LogCommandError(ctx, "assignments.list", err, map[string]interface{}{
@@ -0,0 +1,224 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// auditCmd represents the audit command group
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "View audit logs",
+	Long: `View Canvas audit logs for grade changes and course events.
+
+Events are listed with the names of the linked course, assignment and users,
+so they can be exported with -o csv as an evidence trail. Audit logs require
+the "View grade audit trail" or "View course changes" account permissions.
+
+Examples:
+  canvas audit grades --course-id 123 --student-id 456 -o csv > grades.csv
+  canvas audit grades --assignment-id 789 --start-date 2026-09-01
+  canvas audit courses --course-id 123`,
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.AddCommand(newAuditGradesCmd())
+	auditCmd.AddCommand(newAuditCoursesCmd())
+}
+
+func newAuditGradesCmd() *cobra.Command {
+	opts := &options.AuditGradesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "grades",
+		Short: "List grade change events",
+		Long: `List grade change audit events.
+
+Filter by course, assignment, student or grader. Combining filters returns
+only the events that match all of them, e.g. one student's grade changes in
+one course.
+
+Examples:
+  canvas audit grades --course-id 123
+  canvas audit grades --assignment-id 789 --student-id 456
+  canvas audit grades --grader-id 12 --start-date 2026-09-01 --end-date 2026-10-01
+  canvas audit grades --course-id 123 --student-id 456 -o csv > evidence.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runAuditGrades(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID")
+	cmd.Flags().Int64Var(&opts.StudentID, "student-id", 0, "Student user ID")
+	cmd.Flags().Int64Var(&opts.GraderID, "grader-id", 0, "Grader user ID")
+	cmd.Flags().StringVar(&opts.StartDate, "start-date", "", "Start date (YYYY-MM-DD or ISO 8601)")
+	cmd.Flags().StringVar(&opts.EndDate, "end-date", "", "End date (YYYY-MM-DD or ISO 8601)")
+
+	return cmd
+}
+
+func newAuditCoursesCmd() *cobra.Command {
+	opts := &options.AuditCoursesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "courses",
+		Short: "List course audit events",
+		Long: `List course audit events such as created, updated, published,
+concluded and copied.
+
+Use --course-id for one course. Without it, events for every course in the
+account are listed; if --account-id is not specified, uses the default
+account ID from config.
+
+Examples:
+  canvas audit courses --course-id 123
+  canvas audit courses --account-id 1 --start-date 2026-08-01
+  canvas audit courses --course-id 123 -o csv > course-history.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.CourseID == 0 {
+				accountID, err := resolveAccountID(opts.AccountID, "audit courses")
+				if err != nil {
+					return err
+				}
+				opts.AccountID = accountID
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runAuditCourses(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.StartDate, "start-date", "", "Start date (YYYY-MM-DD or ISO 8601)")
+	cmd.Flags().StringVar(&opts.EndDate, "end-date", "", "End date (YYYY-MM-DD or ISO 8601)")
+
+	return cmd
+}
+
+func runAuditGrades(ctx context.Context, client *api.Client, opts *options.AuditGradesOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "audit.grades", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"assignment_id": opts.AssignmentID,
+		"student_id":    opts.StudentID,
+		"grader_id":     opts.GraderID,
+		"start_date":    opts.StartDate,
+		"end_date":      opts.EndDate,
+	})
+
+	service := api.NewAuditLogsService(client)
+	dateRange := &api.AuditLogOptions{
+		StartTime: opts.StartDate,
+		EndTime:   opts.EndDate,
+	}
+
+	query := &api.GradeChangeQuery{
+		CourseID:     opts.CourseID,
+		AssignmentID: opts.AssignmentID,
+		StudentID:    opts.StudentID,
+		GraderID:     opts.GraderID,
+	}
+
+	var events []api.GradeChangeEvent
+	var err error
+
+	// A single filter uses its dedicated endpoint; combined filters use the query endpoint
+	switch {
+	case countSet(opts.CourseID, opts.AssignmentID, opts.StudentID, opts.GraderID) > 1:
+		events, err = service.QueryGradeChanges(ctx, query, dateRange)
+	case opts.CourseID > 0:
+		events, err = service.ListGradeChangesByCourse(ctx, opts.CourseID, dateRange)
+	case opts.AssignmentID > 0:
+		events, err = service.ListGradeChangesByAssignment(ctx, opts.AssignmentID, dateRange)
+	case opts.StudentID > 0:
+		events, err = service.ListGradeChangesByStudent(ctx, opts.StudentID, dateRange)
+	default:
+		events, err = service.ListGradeChangesByGrader(ctx, opts.GraderID, dateRange)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "audit.grades", err, map[string]interface{}{
+			"course_id":     opts.CourseID,
+			"assignment_id": opts.AssignmentID,
+		})
+		return fmt.Errorf("failed to list grade change events: %w", err)
+	}
+
+	printVerbose("Found %d grade change events:\n\n", len(events))
+
+	logger.LogCommandComplete(ctx, "audit.grades", len(events))
+	return formatEmptyOrOutput(events, "No grade change events found")
+}
+
+func runAuditCourses(ctx context.Context, client *api.Client, opts *options.AuditCoursesOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "audit.courses", map[string]interface{}{
+		"course_id":  opts.CourseID,
+		"account_id": opts.AccountID,
+		"start_date": opts.StartDate,
+		"end_date":   opts.EndDate,
+	})
+
+	service := api.NewAuditLogsService(client)
+	dateRange := &api.AuditLogOptions{
+		StartTime: opts.StartDate,
+		EndTime:   opts.EndDate,
+	}
+
+	var events []api.CourseEvent
+	var err error
+
+	if opts.CourseID > 0 {
+		events, err = service.ListCourseEvents(ctx, opts.CourseID, dateRange)
+	} else {
+		events, err = service.ListAccountCourseEvents(ctx, opts.AccountID, dateRange)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "audit.courses", err, map[string]interface{}{
+			"course_id":  opts.CourseID,
+			"account_id": opts.AccountID,
+		})
+		return fmt.Errorf("failed to list course events: %w", err)
+	}
+
+	printVerbose("Found %d course events:\n\n", len(events))
+
+	logger.LogCommandComplete(ctx, "audit.courses", len(events))
+	return formatEmptyOrOutput(events, "No course events found")
+}
+
+// countSet returns how many of the IDs are set
+func countSet(ids ...int64) int {
+	count := 0
+	for _, id := range ids {
+		if id > 0 {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestAuditGradesCmd(t *testing.T) {
+	oldFormat := outputFormat
+	outputFormat = "csv"
+	defer func() { outputFormat = oldFormat }()
+
+	events := `{"events":[{"id":"e1","created_at":"2026-09-02T10:00:00Z","event_type":"grade_change","grade_before":"C","grade_after":"B","links":{"assignment":5,"course":10,"student":7,"grader":3}}],"linked":{"assignments":[{"id":5,"name":"Essay 1"}],"users":[{"id":7,"name":"Student One"},{"id":3,"name":"Teacher One"}]}}`
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "by course as csv",
+			Args: []string{"--course-id", "10", "--start-date", "2026-09-01"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/audit/grade_change/courses/10": cmdtest.NewMockResponse(events),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				lines := strings.Split(strings.TrimSpace(output), "\n")
+				if len(lines) != 2 {
+					t.Fatalf("Expected header and one row, got: %s", output)
+				}
+				if !strings.Contains(lines[0], "assignment_name") || !strings.Contains(lines[1], "Essay 1") {
+					t.Errorf("Expected flattened event in CSV, got: %s", output)
+				}
+			},
+		},
+		{
+			Name: "combined filters use query endpoint",
+			Args: []string{"--course-id", "10", "--student-id", "7"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/audit/grade_change": cmdtest.NewMockResponse(events),
+			},
+			ExpectOutput: "Student One",
+		},
+		{
+			Name:        "no filter",
+			Args:        []string{"--start-date", "2026-09-01"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newAuditGradesCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestAuditCoursesCmd(t *testing.T) {
+	oldFormat := outputFormat
+	outputFormat = "json"
+	defer func() { outputFormat = oldFormat }()
+
+	tc := cmdtest.CommandTestCase{
+		Name: "by course",
+		Args: []string{"--course-id", "10"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/v1/audit/course/courses/10": cmdtest.NewMockResponse(`{"events":[{"id":"c1","event_type":"published","links":{"course":10,"user":3}}],"linked":{"users":[{"id":3,"name":"Teacher One"}]}}`),
+		},
+		ExpectOutput: "Teacher One",
+	}
+
+	cmdtest.RunCommandTest(t, newAuditCoursesCmd(), tc)
+}
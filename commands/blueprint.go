@@ -204,7 +204,8 @@ func newBlueprintSyncCmd() *cobra.Command {
 Examples:
   canvas blueprint sync --course-id 1
   canvas blueprint sync --course-id 1 --comment "Weekly content update"
-  canvas blueprint sync --course-id 1 --send-notification --copy-settings`,
+  canvas blueprint sync --course-id 1 --send-notification --copy-settings
+  canvas blueprint sync --course-id 1 --wait --timeout 20m`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.NotifySet = cmd.Flags().Changed("send-notification")
 			opts.CopySettingsSet = cmd.Flags().Changed("copy-settings")
@@ -229,6 +230,7 @@ Examples:
 	cmd.Flags().BoolVar(&opts.Notify, "send-notification", false, "Send notification to users")
 	cmd.Flags().BoolVar(&opts.CopySettings, "copy-settings", false, "Copy course settings")
 	cmd.Flags().BoolVar(&opts.Publish, "publish", false, "Publish synced content")
+	addWaitFlags(cmd, &opts.WaitOptions)
 	cmd.MarkFlagRequired("course-id")
 
 	return cmd
@@ -500,6 +502,25 @@ func runBlueprintSync(ctx context.Context, client *api.Client, opts *options.Blu
 
 	fmt.Printf("Blueprint sync started (Migration ID: %d)\n", migration.ID)
 	fmt.Printf("State: %s\n", migration.WorkflowState)
+
+	if opts.Wait {
+		if _, err := waitForJob(ctx, opts.WaitOptions, func(ctx context.Context) (*api.JobProgress, error) {
+			current, err := service.GetMigration(ctx, opts.CourseID, opts.TemplateID, migration.ID, nil)
+			if err != nil {
+				return nil, err
+			}
+			return current.ToProgress(), nil
+		}); err != nil {
+			logger.LogCommandError(ctx, "blueprint.sync", err, map[string]interface{}{
+				"course_id":    opts.CourseID,
+				"migration_id": migration.ID,
+			})
+			return fmt.Errorf("blueprint sync %d did not complete: %w", migration.ID, err)
+		}
+
+		fmt.Println("Blueprint sync completed")
+	}
+
 	logger.LogCommandComplete(ctx, "blueprint.sync", 1)
 	return nil
 }
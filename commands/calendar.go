@@ -25,7 +25,8 @@ Examples:
   canvas calendar list --course-id 123
   canvas calendar list --start-date 2024-01-01 --end-date 2024-12-31
   canvas calendar get 456
-  canvas calendar create --course-id 123 --title "Team Meeting"`,
+  canvas calendar create --course-id 123 --title "Team Meeting"
+  canvas calendar appointments list --scope manageable`,
 }
 
 func init() {
@@ -0,0 +1,804 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// calendarAppointmentsCmd represents the calendar appointments command group
+var calendarAppointmentsCmd = &cobra.Command{
+	Use:     "appointments",
+	Aliases: []string{"appointment-groups", "scheduler"},
+	Short:   "Manage appointment groups",
+	Long: `Manage Scheduler appointment groups, such as office hours, that students
+sign up for.
+
+Time slots are described with a schedule spec:
+
+  <days> <start>-<end> [every <length>] [for <n> weeks]
+
+  days    Mon/Wed, Tue,Thu, Mon-Fri, weekdays or daily
+  length  Slot length such as 15m, 30m or 1h; without it the whole range is one slot
+  weeks   Number of weeks to repeat, starting at --starting (default 1)
+
+Examples:
+  canvas calendar appointments list --scope manageable
+  canvas calendar appointments create --course-id 123 --title "Office Hours" \
+    --schedule "Mon/Wed 14:00-16:00 every 15m for 6 weeks"
+  canvas calendar appointments participants 456`,
+}
+
+func init() {
+	calendarCmd.AddCommand(calendarAppointmentsCmd)
+	calendarAppointmentsCmd.AddCommand(newCalendarAppointmentsListCmd())
+	calendarAppointmentsCmd.AddCommand(newCalendarAppointmentsGetCmd())
+	calendarAppointmentsCmd.AddCommand(newCalendarAppointmentsCreateCmd())
+	calendarAppointmentsCmd.AddCommand(newCalendarAppointmentsUpdateCmd())
+	calendarAppointmentsCmd.AddCommand(newCalendarAppointmentsDeleteCmd())
+	calendarAppointmentsCmd.AddCommand(newCalendarAppointmentsParticipantsCmd())
+	calendarAppointmentsCmd.AddCommand(newCalendarAppointmentsNextCmd())
+}
+
+func newCalendarAppointmentsListCmd() *cobra.Command {
+	opts := &options.CalendarAppointmentsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List appointment groups",
+		Long: `List appointment groups. By default lists the groups you can sign up for;
+use --scope manageable for the groups you can edit.
+
+Examples:
+  canvas calendar appointments list
+  canvas calendar appointments list --scope manageable --course-id 123
+  canvas calendar appointments list --include-past`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCalendarAppointmentsList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Scope, "scope", "", "Scope: reservable (default), manageable")
+	cmd.Flags().Int64SliceVar(&opts.CourseIDs, "course-id", nil, "Only list groups of these courses")
+	cmd.Flags().BoolVar(&opts.IncludePast, "include-past", false, "Include groups whose appointments are all in the past")
+
+	return cmd
+}
+
+func newCalendarAppointmentsGetCmd() *cobra.Command {
+	opts := &options.CalendarAppointmentsGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <appointment-group-id>",
+		Short: "Get an appointment group",
+		Long: `Get an appointment group with its time slots.
+
+Examples:
+  canvas calendar appointments get 456
+  canvas calendar appointments get 456 -o json`,
+		Args: ExactArgsWithUsage(1, "appointment-group-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid appointment group ID: %s", args[0])
+			}
+			opts.GroupID = groupID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCalendarAppointmentsGet(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newCalendarAppointmentsCreateCmd() *cobra.Command {
+	opts := &options.CalendarAppointmentsCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an appointment group",
+		Long: `Create an appointment group with time slots generated from one or more
+schedule specs. Use --dry-run to list the slots without creating anything.
+
+Slot times are in --time-zone (an IANA name such as America/New_York),
+defaulting to the local time zone.
+
+Examples:
+  canvas calendar appointments create --course-id 123 --title "Office Hours" \
+    --schedule "Mon/Wed 14:00-16:00 every 15m for 6 weeks" --publish
+  canvas calendar appointments create --course-id 123 --title "Project Check-in" \
+    --schedule "Tue 09:00-12:00 every 30m" --schedule "Thu 13:00-15:00 every 30m" \
+    --starting 2026-11-02 --max-per-user 1 --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			slots, err := buildAppointmentSlots(opts.Schedules, opts.Starting, opts.TimeZone, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if opts.DryRun {
+				printAppointmentSlots(slots)
+				return nil
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCalendarAppointmentsCreate(cmd.Context(), client, opts, slots)
+		},
+	}
+
+	cmd.Flags().Int64SliceVar(&opts.CourseIDs, "course-id", nil, "Course ID (required, repeatable)")
+	cmd.Flags().Int64SliceVar(&opts.SectionIDs, "section-id", nil, "Limit sign-up to these sections")
+	cmd.Flags().StringVar(&opts.Title, "title", "", "Appointment group title (required)")
+	cmd.Flags().StringVar(&opts.Description, "description", "", "Description")
+	cmd.Flags().StringVar(&opts.LocationName, "location", "", "Location name")
+	cmd.Flags().StringVar(&opts.LocationAddress, "address", "", "Location address")
+	cmd.Flags().StringArrayVar(&opts.Schedules, "schedule", nil, `Schedule spec, e.g. "Mon/Wed 14:00-16:00 every 15m for 6 weeks" (repeatable)`)
+	cmd.Flags().StringVar(&opts.Starting, "starting", "", "First date of the schedule (YYYY-MM-DD, default today)")
+	cmd.Flags().StringVar(&opts.TimeZone, "time-zone", "", "Time zone of the schedule (default local)")
+	cmd.Flags().IntVar(&opts.ParticipantsPerAppointment, "participants-per-slot", 0, "Maximum participants per slot")
+	cmd.Flags().IntVar(&opts.MaxAppointmentsPerUser, "max-per-user", 0, "Maximum slots a participant can sign up for")
+	cmd.Flags().StringVar(&opts.Visibility, "visibility", "", "Participant visibility: private, protected")
+	cmd.Flags().BoolVar(&opts.Publish, "publish", false, "Publish the group so participants can sign up")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "List the slots without creating the group")
+
+	return cmd
+}
+
+func newCalendarAppointmentsUpdateCmd() *cobra.Command {
+	opts := &options.CalendarAppointmentsUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <appointment-group-id>",
+		Short: "Update an appointment group",
+		Long: `Update an appointment group. Slots from --schedule are added to the
+existing ones.
+
+Examples:
+  canvas calendar appointments update 456 --title "Office Hours (Room 12)" --location "Room 12"
+  canvas calendar appointments update 456 --schedule "Fri 10:00-11:00 every 15m" --starting 2026-11-06
+  canvas calendar appointments update 456 --publish`,
+		Args: ExactArgsWithUsage(1, "appointment-group-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid appointment group ID: %s", args[0])
+			}
+			opts.GroupID = groupID
+
+			// Track which fields were set
+			opts.TitleSet = cmd.Flags().Changed("title")
+			opts.DescriptionSet = cmd.Flags().Changed("description")
+			opts.LocationNameSet = cmd.Flags().Changed("location")
+			opts.LocationAddressSet = cmd.Flags().Changed("address")
+			opts.ParticipantsPerAppointmentSet = cmd.Flags().Changed("participants-per-slot")
+			opts.MaxAppointmentsPerUserSet = cmd.Flags().Changed("max-per-user")
+			opts.VisibilitySet = cmd.Flags().Changed("visibility")
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			var slots []api.AppointmentSlot
+			if len(opts.Schedules) > 0 {
+				slots, err = buildAppointmentSlots(opts.Schedules, opts.Starting, opts.TimeZone, time.Now())
+				if err != nil {
+					return err
+				}
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCalendarAppointmentsUpdate(cmd.Context(), client, opts, slots)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Title, "title", "", "New title")
+	cmd.Flags().StringVar(&opts.Description, "description", "", "New description")
+	cmd.Flags().StringVar(&opts.LocationName, "location", "", "New location name")
+	cmd.Flags().StringVar(&opts.LocationAddress, "address", "", "New location address")
+	cmd.Flags().StringArrayVar(&opts.Schedules, "schedule", nil, "Schedule spec of slots to add (repeatable)")
+	cmd.Flags().StringVar(&opts.Starting, "starting", "", "First date of the added schedule (YYYY-MM-DD, default today)")
+	cmd.Flags().StringVar(&opts.TimeZone, "time-zone", "", "Time zone of the added schedule (default local)")
+	cmd.Flags().IntVar(&opts.ParticipantsPerAppointment, "participants-per-slot", 0, "Maximum participants per slot")
+	cmd.Flags().IntVar(&opts.MaxAppointmentsPerUser, "max-per-user", 0, "Maximum slots a participant can sign up for")
+	cmd.Flags().StringVar(&opts.Visibility, "visibility", "", "Participant visibility: private, protected")
+	cmd.Flags().BoolVar(&opts.Publish, "publish", false, "Publish the group")
+
+	return cmd
+}
+
+func newCalendarAppointmentsDeleteCmd() *cobra.Command {
+	opts := &options.CalendarAppointmentsDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <appointment-group-id>",
+		Short: "Delete an appointment group",
+		Long: `Delete an appointment group. Existing reservations are cancelled and
+participants are notified with the reason.
+
+Examples:
+  canvas calendar appointments delete 456
+  canvas calendar appointments delete 456 --reason "Office hours moved" --force`,
+		Args: ExactArgsWithUsage(1, "appointment-group-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid appointment group ID: %s", args[0])
+			}
+			opts.GroupID = groupID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCalendarAppointmentsDelete(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.CancelReason, "reason", "", "Cancellation reason sent to participants")
+	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newCalendarAppointmentsParticipantsCmd() *cobra.Command {
+	opts := &options.CalendarAppointmentsParticipantsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "participants <appointment-group-id>",
+		Short: "List appointment group participants",
+		Long: `List the users, or student groups with --groups, that can sign up for an
+appointment group.
+
+Examples:
+  canvas calendar appointments participants 456
+  canvas calendar appointments participants 456 --registration-status registered
+  canvas calendar appointments participants 456 --groups`,
+		Args: ExactArgsWithUsage(1, "appointment-group-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid appointment group ID: %s", args[0])
+			}
+			opts.GroupID = groupID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCalendarAppointmentsParticipants(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.RegistrationStatus, "registration-status", "", "Filter: all (default), registered")
+	cmd.Flags().BoolVar(&opts.Groups, "groups", false, "List student groups instead of users")
+
+	return cmd
+}
+
+func newCalendarAppointmentsNextCmd() *cobra.Command {
+	opts := &options.CalendarAppointmentsNextOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "next [appointment-group-id...]",
+		Short: "Show your next appointment",
+		Long: `Show your next reserved appointment, optionally limited to some
+appointment groups.
+
+Examples:
+  canvas calendar appointments next
+  canvas calendar appointments next 456 457`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, arg := range args {
+				groupID, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid appointment group ID: %s", arg)
+				}
+				opts.GroupIDs = append(opts.GroupIDs, groupID)
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCalendarAppointmentsNext(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func runCalendarAppointmentsList(ctx context.Context, client *api.Client, opts *options.CalendarAppointmentsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "calendar.appointments.list", map[string]interface{}{
+		"scope":        opts.Scope,
+		"course_ids":   opts.CourseIDs,
+		"include_past": opts.IncludePast,
+	})
+
+	contextCodes := make([]string, len(opts.CourseIDs))
+	for i, courseID := range opts.CourseIDs {
+		contextCodes[i] = fmt.Sprintf("course_%d", courseID)
+	}
+
+	service := api.NewAppointmentGroupsService(client)
+
+	groups, err := service.List(ctx, &api.ListAppointmentGroupsOptions{
+		Scope:        opts.Scope,
+		ContextCodes: contextCodes,
+		IncludePast:  opts.IncludePast,
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.list", err, map[string]interface{}{
+			"scope": opts.Scope,
+		})
+		return fmt.Errorf("failed to list appointment groups: %w", err)
+	}
+
+	printVerbose("Found %d appointment groups:\n\n", len(groups))
+
+	logger.LogCommandComplete(ctx, "calendar.appointments.list", len(groups))
+	return formatEmptyOrOutput(groups, "No appointment groups found")
+}
+
+func runCalendarAppointmentsGet(ctx context.Context, client *api.Client, opts *options.CalendarAppointmentsGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "calendar.appointments.get", map[string]interface{}{
+		"appointment_group_id": opts.GroupID,
+	})
+
+	service := api.NewAppointmentGroupsService(client)
+
+	group, err := service.Get(ctx, opts.GroupID, []string{"appointments"})
+	if err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.get", err, map[string]interface{}{
+			"appointment_group_id": opts.GroupID,
+		})
+		return fmt.Errorf("failed to get appointment group: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "calendar.appointments.get", 1)
+	return formatOutput(group, nil)
+}
+
+func runCalendarAppointmentsCreate(ctx context.Context, client *api.Client, opts *options.CalendarAppointmentsCreateOptions, slots []api.AppointmentSlot) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "calendar.appointments.create", map[string]interface{}{
+		"course_ids": opts.CourseIDs,
+		"title":      opts.Title,
+		"slots":      len(slots),
+	})
+
+	params := &api.CreateAppointmentGroupParams{
+		Title:                         opts.Title,
+		Description:                   opts.Description,
+		LocationName:                  opts.LocationName,
+		LocationAddress:               opts.LocationAddress,
+		Publish:                       opts.Publish,
+		ParticipantsPerAppointment:    opts.ParticipantsPerAppointment,
+		MaxAppointmentsPerParticipant: opts.MaxAppointmentsPerUser,
+		ParticipantVisibility:         opts.Visibility,
+		Slots:                         slots,
+	}
+	for _, courseID := range opts.CourseIDs {
+		params.ContextCodes = append(params.ContextCodes, fmt.Sprintf("course_%d", courseID))
+	}
+	for _, sectionID := range opts.SectionIDs {
+		params.SubContextCodes = append(params.SubContextCodes, fmt.Sprintf("course_section_%d", sectionID))
+	}
+
+	service := api.NewAppointmentGroupsService(client)
+
+	group, err := service.Create(ctx, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.create", err, map[string]interface{}{
+			"course_ids": opts.CourseIDs,
+		})
+		return fmt.Errorf("failed to create appointment group: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "calendar.appointments.create", 1)
+	return formatSuccessOutput(group, fmt.Sprintf("Appointment group created successfully (ID: %d) with %d slots", group.ID, len(slots)))
+}
+
+func runCalendarAppointmentsUpdate(ctx context.Context, client *api.Client, opts *options.CalendarAppointmentsUpdateOptions, slots []api.AppointmentSlot) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "calendar.appointments.update", map[string]interface{}{
+		"appointment_group_id": opts.GroupID,
+		"new_slots":            len(slots),
+	})
+
+	params := &api.UpdateAppointmentGroupParams{
+		Publish: opts.Publish,
+		Slots:   slots,
+	}
+	if opts.TitleSet {
+		params.Title = &opts.Title
+	}
+	if opts.DescriptionSet {
+		params.Description = &opts.Description
+	}
+	if opts.LocationNameSet {
+		params.LocationName = &opts.LocationName
+	}
+	if opts.LocationAddressSet {
+		params.LocationAddress = &opts.LocationAddress
+	}
+	if opts.ParticipantsPerAppointmentSet {
+		params.ParticipantsPerAppointment = &opts.ParticipantsPerAppointment
+	}
+	if opts.MaxAppointmentsPerUserSet {
+		params.MaxAppointmentsPerParticipant = &opts.MaxAppointmentsPerUser
+	}
+	if opts.VisibilitySet {
+		params.ParticipantVisibility = &opts.Visibility
+	}
+
+	service := api.NewAppointmentGroupsService(client)
+
+	group, err := service.Update(ctx, opts.GroupID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.update", err, map[string]interface{}{
+			"appointment_group_id": opts.GroupID,
+		})
+		return fmt.Errorf("failed to update appointment group: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "calendar.appointments.update", 1)
+	return formatSuccessOutput(group, fmt.Sprintf("Appointment group updated successfully (ID: %d)", group.ID))
+}
+
+func runCalendarAppointmentsDelete(ctx context.Context, client *api.Client, opts *options.CalendarAppointmentsDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "calendar.appointments.delete", map[string]interface{}{
+		"appointment_group_id": opts.GroupID,
+		"force":                opts.Force,
+	})
+
+	confirmed, err := confirmDelete("appointment group", opts.GroupID, opts.Force)
+	if err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.delete", err, map[string]interface{}{})
+		return err
+	}
+	if !confirmed {
+		logger.LogCommandComplete(ctx, "calendar.appointments.delete", 0)
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	service := api.NewAppointmentGroupsService(client)
+
+	if _, err := service.Delete(ctx, opts.GroupID, opts.CancelReason); err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.delete", err, map[string]interface{}{
+			"appointment_group_id": opts.GroupID,
+		})
+		return fmt.Errorf("failed to delete appointment group: %w", err)
+	}
+
+	fmt.Printf("Appointment group %d deleted\n", opts.GroupID)
+	logger.LogCommandComplete(ctx, "calendar.appointments.delete", 1)
+	return nil
+}
+
+func runCalendarAppointmentsParticipants(ctx context.Context, client *api.Client, opts *options.CalendarAppointmentsParticipantsOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "calendar.appointments.participants", map[string]interface{}{
+		"appointment_group_id": opts.GroupID,
+		"registration_status":  opts.RegistrationStatus,
+		"groups":               opts.Groups,
+	})
+
+	service := api.NewAppointmentGroupsService(client)
+
+	if opts.Groups {
+		groups, err := service.ListGroups(ctx, opts.GroupID, opts.RegistrationStatus)
+		if err != nil {
+			logger.LogCommandError(ctx, "calendar.appointments.participants", err, map[string]interface{}{
+				"appointment_group_id": opts.GroupID,
+			})
+			return fmt.Errorf("failed to list appointment group participants: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "calendar.appointments.participants", len(groups))
+		return formatEmptyOrOutput(groups, "No participant groups found")
+	}
+
+	users, err := service.ListUsers(ctx, opts.GroupID, opts.RegistrationStatus)
+	if err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.participants", err, map[string]interface{}{
+			"appointment_group_id": opts.GroupID,
+		})
+		return fmt.Errorf("failed to list appointment group participants: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "calendar.appointments.participants", len(users))
+	return formatEmptyOrOutput(users, "No participants found")
+}
+
+func runCalendarAppointmentsNext(ctx context.Context, client *api.Client, opts *options.CalendarAppointmentsNextOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "calendar.appointments.next", map[string]interface{}{
+		"appointment_group_ids": opts.GroupIDs,
+	})
+
+	service := api.NewAppointmentGroupsService(client)
+
+	events, err := service.NextAppointment(ctx, opts.GroupIDs)
+	if err != nil {
+		logger.LogCommandError(ctx, "calendar.appointments.next", err, map[string]interface{}{})
+		return fmt.Errorf("failed to get next appointment: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "calendar.appointments.next", len(events))
+	return formatEmptyOrOutput(events, "No upcoming appointments")
+}
+
+// appointmentSchedule is a parsed schedule spec such as
+// "Mon/Wed 14:00-16:00 every 15m for 6 weeks"
+type appointmentSchedule struct {
+	Days  []time.Weekday
+	Start int // Minutes after midnight
+	End   int
+	Every int // Slot length in minutes; 0 means one slot for the whole range
+	Weeks int
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// parseAppointmentSchedule parses a schedule spec
+func parseAppointmentSchedule(spec string) (*appointmentSchedule, error) {
+	fields := strings.Fields(strings.ToLower(spec))
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid schedule %q: expected <days> <start>-<end> [every <length>] [for <n> weeks]", spec)
+	}
+
+	days, err := parseScheduleDays(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+
+	schedule := &appointmentSchedule{Days: days, Weeks: 1}
+
+	startText, endText, ok := strings.Cut(fields[1], "-")
+	if !ok {
+		return nil, fmt.Errorf("invalid schedule %q: time range must be <start>-<end>, e.g. 14:00-16:00", spec)
+	}
+	if schedule.Start, err = parseClock(startText); err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+	if schedule.End, err = parseClock(endText); err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+	if schedule.End <= schedule.Start {
+		return nil, fmt.Errorf("invalid schedule %q: end time must be after start time", spec)
+	}
+
+	rest := fields[2:]
+	for len(rest) > 0 {
+		switch {
+		case rest[0] == "every" && len(rest) >= 2:
+			length, err := time.ParseDuration(rest[1])
+			if err != nil || length < time.Minute || length%time.Minute != 0 {
+				return nil, fmt.Errorf("invalid schedule %q: slot length %q must be whole minutes, e.g. 15m or 1h", spec, rest[1])
+			}
+			schedule.Every = int(length / time.Minute)
+			rest = rest[2:]
+		case rest[0] == "for" && len(rest) >= 3 && (rest[2] == "week" || rest[2] == "weeks"):
+			weeks, err := strconv.Atoi(rest[1])
+			if err != nil || weeks < 1 {
+				return nil, fmt.Errorf("invalid schedule %q: number of weeks %q must be a positive integer", spec, rest[1])
+			}
+			schedule.Weeks = weeks
+			rest = rest[3:]
+		default:
+			return nil, fmt.Errorf("invalid schedule %q: unexpected %q", spec, strings.Join(rest, " "))
+		}
+	}
+
+	if schedule.Every > schedule.End-schedule.Start {
+		return nil, fmt.Errorf("invalid schedule %q: slot length is longer than the time range", spec)
+	}
+
+	return schedule, nil
+}
+
+// parseScheduleDays parses days such as "mon/wed", "tue,thu", "mon-fri",
+// "weekdays" or "daily"
+func parseScheduleDays(text string) ([]time.Weekday, error) {
+	switch text {
+	case "daily":
+		return []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}, nil
+	case "weekdays":
+		return []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, nil
+	}
+
+	var days []time.Weekday
+	for _, part := range strings.FieldsFunc(text, func(r rune) bool { return r == '/' || r == ',' }) {
+		if from, to, ok := strings.Cut(part, "-"); ok {
+			first, err := parseWeekday(from)
+			if err != nil {
+				return nil, err
+			}
+			last, err := parseWeekday(to)
+			if err != nil {
+				return nil, err
+			}
+			for day := first; ; day = (day + 1) % 7 {
+				days = append(days, day)
+				if day == last {
+					break
+				}
+			}
+			continue
+		}
+
+		day, err := parseWeekday(part)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+
+	if len(days) == 0 {
+		return nil, fmt.Errorf("no days given")
+	}
+
+	slices.Sort(days)
+	return slices.Compact(days), nil
+}
+
+// parseWeekday parses a day name or an abbreviation of at least three letters
+func parseWeekday(text string) (time.Weekday, error) {
+	if len(text) >= 3 {
+		for name, day := range weekdayNames {
+			if strings.HasPrefix(name, text) {
+				return day, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unknown day %q", text)
+}
+
+// parseClock parses HH:MM into minutes after midnight
+func parseClock(text string) (int, error) {
+	t, err := time.Parse("15:04", text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: expected HH:MM", text)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// slots returns the time slots of the schedule from the first day on
+func (s *appointmentSchedule) slots(first time.Time) []api.AppointmentSlot {
+	length := s.Every
+	if length == 0 {
+		length = s.End - s.Start
+	}
+
+	var slots []api.AppointmentSlot
+	for offset := 0; offset < s.Weeks*7; offset++ {
+		day := first.AddDate(0, 0, offset)
+		if !slices.Contains(s.Days, day.Weekday()) {
+			continue
+		}
+
+		for start := s.Start; start+length <= s.End; start += length {
+			// time.Date normalizes minutes past 59, keeping wall-clock times across DST changes
+			slots = append(slots, api.AppointmentSlot{
+				StartAt: time.Date(day.Year(), day.Month(), day.Day(), 0, start, 0, 0, day.Location()),
+				EndAt:   time.Date(day.Year(), day.Month(), day.Day(), 0, start+length, 0, 0, day.Location()),
+			})
+		}
+	}
+
+	return slots
+}
+
+// buildAppointmentSlots turns schedule specs into time slots starting on the
+// given date (default today) in the given time zone (default local)
+func buildAppointmentSlots(specs []string, starting, timeZone string, now time.Time) ([]api.AppointmentSlot, error) {
+	loc := time.Local
+	if timeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+		}
+	}
+
+	now = now.In(loc)
+	first := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if starting != "" {
+		var err error
+		first, err = time.ParseInLocation("2006-01-02", starting, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date %q: expected YYYY-MM-DD", starting)
+		}
+	}
+
+	var slots []api.AppointmentSlot
+	for _, spec := range specs {
+		schedule, err := parseAppointmentSchedule(spec)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, schedule.slots(first)...)
+	}
+
+	if len(slots) == 0 {
+		return nil, fmt.Errorf("schedule produced no time slots")
+	}
+
+	slices.SortFunc(slots, func(a, b api.AppointmentSlot) int {
+		return a.StartAt.Compare(b.StartAt)
+	})
+
+	return slots, nil
+}
+
+func printAppointmentSlots(slots []api.AppointmentSlot) {
+	fmt.Printf("DRY RUN - %d slots would be created:\n", len(slots))
+	for _, slot := range slots {
+		fmt.Printf("  %s %s-%s\n", slot.StartAt.Format("Mon 2006-01-02"), slot.StartAt.Format("15:04"), slot.EndAt.Format("15:04 MST"))
+	}
+}
@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestParseAppointmentSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		wantDays  []time.Weekday
+		wantEvery int
+		wantWeeks int
+		wantErr   bool
+	}{
+		{
+			name:      "full spec",
+			spec:      "Mon/Wed 14:00-16:00 every 15m for 6 weeks",
+			wantDays:  []time.Weekday{time.Monday, time.Wednesday},
+			wantEvery: 15,
+			wantWeeks: 6,
+		},
+		{
+			name:      "day range and single week",
+			spec:      "mon-fri 09:00-10:00 every 1h",
+			wantDays:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			wantEvery: 60,
+			wantWeeks: 1,
+		},
+		{
+			name:      "full day names without slots",
+			spec:      "Tuesday,Thursday 13:00-15:00 for 1 week",
+			wantDays:  []time.Weekday{time.Tuesday, time.Thursday},
+			wantWeeks: 1,
+		},
+		{name: "unknown day", spec: "Mo 14:00-16:00", wantErr: true},
+		{name: "end before start", spec: "Mon 16:00-14:00", wantErr: true},
+		{name: "slot longer than range", spec: "Mon 14:00-14:30 every 1h", wantErr: true},
+		{name: "bad weeks", spec: "Mon 14:00-16:00 for zero weeks", wantErr: true},
+		{name: "trailing text", spec: "Mon 14:00-16:00 biweekly", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := parseAppointmentSchedule(tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.spec)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(schedule.Days) != len(tt.wantDays) {
+				t.Fatalf("Expected days %v, got %v", tt.wantDays, schedule.Days)
+			}
+			for i := range tt.wantDays {
+				if schedule.Days[i] != tt.wantDays[i] {
+					t.Errorf("Expected days %v, got %v", tt.wantDays, schedule.Days)
+				}
+			}
+			if schedule.Every != tt.wantEvery || schedule.Weeks != tt.wantWeeks {
+				t.Errorf("Expected every %d for %d weeks, got every %d for %d weeks", tt.wantEvery, tt.wantWeeks, schedule.Every, schedule.Weeks)
+			}
+		})
+	}
+}
+
+func TestBuildAppointmentSlots(t *testing.T) {
+	slots, err := buildAppointmentSlots([]string{"Mon/Wed 14:00-16:00 every 15m for 2 weeks"}, "2026-10-26", "America/New_York", time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 2 days a week for 2 weeks, 8 slots a day
+	if len(slots) != 32 {
+		t.Fatalf("Expected 32 slots, got %d", len(slots))
+	}
+
+	first := slots[0]
+	if got := first.StartAt.Format(time.RFC3339); got != "2026-10-26T14:00:00-04:00" {
+		t.Errorf("Unexpected first slot start: %s", got)
+	}
+	if got := first.EndAt.Format(time.RFC3339); got != "2026-10-26T14:15:00-04:00" {
+		t.Errorf("Unexpected first slot end: %s", got)
+	}
+
+	// The second week is after the end of daylight saving time but keeps the wall-clock times
+	last := slots[len(slots)-1]
+	if got := last.StartAt.Format(time.RFC3339); got != "2026-11-04T15:45:00-05:00" {
+		t.Errorf("Unexpected last slot start: %s", got)
+	}
+
+	if _, err := buildAppointmentSlots([]string{"Sat 10:00-11:00"}, "2026-10-19", "UTC", time.Now()); err != nil {
+		t.Errorf("Expected Saturday in the first week to be included: %v", err)
+	}
+	if _, err := buildAppointmentSlots([]string{"Mon 10:00-11:00"}, "19/10/2026", "", time.Now()); err == nil {
+		t.Error("Expected error for invalid start date")
+	}
+}
+
+func TestCalendarAppointmentsCreateCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "create with schedule",
+			Args: []string{"--course-id", "123", "--title", "Office Hours", "--schedule", "Mon 14:00-15:00 every 30m", "--starting", "2026-10-19"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/appointment_groups": cmdtest.NewMockResponse(`{"id":456,"title":"Office Hours","workflow_state":"pending"}`),
+			},
+			ExpectOutput: "Appointment group created successfully (ID: 456) with 2 slots",
+		},
+		{
+			Name: "dry run",
+			Args: []string{"--course-id", "123", "--title", "Office Hours", "--schedule", "Mon/Wed 14:00-16:00 every 15m for 6 weeks", "--starting", "2026-10-19", "--time-zone", "UTC", "--dry-run"},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "96 slots would be created") {
+					t.Errorf("Expected slot count, got: %s", output)
+				}
+				if !strings.Contains(output, "Mon 2026-10-19 14:00-14:15 UTC") {
+					t.Errorf("Expected first slot, got: %s", output)
+				}
+			},
+		},
+		{
+			Name:        "invalid schedule",
+			Args:        []string{"--course-id", "123", "--title", "Office Hours", "--schedule", "sometimes"},
+			ExpectError: true,
+		},
+		{
+			Name:        "missing course",
+			Args:        []string{"--title", "Office Hours", "--schedule", "Mon 14:00-15:00"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newCalendarAppointmentsCreateCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
@@ -123,7 +123,8 @@ Migration types:
 
 Examples:
   canvas content-migrations create --course-id 1 --type course_copy_importer --source-course-id 100
-  canvas content-migrations create --course-id 1 --type common_cartridge_importer --file export.imscc`,
+  canvas content-migrations create --course-id 1 --type common_cartridge_importer --file export.imscc
+  canvas content-migrations create --course-id 1 --type course_copy_importer --source-course-id 100 --wait`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Track which fields were set
 			opts.SourceCourseIDSet = cmd.Flags().Changed("source-course-id")
@@ -152,6 +153,7 @@ Examples:
 	cmd.Flags().BoolVar(&opts.Selective, "selective", false, "Enable selective import")
 	cmd.Flags().StringVar(&opts.CopyOptions, "copy-options", "", "JSON with copy options")
 	cmd.Flags().StringVar(&opts.DateShift, "date-shift", "", "JSON with date shift options")
+	addWaitFlags(cmd, &opts.WaitOptions)
 	cmd.MarkFlagRequired("course-id")
 	cmd.MarkFlagRequired("type")
 
@@ -386,6 +388,26 @@ func runContentMigrationsCreate(ctx context.Context, client *api.Client, opts *o
 	fmt.Printf("Content migration created (ID: %d)\n", migration.ID)
 	fmt.Printf("Type: %s\n", migration.MigrationType)
 	fmt.Printf("State: %s\n", migration.WorkflowState)
+
+	if opts.Wait {
+		if migration.ProgressURL == "" {
+			return fmt.Errorf("content migration %d did not return a progress URL to wait on", migration.ID)
+		}
+
+		progressService := api.NewProgressService(client)
+		if _, err := waitForJob(ctx, opts.WaitOptions, func(ctx context.Context) (*api.JobProgress, error) {
+			return progressService.GetByURL(ctx, migration.ProgressURL)
+		}); err != nil {
+			logger.LogCommandError(ctx, "content_migrations.create", err, map[string]interface{}{
+				"course_id":    opts.CourseID,
+				"migration_id": migration.ID,
+			})
+			return fmt.Errorf("content migration %d did not complete: %w", migration.ID, err)
+		}
+
+		fmt.Println("Content migration completed")
+	}
+
 	logger.LogCommandComplete(ctx, "content_migrations.create", 1)
 	return nil
 }
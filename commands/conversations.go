@@ -41,6 +41,7 @@ func init() {
 	conversationsCmd.AddCommand(newConversationsUnstarCmd())
 	conversationsCmd.AddCommand(newConversationsMarkReadCmd())
 	conversationsCmd.AddCommand(newConversationsMarkAllReadCmd())
+	conversationsCmd.AddCommand(newConversationsBatchUpdateCmd())
 	conversationsCmd.AddCommand(newConversationsDeleteCmd())
 	conversationsCmd.AddCommand(newConversationsUnreadCountCmd())
 }
@@ -404,6 +405,43 @@ Examples:
 	return cmd
 }
 
+func newConversationsBatchUpdateCmd() *cobra.Command {
+	opts := &options.ConversationsBatchUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "batch-update",
+		Short: "Update multiple conversations at once",
+		Long: `Apply an event to multiple conversations at once.
+
+Canvas processes batch updates asynchronously. Use --wait to block
+until the job finishes.
+
+Events: mark_as_read, mark_as_unread, star, unstar, archive, destroy
+
+Examples:
+  canvas conversations batch-update --ids 1,2,3 --event archive
+  canvas conversations batch-update --ids 1,2,3 --event mark_as_read --wait`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+			return runConversationsBatchUpdate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64SliceVar(&opts.ConversationIDs, "ids", nil, "Conversation IDs (required)")
+	cmd.Flags().StringVar(&opts.Event, "event", "", "Event to apply (required)")
+	addWaitFlags(cmd, &opts.WaitOptions)
+	cmd.MarkFlagRequired("ids")
+	cmd.MarkFlagRequired("event")
+
+	return cmd
+}
+
 func newConversationsDeleteCmd() *cobra.Command {
 	opts := &options.ConversationsDeleteOptions{}
 
@@ -747,6 +785,47 @@ func runConversationsMarkAllRead(ctx context.Context, client *api.Client, opts *
 	return nil
 }
 
+func runConversationsBatchUpdate(ctx context.Context, client *api.Client, opts *options.ConversationsBatchUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "conversations.batch_update", map[string]interface{}{
+		"count": len(opts.ConversationIDs),
+		"event": opts.Event,
+	})
+
+	service := api.NewConversationsService(client)
+
+	progress, err := service.BatchUpdate(ctx, &api.BatchUpdateParams{
+		ConversationIDs: opts.ConversationIDs,
+		Event:           opts.Event,
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "conversations.batch_update", err, map[string]interface{}{
+			"event": opts.Event,
+		})
+		return fmt.Errorf("failed to batch update conversations: %w", err)
+	}
+
+	fmt.Printf("Batch update started (Progress ID: %d)\n", progress.ID)
+	fmt.Printf("Workflow state: %s\n", progress.WorkflowState)
+
+	if opts.Wait {
+		progressService := api.NewProgressService(client)
+		if _, err := waitForJob(ctx, opts.WaitOptions, func(ctx context.Context) (*api.JobProgress, error) {
+			return progressService.Get(ctx, progress.ID)
+		}); err != nil {
+			logger.LogCommandError(ctx, "conversations.batch_update", err, map[string]interface{}{
+				"progress_id": progress.ID,
+			})
+			return fmt.Errorf("batch update did not complete: %w", err)
+		}
+
+		fmt.Printf("Batch update completed (%d conversations)\n", len(opts.ConversationIDs))
+	}
+
+	logger.LogCommandComplete(ctx, "conversations.batch_update", len(opts.ConversationIDs))
+	return nil
+}
+
 func runConversationsDelete(ctx context.Context, client *api.Client, opts *options.ConversationsDeleteOptions) error {
 	logger := logging.NewCommandLogger(verbose)
 	logger.LogCommandStart(ctx, "conversations.delete", map[string]interface{}{
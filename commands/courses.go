@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/spf13/cobra"
 
@@ -29,8 +30,9 @@ func newCoursesListCmd() *cobra.Command {
 	opts := &options.CoursesListOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "list",
-		Short: "List courses",
+		Use:         "list",
+		Short:       "List courses",
+		Annotations: streamingAnnotations(),
 		Long: `List courses for the authenticated user or for an account (admin).
 
 By default, lists courses you are enrolled in. Use --account-id to list all courses
@@ -44,6 +46,7 @@ Account context (admin):
   canvas courses list --account-id 1        # All courses in account 1
   canvas courses list --account-id 1 --search "Biology"
   canvas courses list --account-id 1 --sort course_name --order asc
+  canvas courses list --account-id 1 --term "Fall 2026"
 
 Examples:
   canvas courses list
@@ -71,6 +74,7 @@ Examples:
 	cmd.Flags().StringVar(&opts.EnrollmentState, "enrollment-state", "", "Filter by enrollment state (active, invited_or_pending, completed)")
 	cmd.Flags().StringSliceVar(&opts.Include, "include", []string{}, "Additional data to include (comma-separated)")
 	cmd.Flags().StringSliceVar(&opts.State, "state", []string{}, "Filter by course state (comma-separated: available, completed, unpublished, deleted)")
+	cmd.Flags().StringVar(&opts.Term, "term", "", "Filter by enrollment term (ID, SIS term ID or name)")
 
 	// Account context flags (admin)
 	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID to list courses from (admin mode)")
@@ -91,6 +95,7 @@ func runCoursesList(ctx context.Context, client *api.Client, opts *options.Cours
 		"enrollment_state": opts.EnrollmentState,
 		"account_id":       opts.AccountID,
 		"search_term":      opts.SearchTerm,
+		"term":             opts.Term,
 	})
 
 	var courses []api.Course
@@ -109,6 +114,32 @@ func runCoursesList(ctx context.Context, client *api.Client, opts *options.Cours
 			Order:      opts.Order,
 		}
 
+		if opts.Term != "" {
+			reqOpts.EnrollmentTermID, err = resolveTermID(ctx, client, opts.AccountID, opts.Term)
+			if err != nil {
+				logger.LogCommandError(ctx, "courses.list", err, map[string]interface{}{
+					"account_id": opts.AccountID,
+					"term":       opts.Term,
+				})
+				return err
+			}
+		}
+
+		// Stream courses page by page for NDJSON output
+		if isStreamingOutput() {
+			count, err := streamNDJSON(accountsService.ListCoursesIter(ctx, opts.AccountID, reqOpts))
+			if err != nil {
+				logger.LogCommandError(ctx, "courses.list", err, map[string]interface{}{
+					"account_id": opts.AccountID,
+					"written":    count,
+				})
+				return fmt.Errorf("failed to list account courses: %w", err)
+			}
+
+			logger.LogCommandComplete(ctx, "courses.list", count)
+			return nil
+		}
+
 		courses, err = accountsService.ListCourses(ctx, opts.AccountID, reqOpts)
 		if err != nil {
 			logger.LogCommandError(ctx, "courses.list", err, map[string]interface{}{
@@ -129,6 +160,34 @@ func runCoursesList(ctx context.Context, client *api.Client, opts *options.Cours
 			State:           opts.State,
 		}
 
+		// Enrolled courses are filtered by the term included with each
+		// course, which does not need access to the account's terms
+		if opts.Term != "" && !slices.Contains(reqOpts.Include, "term") {
+			reqOpts.Include = append(slices.Clone(reqOpts.Include), "term")
+		}
+
+		// Stream courses page by page for NDJSON output
+		if isStreamingOutput() {
+			seq := coursesService.ListIter(ctx, reqOpts)
+			if opts.Term != "" {
+				seq = filterSeq(seq, func(course api.Course) bool {
+					return courseInTerm(course, opts.Term)
+				})
+			}
+
+			count, err := streamNDJSON(seq)
+			if err != nil {
+				logger.LogCommandError(ctx, "courses.list", err, map[string]interface{}{
+					"enrollment_type": opts.EnrollmentType,
+					"written":         count,
+				})
+				return fmt.Errorf("failed to list courses: %w", err)
+			}
+
+			logger.LogCommandComplete(ctx, "courses.list", count)
+			return nil
+		}
+
 		courses, err = coursesService.List(ctx, reqOpts)
 		if err != nil {
 			logger.LogCommandError(ctx, "courses.list", err, map[string]interface{}{
@@ -137,6 +196,10 @@ func runCoursesList(ctx context.Context, client *api.Client, opts *options.Cours
 			return fmt.Errorf("failed to list courses: %w", err)
 		}
 
+		if opts.Term != "" {
+			courses = filterCoursesByTerm(courses, opts.Term)
+		}
+
 		printVerbose("Found %d enrolled courses:\n\n", len(courses))
 	}
 
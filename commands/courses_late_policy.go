@@ -0,0 +1,210 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// coursesLatePolicyCmd represents the courses late-policy command group
+var coursesLatePolicyCmd = &cobra.Command{
+	Use:   "late-policy",
+	Short: "Manage course late policies",
+	Long: `Manage the automatic penalties a course applies to late and missing
+submissions.
+
+To apply the same late policy to every course of a sub-account, add it to a
+grading scheme file and use 'canvas grading-standards push'.
+
+Examples:
+  canvas courses late-policy get 123
+  canvas courses late-policy set 123 --late-deduction 10 --late-interval day --minimum-percent 50
+  canvas courses late-policy set 123 --missing-deduction 100
+  canvas courses late-policy set 123 --disable-late`,
+}
+
+func init() {
+	coursesCmd.AddCommand(coursesLatePolicyCmd)
+	coursesLatePolicyCmd.AddCommand(newCoursesLatePolicyGetCmd())
+	coursesLatePolicyCmd.AddCommand(newCoursesLatePolicySetCmd())
+}
+
+func newCoursesLatePolicyGetCmd() *cobra.Command {
+	opts := &options.CoursesLatePolicyGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <course-id>",
+		Short: "Get a course late policy",
+		Long: `Get the late policy of a course.
+
+Examples:
+  canvas courses late-policy get 123
+  canvas courses late-policy get 123 -o json`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid course ID: %s", args[0])
+			}
+			opts.CourseID = courseID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesLatePolicyGet(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newCoursesLatePolicySetCmd() *cobra.Command {
+	opts := &options.CoursesLatePolicySetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <course-id>",
+		Short: "Create or update a course late policy",
+		Long: `Create or update the late policy of a course. Setting a deduction enables
+it; settings that are not given are left unchanged.
+
+Examples:
+  canvas courses late-policy set 123 --late-deduction 10 --late-interval day
+  canvas courses late-policy set 123 --minimum-percent 50
+  canvas courses late-policy set 123 --missing-deduction 100
+  canvas courses late-policy set 123 --disable-late --disable-minimum`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid course ID: %s", args[0])
+			}
+			opts.CourseID = courseID
+
+			// Track which fields were set
+			opts.LateDeductionSet = cmd.Flags().Changed("late-deduction")
+			opts.LateIntervalSet = cmd.Flags().Changed("late-interval")
+			opts.MinimumPercentSet = cmd.Flags().Changed("minimum-percent")
+			opts.MissingDeductionSet = cmd.Flags().Changed("missing-deduction")
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesLatePolicySet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Float64Var(&opts.LateDeduction, "late-deduction", 0, "Percent deducted per late interval")
+	cmd.Flags().StringVar(&opts.LateInterval, "late-interval", "", "Late deduction interval: day, hour")
+	cmd.Flags().Float64Var(&opts.MinimumPercent, "minimum-percent", 0, "Lowest percent late deductions can reduce a grade to")
+	cmd.Flags().Float64Var(&opts.MissingDeduction, "missing-deduction", 0, "Percent deducted from missing submissions")
+	cmd.Flags().BoolVar(&opts.DisableLate, "disable-late", false, "Disable the late submission deduction")
+	cmd.Flags().BoolVar(&opts.DisableMinimum, "disable-minimum", false, "Disable the minimum percent")
+	cmd.Flags().BoolVar(&opts.DisableMissing, "disable-missing", false, "Disable the missing submission deduction")
+
+	return cmd
+}
+
+func runCoursesLatePolicyGet(ctx context.Context, client *api.Client, opts *options.CoursesLatePolicyGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.late_policy.get", map[string]interface{}{
+		"course_id": opts.CourseID,
+	})
+
+	service := api.NewLatePolicyService(client)
+
+	policy, err := service.Get(ctx, opts.CourseID)
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			logger.LogCommandComplete(ctx, "courses.late_policy.get", 0)
+			fmt.Printf("Course %d has no late policy\n", opts.CourseID)
+			return nil
+		}
+		logger.LogCommandError(ctx, "courses.late_policy.get", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to get late policy: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "courses.late_policy.get", 1)
+	return formatOutput(policy, nil)
+}
+
+func runCoursesLatePolicySet(ctx context.Context, client *api.Client, opts *options.CoursesLatePolicySetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.late_policy.set", map[string]interface{}{
+		"course_id": opts.CourseID,
+	})
+
+	params := &api.LatePolicyParams{}
+	enabled, disabled := true, false
+
+	if opts.LateDeductionSet {
+		params.LateSubmissionDeductionEnabled = &enabled
+		params.LateSubmissionDeduction = &opts.LateDeduction
+	} else if opts.DisableLate {
+		params.LateSubmissionDeductionEnabled = &disabled
+	}
+	if opts.LateIntervalSet {
+		params.LateSubmissionInterval = &opts.LateInterval
+	}
+	if opts.MinimumPercentSet {
+		params.LateSubmissionMinimumPercentEnabled = &enabled
+		params.LateSubmissionMinimumPercent = &opts.MinimumPercent
+	} else if opts.DisableMinimum {
+		params.LateSubmissionMinimumPercentEnabled = &disabled
+	}
+	if opts.MissingDeductionSet {
+		params.MissingSubmissionDeductionEnabled = &enabled
+		params.MissingSubmissionDeduction = &opts.MissingDeduction
+	} else if opts.DisableMissing {
+		params.MissingSubmissionDeductionEnabled = &disabled
+	}
+
+	service := api.NewLatePolicyService(client)
+
+	policy, err := saveLatePolicy(ctx, service, opts.CourseID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.late_policy.set", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to save late policy: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "courses.late_policy.set", 1)
+	return formatSuccessOutput(policy, fmt.Sprintf("Late policy of course %d saved", opts.CourseID))
+}
+
+// saveLatePolicy updates a course's late policy, creating it if the course
+// has none yet
+func saveLatePolicy(ctx context.Context, service *api.LatePolicyService, courseID int64, params *api.LatePolicyParams) (*api.LatePolicy, error) {
+	if _, err := service.Get(ctx, courseID); err != nil {
+		if api.IsNotFoundError(err) {
+			return service.Create(ctx, courseID, params)
+		}
+		return nil, err
+	}
+
+	if err := service.Update(ctx, courseID, params); err != nil {
+		return nil, err
+	}
+
+	return service.Get(ctx, courseID)
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+func TestSaveLatePolicy(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"message":"The specified resource does not exist."}]}`))
+			return
+		}
+		w.Write([]byte(`{"late_policy":{"id":1,"course_id":123,"missing_submission_deduction_enabled":true,"missing_submission_deduction":100}}`))
+	}))
+	defer server.Close()
+
+	client, err := api.NewClient(api.ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	enabled := true
+	deduction := 100.0
+	policy, err := saveLatePolicy(context.Background(), api.NewLatePolicyService(client), 123, &api.LatePolicyParams{
+		MissingSubmissionDeductionEnabled: &enabled,
+		MissingSubmissionDeduction:        &deduction,
+	})
+	if err != nil {
+		t.Fatalf("saveLatePolicy failed: %v", err)
+	}
+
+	if policy.ID != 1 || !policy.MissingSubmissionDeductionEnabled {
+		t.Errorf("Unexpected policy: %+v", policy)
+	}
+	if len(methods) != 2 || methods[0] != http.MethodGet || methods[1] != http.MethodPost {
+		t.Errorf("Expected GET then POST for a course without a policy, got %v", methods)
+	}
+}
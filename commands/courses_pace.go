@@ -0,0 +1,803 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// coursesPaceCmd represents the courses pace command group
+var coursesPaceCmd = &cobra.Command{
+	Use:   "pace",
+	Short: "Manage course pacing",
+	Long: `Manage Course Pacing for a course, its sections and its students.
+
+Commands target the course pace by default, a section pace with --section-id
+or a student pace with --user-id. Sections and students without a pace of
+their own follow the course pace.
+
+Paces can be exported to YAML and imported again, so one pace can be applied
+to many sections.
+
+Examples:
+  canvas courses pace get 123
+  canvas courses pace set 123 --section-id 45 --end-date 2026-12-15 --duration 301=2
+  canvas courses pace export 123 --file pace.yaml
+  canvas courses pace import 123 --file pace.yaml --section-id 45,46,47`,
+}
+
+func init() {
+	coursesCmd.AddCommand(coursesPaceCmd)
+	coursesPaceCmd.AddCommand(newCoursesPaceGetCmd())
+	coursesPaceCmd.AddCommand(newCoursesPaceSetCmd())
+	coursesPaceCmd.AddCommand(newCoursesPacePublishCmd())
+	coursesPaceCmd.AddCommand(newCoursesPaceExportCmd())
+	coursesPaceCmd.AddCommand(newCoursesPaceImportCmd())
+}
+
+// addPaceTargetFlags registers the flags selecting a section or student pace
+func addPaceTargetFlags(cmd *cobra.Command, sectionID, userID *int64) {
+	cmd.Flags().Int64Var(sectionID, "section-id", 0, "Section pace")
+	cmd.Flags().Int64Var(userID, "user-id", 0, "Student pace")
+}
+
+// parseCourseIDArg parses the course ID positional argument
+func parseCourseIDArg(arg string) (int64, error) {
+	courseID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid course ID: %s", arg)
+	}
+	return courseID, nil
+}
+
+func newCoursesPaceGetCmd() *cobra.Command {
+	opts := &options.CoursesPaceGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <course-id>",
+		Short: "Get a course, section or student pace",
+		Long: `Get a pace with the number of days it gives to each module item.
+
+Examples:
+  canvas courses pace get 123
+  canvas courses pace get 123 --section-id 45
+  canvas courses pace get 123 --user-id 789 -o json`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := parseCourseIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			opts.CourseID = courseID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesPaceGet(cmd.Context(), client, opts)
+		},
+	}
+
+	addPaceTargetFlags(cmd, &opts.SectionID, &opts.UserID)
+
+	return cmd
+}
+
+func newCoursesPaceSetCmd() *cobra.Command {
+	opts := &options.CoursesPaceSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <course-id>",
+		Short: "Create or update a pace",
+		Long: `Create or update a course, section or student pace. Saving a pace also
+publishes it. Settings that are not given are left unchanged.
+
+Module item durations are given as MODULE_ITEM_ID=DAYS.
+
+Examples:
+  canvas courses pace set 123 --end-date 2026-12-15 --skip-days sat,sun
+  canvas courses pace set 123 --section-id 45 --duration 301=2 --duration 302=5
+  canvas courses pace set 123 --user-id 789 --hard-end-dates --wait`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := parseCourseIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			opts.CourseID = courseID
+
+			// Track which fields were set
+			opts.EndDateSet = cmd.Flags().Changed("end-date")
+			opts.SkipDaysSet = cmd.Flags().Changed("skip-days")
+			opts.ExcludeWeekendsSet = cmd.Flags().Changed("exclude-weekends")
+			opts.HardEndDatesSet = cmd.Flags().Changed("hard-end-dates")
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesPaceSet(cmd.Context(), client, opts)
+		},
+	}
+
+	addPaceTargetFlags(cmd, &opts.SectionID, &opts.UserID)
+	cmd.Flags().StringVar(&opts.EndDate, "end-date", "", "End date (YYYY-MM-DD)")
+	cmd.Flags().StringSliceVar(&opts.SkipDays, "skip-days", nil, "Days to skip: sun, mon, tue, wed, thu, fri, sat")
+	cmd.Flags().BoolVar(&opts.ExcludeWeekends, "exclude-weekends", false, "Skip weekends")
+	cmd.Flags().BoolVar(&opts.HardEndDates, "hard-end-dates", false, "Require the pace to end on the end date")
+	cmd.Flags().StringArrayVar(&opts.Durations, "duration", nil, "Module item duration as MODULE_ITEM_ID=DAYS (repeatable)")
+	addWaitFlags(cmd, &opts.WaitOptions)
+
+	return cmd
+}
+
+func newCoursesPacePublishCmd() *cobra.Command {
+	opts := &options.CoursesPacePublishOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "publish <course-id>",
+		Short: "Publish a pace",
+		Long: `Publish the draft changes of a pace, recomputing the due dates of the
+students that follow it.
+
+Examples:
+  canvas courses pace publish 123
+  canvas courses pace publish 123 --section-id 45 --wait`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := parseCourseIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			opts.CourseID = courseID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesPacePublish(cmd.Context(), client, opts)
+		},
+	}
+
+	addPaceTargetFlags(cmd, &opts.SectionID, &opts.UserID)
+	addWaitFlags(cmd, &opts.WaitOptions)
+
+	return cmd
+}
+
+func newCoursesPaceExportCmd() *cobra.Command {
+	opts := &options.CoursesPaceExportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export <course-id>",
+		Short: "Export a pace to YAML",
+		Long: `Export a pace as YAML, to a file or to stdout. The file can be edited and
+applied to other sections with 'canvas courses pace import'.
+
+Example file:
+  end_date: "2026-12-15"
+  selected_days_to_skip: [sat, sun]
+  hard_end_dates: false
+  items:
+    - module_item_id: 301
+      duration: 2
+      module: Week 1
+      title: Reading 1
+
+Examples:
+  canvas courses pace export 123
+  canvas courses pace export 123 --section-id 45 --file pace.yaml`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := parseCourseIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			opts.CourseID = courseID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesPaceExport(cmd.Context(), client, opts)
+		},
+	}
+
+	addPaceTargetFlags(cmd, &opts.SectionID, &opts.UserID)
+	cmd.Flags().StringVar(&opts.File, "file", "", "Write to this file instead of stdout")
+
+	return cmd
+}
+
+func newCoursesPaceImportCmd() *cobra.Command {
+	opts := &options.CoursesPaceImportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import <course-id>",
+		Short: "Apply a YAML pace",
+		Long: `Apply a pace exported with 'canvas courses pace export' to the course pace,
+to sections or to students. Every module item in the file must be part of the
+course modules.
+
+Examples:
+  canvas courses pace import 123 --file pace.yaml
+  canvas courses pace import 123 --file pace.yaml --section-id 45,46,47
+  canvas courses pace import 123 --file pace.yaml --user-id 789 --dry-run`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := parseCourseIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			opts.CourseID = courseID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesPaceImport(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.File, "file", "", "YAML pace file (required)")
+	cmd.Flags().Int64SliceVar(&opts.SectionIDs, "section-id", nil, "Section IDs to apply the pace to (comma-separated)")
+	cmd.Flags().Int64SliceVar(&opts.UserIDs, "user-id", nil, "Student user IDs to apply the pace to (comma-separated)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would change without applying it")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runCoursesPaceGet(ctx context.Context, client *api.Client, opts *options.CoursesPaceGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.pace.get", map[string]interface{}{
+		"course_id":  opts.CourseID,
+		"section_id": opts.SectionID,
+		"user_id":    opts.UserID,
+	})
+
+	target := paceTarget{SectionID: opts.SectionID, UserID: opts.UserID}
+
+	pace, err := target.get(ctx, client, opts.CourseID)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.pace.get", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"target":    target.String(),
+		})
+		return fmt.Errorf("failed to get %s pace: %w", target, err)
+	}
+
+	logger.LogCommandComplete(ctx, "courses.pace.get", 1)
+	return formatOutput(pace, func() { printCoursePace(target, pace) })
+}
+
+// printCoursePace prints a pace's settings followed by its module items
+func printCoursePace(target paceTarget, pace *api.CoursePace) {
+	if pace.ID == 0 {
+		fmt.Printf("The %s has no pace of its own; it follows this pace:\n\n", target)
+	} else {
+		fmt.Printf("Pace %d for the %s (%s)\n\n", pace.ID, target, pace.WorkflowState)
+	}
+
+	skipDays := "none"
+	if len(pace.SelectedDaysToSkip) > 0 {
+		skipDays = strings.Join(pace.SelectedDaysToSkip, ", ")
+	} else if pace.ExcludeWeekends {
+		skipDays = "weekends"
+	}
+	endDate := pace.EndDate
+	if endDate == "" {
+		endDate = "none"
+	}
+	fmt.Printf("End date:       %s\n", endDate)
+	fmt.Printf("Hard end dates: %t\n", pace.HardEndDates)
+	fmt.Printf("Days to skip:   %s\n", skipDays)
+
+	for _, module := range pace.Modules {
+		fmt.Printf("\n%s\n", module.Name)
+		for _, item := range module.Items {
+			fmt.Printf("  %3d days  %s (module item %d)\n", item.Duration, item.AssignmentTitle, item.ModuleItemID)
+		}
+	}
+}
+
+func runCoursesPaceSet(ctx context.Context, client *api.Client, opts *options.CoursesPaceSetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.pace.set", map[string]interface{}{
+		"course_id":  opts.CourseID,
+		"section_id": opts.SectionID,
+		"user_id":    opts.UserID,
+	})
+
+	durations, err := parsePaceDurations(opts.Durations)
+	if err != nil {
+		return err
+	}
+
+	settings := &paceSettings{Durations: durations}
+	if opts.EndDateSet {
+		settings.EndDate = &opts.EndDate
+	}
+	if opts.SkipDaysSet {
+		settings.SkipDays = opts.SkipDays
+	}
+	if opts.ExcludeWeekendsSet {
+		settings.ExcludeWeekends = &opts.ExcludeWeekends
+	}
+	if opts.HardEndDatesSet {
+		settings.HardEndDates = &opts.HardEndDates
+	}
+
+	target := paceTarget{SectionID: opts.SectionID, UserID: opts.UserID}
+
+	pace, progress, err := target.save(ctx, client, opts.CourseID, settings)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.pace.set", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"target":    target.String(),
+		})
+		return fmt.Errorf("failed to save %s pace: %w", target, err)
+	}
+
+	if err := formatSuccessOutput(pace, fmt.Sprintf("Pace for the %s saved (ID: %d)", target, pace.ID)); err != nil {
+		return err
+	}
+
+	logger.LogCommandComplete(ctx, "courses.pace.set", 1)
+	return waitForPacePublish(ctx, client, opts.WaitOptions, progress)
+}
+
+func runCoursesPacePublish(ctx context.Context, client *api.Client, opts *options.CoursesPacePublishOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.pace.publish", map[string]interface{}{
+		"course_id":  opts.CourseID,
+		"section_id": opts.SectionID,
+		"user_id":    opts.UserID,
+	})
+
+	target := paceTarget{SectionID: opts.SectionID, UserID: opts.UserID}
+
+	pace, err := target.get(ctx, client, opts.CourseID)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.pace.publish", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"target":    target.String(),
+		})
+		return fmt.Errorf("failed to get %s pace: %w", target, err)
+	}
+	if pace.ID == 0 {
+		return fmt.Errorf("the %s has no pace of its own to publish", target)
+	}
+
+	progress, err := api.NewCoursePacesService(client).Publish(ctx, opts.CourseID, pace.ID)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.pace.publish", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"pace_id":   pace.ID,
+		})
+		return fmt.Errorf("failed to publish pace: %w", err)
+	}
+
+	fmt.Printf("Publishing pace %d for the %s\n", pace.ID, target)
+
+	logger.LogCommandComplete(ctx, "courses.pace.publish", 1)
+	return waitForPacePublish(ctx, client, opts.WaitOptions, progress)
+}
+
+// waitForPacePublish waits for a pace to finish publishing when --wait is set
+func waitForPacePublish(ctx context.Context, client *api.Client, wait options.WaitOptions, progress *api.JobProgress) error {
+	if !wait.Wait || progress == nil {
+		return nil
+	}
+
+	progressService := api.NewProgressService(client)
+	_, err := waitForJob(ctx, wait, func(ctx context.Context) (*api.JobProgress, error) {
+		return progressService.Get(ctx, progress.ID)
+	})
+	return err
+}
+
+func runCoursesPaceExport(ctx context.Context, client *api.Client, opts *options.CoursesPaceExportOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.pace.export", map[string]interface{}{
+		"course_id":  opts.CourseID,
+		"section_id": opts.SectionID,
+		"user_id":    opts.UserID,
+		"file":       opts.File,
+	})
+
+	target := paceTarget{SectionID: opts.SectionID, UserID: opts.UserID}
+
+	pace, err := target.get(ctx, client, opts.CourseID)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.pace.export", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"target":    target.String(),
+		})
+		return fmt.Errorf("failed to get %s pace: %w", target, err)
+	}
+
+	data, err := yaml.Marshal(newPaceFile(pace))
+	if err != nil {
+		return fmt.Errorf("failed to encode pace: %w", err)
+	}
+
+	if opts.File == "" {
+		fmt.Print(string(data))
+	} else {
+		if err := os.WriteFile(opts.File, data, 0644); err != nil {
+			return fmt.Errorf("failed to write pace file: %w", err)
+		}
+		fmt.Printf("Pace for the %s exported to %s\n", target, opts.File)
+	}
+
+	logger.LogCommandComplete(ctx, "courses.pace.export", 1)
+	return nil
+}
+
+func runCoursesPaceImport(ctx context.Context, client *api.Client, opts *options.CoursesPaceImportOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.pace.import", map[string]interface{}{
+		"course_id":   opts.CourseID,
+		"file":        opts.File,
+		"section_ids": opts.SectionIDs,
+		"user_ids":    opts.UserIDs,
+		"dry_run":     opts.DryRun,
+	})
+
+	file, err := loadPaceFile(opts.File)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.pace.import", err, map[string]interface{}{
+			"file": opts.File,
+		})
+		return err
+	}
+
+	var targets []paceTarget
+	for _, sectionID := range opts.SectionIDs {
+		targets = append(targets, paceTarget{SectionID: sectionID})
+	}
+	for _, userID := range opts.UserIDs {
+		targets = append(targets, paceTarget{UserID: userID})
+	}
+	if len(targets) == 0 {
+		targets = append(targets, paceTarget{})
+	}
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+	}
+
+	settings := file.settings()
+	applied := 0
+	var errors []error
+
+	for _, target := range targets {
+		if opts.DryRun {
+			pace, err := target.get(ctx, client, opts.CourseID)
+			if err == nil {
+				_, err = settings.moduleItems(pace)
+			}
+			if err != nil {
+				errors = append(errors, fmt.Errorf("%s: %w", target, err))
+				continue
+			}
+
+			action := "update"
+			if pace.ID == 0 {
+				action = "create"
+			}
+			fmt.Printf("  Would %s the pace for the %s (%d module items)\n", action, target, len(settings.Durations))
+			applied++
+			continue
+		}
+
+		pace, _, err := target.save(ctx, client, opts.CourseID, settings)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%s: %w", target, err))
+			continue
+		}
+
+		fmt.Printf("  Applied pace to the %s (ID: %d)\n", target, pace.ID)
+		applied++
+	}
+
+	if opts.DryRun {
+		fmt.Printf("\nWould apply pace to %d of %d targets\n", applied, len(targets))
+	} else {
+		fmt.Printf("\nApplied pace to %d of %d targets\n", applied, len(targets))
+	}
+
+	if len(errors) > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range errors {
+			fmt.Printf("  - %v\n", err)
+		}
+		logger.LogCommandError(ctx, "courses.pace.import", fmt.Errorf("%d targets failed", len(errors)), map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to apply pace to %d of %d targets", len(errors), len(targets))
+	}
+
+	logger.LogCommandComplete(ctx, "courses.pace.import", applied)
+	return nil
+}
+
+// paceTarget is the course, section or student a pace belongs to
+type paceTarget struct {
+	SectionID int64
+	UserID    int64
+}
+
+func (t paceTarget) String() string {
+	switch {
+	case t.SectionID > 0:
+		return fmt.Sprintf("section %d", t.SectionID)
+	case t.UserID > 0:
+		return fmt.Sprintf("student %d", t.UserID)
+	default:
+		return "course"
+	}
+}
+
+// get retrieves the target's pace. Student paces are looked up through the
+// student's enrollment in the course.
+func (t paceTarget) get(ctx context.Context, client *api.Client, courseID int64) (*api.CoursePace, error) {
+	service := api.NewCoursePacesService(client)
+
+	switch {
+	case t.SectionID > 0:
+		return service.GetSectionPace(ctx, courseID, t.SectionID)
+	case t.UserID > 0:
+		enrollments, err := api.NewEnrollmentsService(client).ListCourse(ctx, courseID, &api.ListEnrollmentsOptions{
+			Type:   []string{"StudentEnrollment"},
+			UserID: t.UserID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(enrollments) == 0 {
+			return nil, fmt.Errorf("user %d is not a student in course %d", t.UserID, courseID)
+		}
+		return service.GetStudentPace(ctx, courseID, enrollments[0].ID)
+	default:
+		return service.GetCoursePace(ctx, courseID)
+	}
+}
+
+// save applies settings to the target's pace, creating the pace if the
+// target has none of its own yet
+func (t paceTarget) save(ctx context.Context, client *api.Client, courseID int64, settings *paceSettings) (*api.CoursePace, *api.JobProgress, error) {
+	pace, err := t.get(ctx, client, courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, err := settings.moduleItems(pace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := &api.CoursePaceParams{
+		EndDate:            settings.EndDate,
+		ExcludeWeekends:    settings.ExcludeWeekends,
+		SelectedDaysToSkip: settings.SkipDays,
+		HardEndDates:       settings.HardEndDates,
+		ModuleItems:        items,
+	}
+
+	service := api.NewCoursePacesService(client)
+
+	if pace.ID == 0 {
+		params.CourseSectionID = t.SectionID
+		params.UserID = t.UserID
+		return service.Create(ctx, courseID, params)
+	}
+
+	return service.Update(ctx, courseID, pace.ID, params)
+}
+
+// paceSettings are the changes to apply to a pace. Nil fields are left
+// unchanged.
+type paceSettings struct {
+	EndDate         *string
+	SkipDays        []string
+	ExcludeWeekends *bool
+	HardEndDates    *bool
+	Durations       map[int64]int // Days by module item ID
+}
+
+// moduleItems returns the module item durations to send for a pace. New paces
+// need every module item, existing paces only the changed ones.
+func (s *paceSettings) moduleItems(pace *api.CoursePace) ([]api.CoursePaceItemDuration, error) {
+	known := make(map[int64]bool)
+	var items []api.CoursePaceItemDuration
+
+	for _, module := range pace.Modules {
+		for _, item := range module.Items {
+			known[item.ModuleItemID] = true
+
+			duration, changed := s.Durations[item.ModuleItemID]
+			if pace.ID > 0 && !changed {
+				continue
+			}
+			if !changed {
+				duration = item.Duration
+			}
+
+			entry := api.CoursePaceItemDuration{ModuleItemID: item.ModuleItemID, Duration: duration}
+			if pace.ID > 0 {
+				entry.ID = item.ID
+			}
+			items = append(items, entry)
+		}
+	}
+
+	for moduleItemID := range s.Durations {
+		if !known[moduleItemID] {
+			return nil, fmt.Errorf("module item %d is not part of the pace", moduleItemID)
+		}
+	}
+
+	return items, nil
+}
+
+// parsePaceDurations parses MODULE_ITEM_ID=DAYS values
+func parsePaceDurations(values []string) (map[int64]int, error) {
+	durations := make(map[int64]int, len(values))
+
+	for _, value := range values {
+		idPart, daysPart, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid duration %q: expected MODULE_ITEM_ID=DAYS", value)
+		}
+
+		moduleItemID, err := strconv.ParseInt(strings.TrimSpace(idPart), 10, 64)
+		if err != nil || moduleItemID <= 0 {
+			return nil, fmt.Errorf("invalid module item ID in duration %q", value)
+		}
+
+		days, err := strconv.Atoi(strings.TrimSpace(daysPart))
+		if err != nil || days < 0 {
+			return nil, fmt.Errorf("invalid number of days in duration %q", value)
+		}
+
+		durations[moduleItemID] = days
+	}
+
+	return durations, nil
+}
+
+// paceFile is a pace exported to YAML
+type paceFile struct {
+	EndDate            string         `yaml:"end_date,omitempty"`
+	ExcludeWeekends    bool           `yaml:"exclude_weekends,omitempty"`
+	SelectedDaysToSkip []string       `yaml:"selected_days_to_skip,omitempty"`
+	HardEndDates       bool           `yaml:"hard_end_dates"`
+	Items              []paceFileItem `yaml:"items"`
+}
+
+// paceFileItem is a module item duration. Module and title are informational.
+type paceFileItem struct {
+	ModuleItemID int64  `yaml:"module_item_id"`
+	Duration     int    `yaml:"duration"`
+	Module       string `yaml:"module,omitempty"`
+	Title        string `yaml:"title,omitempty"`
+}
+
+func newPaceFile(pace *api.CoursePace) *paceFile {
+	file := &paceFile{
+		EndDate:            pace.EndDate,
+		ExcludeWeekends:    pace.ExcludeWeekends,
+		SelectedDaysToSkip: pace.SelectedDaysToSkip,
+		HardEndDates:       pace.HardEndDates,
+		Items:              []paceFileItem{},
+	}
+
+	for _, module := range pace.Modules {
+		for _, item := range module.Items {
+			file.Items = append(file.Items, paceFileItem{
+				ModuleItemID: item.ModuleItemID,
+				Duration:     item.Duration,
+				Module:       module.Name,
+				Title:        item.AssignmentTitle,
+			})
+		}
+	}
+
+	return file
+}
+
+func loadPaceFile(path string) (*paceFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pace file: %w", err)
+	}
+
+	var file paceFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse pace file: %w", err)
+	}
+
+	if err := file.validate(); err != nil {
+		return nil, fmt.Errorf("invalid pace file %s: %w", path, err)
+	}
+
+	return &file, nil
+}
+
+func (f *paceFile) validate() error {
+	if err := options.ValidatePaceSettings(f.EndDate, f.SelectedDaysToSkip); err != nil {
+		return err
+	}
+
+	seen := make(map[int64]bool, len(f.Items))
+	for i, item := range f.Items {
+		if item.ModuleItemID <= 0 {
+			return fmt.Errorf("item %d has no module_item_id", i+1)
+		}
+		if seen[item.ModuleItemID] {
+			return fmt.Errorf("duplicate module item %d", item.ModuleItemID)
+		}
+		seen[item.ModuleItemID] = true
+
+		if item.Duration < 0 {
+			return fmt.Errorf("module item %d: duration must not be negative", item.ModuleItemID)
+		}
+	}
+
+	return nil
+}
+
+// settings returns the changes that make a pace match the file
+func (f *paceFile) settings() *paceSettings {
+	settings := &paceSettings{
+		ExcludeWeekends: &f.ExcludeWeekends,
+		SkipDays:        f.SelectedDaysToSkip,
+		HardEndDates:    &f.HardEndDates,
+		Durations:       make(map[int64]int, len(f.Items)),
+	}
+	if f.EndDate != "" {
+		settings.EndDate = &f.EndDate
+	}
+
+	for _, item := range f.Items {
+		settings.Durations[item.ModuleItemID] = item.Duration
+	}
+
+	return settings
+}
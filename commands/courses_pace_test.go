@@ -0,0 +1,190 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+const sectionPaceMock = `{"course_pace":{"id":null,"course_id":1,"course_section_id":45,"workflow_state":"unpublished",
+	"end_date":"2026-12-15","selected_days_to_skip":["sat","sun"],
+	"modules":[{"id":1,"name":"Week 1","items":[
+		{"id":null,"module_item_id":301,"duration":2,"assignment_title":"Reading 1"},
+		{"id":null,"module_item_id":302,"duration":3,"assignment_title":"Quiz 1"}]}]}}`
+
+func TestPaceSettingsModuleItems(t *testing.T) {
+	pace := &api.CoursePace{
+		Modules: []api.CoursePaceModule{{
+			Name: "Week 1",
+			Items: []api.CoursePaceModuleItem{
+				{ID: 70, ModuleItemID: 301, Duration: 2},
+				{ID: 71, ModuleItemID: 302, Duration: 3},
+			},
+		}},
+	}
+	settings := &paceSettings{Durations: map[int64]int{302: 5}}
+
+	// New paces send every module item
+	items, err := settings.moduleItems(pace)
+	if err != nil {
+		t.Fatalf("moduleItems failed: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != 0 || items[0].Duration != 2 || items[1].Duration != 5 {
+		t.Errorf("Unexpected items for a new pace: %+v", items)
+	}
+
+	// Existing paces only send changed items, with their pace item IDs
+	pace.ID = 9
+	items, err = settings.moduleItems(pace)
+	if err != nil {
+		t.Fatalf("moduleItems failed: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != 71 || items[0].ModuleItemID != 302 || items[0].Duration != 5 {
+		t.Errorf("Unexpected items for an existing pace: %+v", items)
+	}
+
+	settings.Durations[999] = 1
+	if _, err := settings.moduleItems(pace); err == nil || !strings.Contains(err.Error(), "module item 999") {
+		t.Errorf("Expected unknown module item error, got %v", err)
+	}
+}
+
+func TestParsePaceDurations(t *testing.T) {
+	durations, err := parsePaceDurations([]string{"301=2", "302 = 0"})
+	if err != nil {
+		t.Fatalf("parsePaceDurations failed: %v", err)
+	}
+	if durations[301] != 2 || durations[302] != 0 {
+		t.Errorf("Unexpected durations: %v", durations)
+	}
+
+	for _, value := range []string{"301", "abc=2", "301=-1", "0=2"} {
+		if _, err := parsePaceDurations([]string{value}); err == nil {
+			t.Errorf("Expected error for %q", value)
+		}
+	}
+}
+
+func TestLoadPaceFile(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"valid", "end_date: \"2026-12-15\"\nselected_days_to_skip: [sat, sun]\nitems:\n  - module_item_id: 301\n    duration: 2\n", ""},
+		{"bad end date", "end_date: 12/15/2026\nitems: []\n", "end-date"},
+		{"bad skip day", "selected_days_to_skip: [saturday]\nitems: []\n", "skip-days"},
+		{"missing module item", "items:\n  - duration: 2\n", "no module_item_id"},
+		{"duplicate module item", "items:\n  - module_item_id: 301\n  - module_item_id: 301\n", "duplicate module item 301"},
+		{"negative duration", "items:\n  - module_item_id: 301\n    duration: -1\n", "must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(tt.name, " ", "_")+".yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			file, err := loadPaceFile(path)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if file.settings().Durations[301] != 2 {
+					t.Errorf("Unexpected settings: %+v", file.settings())
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCoursesPaceGetCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "get section pace",
+			Args: []string{"1", "--section-id", "45"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/1/course_pacing/new": cmdtest.NewMockResponse(sectionPaceMock),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				for _, want := range []string{"section 45 has no pace of its own", "Days to skip:   sat, sun", "Reading 1 (module item 301)"} {
+					if !strings.Contains(output, want) {
+						t.Errorf("Expected %q in output", want)
+					}
+				}
+			},
+		},
+		{
+			Name:        "section and user are exclusive",
+			Args:        []string{"1", "--section-id", "45", "--user-id", "7"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newCoursesPaceGetCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestCoursesPaceImportCmd(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "pace.yaml")
+	if err := os.WriteFile(valid, []byte("items:\n  - module_item_id: 301\n    duration: 4\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	unknown := filepath.Join(dir, "unknown.yaml")
+	if err := os.WriteFile(unknown, []byte("items:\n  - module_item_id: 999\n    duration: 4\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "dry run to sections",
+			Args: []string{"1", "--file", valid, "--section-id", "45,46", "--dry-run"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/1/course_pacing/new": cmdtest.NewMockResponse(sectionPaceMock),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				for _, want := range []string{"DRY RUN", "Would create the pace for the section 46", "Would apply pace to 2 of 2 targets"} {
+					if !strings.Contains(output, want) {
+						t.Errorf("Expected %q in output", want)
+					}
+				}
+			},
+		},
+		{
+			Name: "unknown module item",
+			Args: []string{"1", "--file", unknown, "--section-id", "45", "--dry-run"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/1/course_pacing/new": cmdtest.NewMockResponse(sectionPaceMock),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "missing file",
+			Args:        []string{"1", "--file", filepath.Join(dir, "missing.yaml")},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newCoursesPaceImportCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
@@ -0,0 +1,627 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+	"github.com/jjuanrivvera/canvas-cli/internal/batch"
+)
+
+// coursesTabsCmd represents the courses tabs command group
+var coursesTabsCmd = &cobra.Command{
+	Use:   "tabs",
+	Short: "Manage course navigation tabs",
+	Long: `Manage the order and visibility of the tabs in a course's navigation menu.
+
+The home tab is always first and the settings tab always last; neither can be
+moved or hidden. Use 'apply' to give many courses the same tab layout.
+
+Examples:
+  canvas courses tabs list 123
+  canvas courses tabs update 123 people --hidden
+  canvas courses tabs update 123 modules --position 2
+  canvas courses tabs apply --file tabs.yaml --account-id 1 --term "Fall 2026" --dry-run`,
+}
+
+func init() {
+	coursesCmd.AddCommand(coursesTabsCmd)
+	coursesTabsCmd.AddCommand(newCoursesTabsListCmd())
+	coursesTabsCmd.AddCommand(newCoursesTabsUpdateCmd())
+	coursesTabsCmd.AddCommand(newCoursesTabsApplyCmd())
+}
+
+func newCoursesTabsListCmd() *cobra.Command {
+	opts := &options.CoursesTabsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <course-id>",
+		Short: "List course navigation tabs",
+		Long: `List the navigation tabs of a course in menu order, including external
+tool tabs.
+
+Examples:
+  canvas courses tabs list 123
+  canvas courses tabs list 123 -o json`,
+		Args: ExactArgsWithUsage(1, "course-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := parseCourseIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			opts.CourseID = courseID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesTabsList(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newCoursesTabsUpdateCmd() *cobra.Command {
+	opts := &options.CoursesTabsUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <course-id> <tab-id>",
+		Short: "Move or hide a course navigation tab",
+		Long: `Move a tab to a new position or hide it from students. Moving a tab shifts
+the tabs at and after its new position down by one.
+
+Tab IDs are shown by 'canvas courses tabs list'.
+
+Examples:
+  canvas courses tabs update 123 people --hidden
+  canvas courses tabs update 123 people --visible
+  canvas courses tabs update 123 context_external_tool_42 --position 3`,
+		Args: ExactArgsWithUsage(2, "course-id", "tab-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			courseID, err := parseCourseIDArg(args[0])
+			if err != nil {
+				return err
+			}
+			opts.CourseID = courseID
+			opts.TabID = args[1]
+
+			// Track which fields were set
+			opts.PositionSet = cmd.Flags().Changed("position")
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesTabsUpdate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Position, "position", 0, "New 1-based position of the tab")
+	cmd.Flags().BoolVar(&opts.Hidden, "hidden", false, "Hide the tab from students")
+	cmd.Flags().BoolVar(&opts.Visible, "visible", false, "Show the tab to students")
+
+	return cmd
+}
+
+func newCoursesTabsApplyCmd() *cobra.Command {
+	opts := &options.CoursesTabsApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a tab layout to many courses",
+		Long: `Apply a tab order and visibility defined in YAML to the given courses, or
+to every course of an account and its sub-accounts, optionally limited to one
+term.
+
+The changes each course needs are shown before anything is applied. With
+--dry-run nothing is applied; otherwise you are asked to confirm unless
+--force is given.
+
+The file lists the tabs in the order they should follow the home tab. Tabs
+are matched by id or, for external tools whose IDs differ between courses,
+by label. Tabs that are not listed keep their relative order after the listed
+ones and are hidden when hide_unlisted is true. Listed tabs without hidden
+keep their current visibility, and listed tabs a course doesn't have are
+skipped.
+
+  tabs:
+    - id: modules
+    - id: assignments
+    - id: grades
+    - label: Zoom
+    - id: people
+      hidden: true
+  hide_unlisted: false
+
+Examples:
+  canvas courses tabs apply --file tabs.yaml --course-id 123 --course-id 124
+  canvas courses tabs apply --file tabs.yaml --account-id 1 --term "Fall 2026" --dry-run
+  canvas courses tabs apply --file tabs.yaml --account-id 1 --force --concurrency 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(opts.CourseIDs) == 0 {
+				accountID, err := resolveAccountID(opts.AccountID, "courses tabs apply")
+				if err != nil {
+					return err
+				}
+				opts.AccountID = accountID
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runCoursesTabsApply(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.File, "file", "", "YAML file with the tab layout (required)")
+	cmd.Flags().Int64SliceVar(&opts.CourseIDs, "course-id", nil, "Course ID (repeatable; default: every course of the account)")
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.Term, "term", "", "Only courses in this term (ID, SIS term ID or name)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of courses read and updated in parallel")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the changes without applying them")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runCoursesTabsList(ctx context.Context, client *api.Client, opts *options.CoursesTabsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.tabs.list", map[string]interface{}{
+		"course_id": opts.CourseID,
+	})
+
+	service := api.NewTabsService(client)
+
+	tabs, err := service.ListCourse(ctx, opts.CourseID)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.tabs.list", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to list tabs: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "courses.tabs.list", len(tabs))
+	return formatEmptyOrOutput(tabs, "No tabs found")
+}
+
+func runCoursesTabsUpdate(ctx context.Context, client *api.Client, opts *options.CoursesTabsUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.tabs.update", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"tab_id":    opts.TabID,
+	})
+
+	params := &api.UpdateTabParams{}
+	if opts.PositionSet {
+		params.Position = &opts.Position
+	}
+	hidden, visible := true, false
+	if opts.Hidden {
+		params.Hidden = &hidden
+	} else if opts.Visible {
+		params.Hidden = &visible
+	}
+
+	service := api.NewTabsService(client)
+
+	tab, err := service.UpdateCourse(ctx, opts.CourseID, opts.TabID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.tabs.update", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"tab_id":    opts.TabID,
+		})
+		return fmt.Errorf("failed to update tab: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "courses.tabs.update", 1)
+	return formatSuccessOutput(tab, fmt.Sprintf("Tab %q updated", tab.Label))
+}
+
+func runCoursesTabsApply(ctx context.Context, client *api.Client, opts *options.CoursesTabsApplyOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "courses.tabs.apply", map[string]interface{}{
+		"file":       opts.File,
+		"course_ids": opts.CourseIDs,
+		"account_id": opts.AccountID,
+		"term":       opts.Term,
+		"dry_run":    opts.DryRun,
+	})
+
+	layout, err := loadTabLayout(opts.File)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.tabs.apply", err, map[string]interface{}{
+			"file": opts.File,
+		})
+		return err
+	}
+
+	courses, err := tabLayoutCourses(ctx, client, opts)
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.tabs.apply", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+		})
+		return err
+	}
+
+	if len(courses) == 0 {
+		fmt.Println("No courses found")
+		logger.LogCommandComplete(ctx, "courses.tabs.apply", 0)
+		return nil
+	}
+
+	service := api.NewTabsService(client)
+
+	// Read the current tabs of every course and work out what has to change
+	plans := make([]*tabPlan, len(courses))
+	items := make([]interface{}, len(courses))
+	for i, course := range courses {
+		plans[i] = &tabPlan{Course: course}
+		items[i] = plans[i]
+	}
+
+	processor := batch.New(opts.Concurrency, false, nil)
+	readSummary, err := processor.Process(ctx, items, func(ctx context.Context, item interface{}) error {
+		plan := item.(*tabPlan)
+		tabs, err := service.ListCourse(ctx, plan.Course.ID)
+		if err != nil {
+			return fmt.Errorf("course %d: failed to list tabs: %w", plan.Course.ID, err)
+		}
+		plan.Changes, plan.Missing = layout.plan(tabs)
+		plan.Read = true
+		return nil
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.tabs.apply", err, map[string]interface{}{})
+		return err
+	}
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+		fmt.Println()
+	}
+
+	var pending []interface{}
+	for _, plan := range plans {
+		if !plan.Read || len(plan.Changes) == 0 {
+			continue
+		}
+		plan.print()
+		pending = append(pending, plan)
+	}
+
+	if readSummary.Failed > 0 {
+		fmt.Printf("Could not read the tabs of %d courses:\n", readSummary.Failed)
+		for _, err := range readSummary.Errors() {
+			fmt.Printf("  - %v\n", err)
+		}
+		fmt.Println()
+	}
+
+	if len(pending) == 0 {
+		fmt.Printf("%d of %d courses already match the tab layout\n", readSummary.Succeeded, len(courses))
+		logger.LogCommandComplete(ctx, "courses.tabs.apply", 0)
+		if readSummary.Failed > 0 {
+			return fmt.Errorf("tab layout apply completed with %d errors", readSummary.Failed)
+		}
+		return nil
+	}
+
+	if opts.DryRun {
+		fmt.Printf("%d of %d courses would change\n", len(pending), len(courses))
+		logger.LogCommandComplete(ctx, "courses.tabs.apply", 0)
+		return nil
+	}
+
+	if !opts.Force {
+		fmt.Printf("Apply these changes to %d courses? [y/N]: ", len(pending))
+		reader := bufio.NewReader(os.Stdin)
+		response, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Println("Apply cancelled")
+			logger.LogCommandComplete(ctx, "courses.tabs.apply", 0)
+			return nil
+		}
+	}
+
+	processor = batch.New(opts.Concurrency, false, batch.NewConsoleProgress(time.Second))
+	summary, err := processor.Process(ctx, pending, func(ctx context.Context, item interface{}) error {
+		plan := item.(*tabPlan)
+		// Changes are ordered by position, so each move lands where the
+		// layout expects it
+		for _, change := range plan.Changes {
+			if _, err := service.UpdateCourse(ctx, plan.Course.ID, change.Tab.ID, change.params()); err != nil {
+				return fmt.Errorf("course %d: failed to update tab %s: %w", plan.Course.ID, change.Tab.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "courses.tabs.apply", err, map[string]interface{}{})
+		return err
+	}
+
+	fmt.Printf("\nApplied tab layout to %d of %d courses\n", summary.Succeeded, summary.Total)
+	if summary.Failed > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range summary.Errors() {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, "courses.tabs.apply", summary.Succeeded)
+
+	if failed := summary.Failed + readSummary.Failed; failed > 0 {
+		return fmt.Errorf("tab layout apply completed with %d errors", failed)
+	}
+
+	return nil
+}
+
+// tabLayoutCourses returns the courses given with --course-id, or else the
+// courses of the account, optionally limited to one term
+func tabLayoutCourses(ctx context.Context, client *api.Client, opts *options.CoursesTabsApplyOptions) ([]api.Course, error) {
+	if len(opts.CourseIDs) > 0 {
+		courses := make([]api.Course, len(opts.CourseIDs))
+		for i, id := range opts.CourseIDs {
+			courses[i] = api.Course{ID: id}
+		}
+		return courses, nil
+	}
+
+	courseOpts := &api.ListAccountCoursesOptions{}
+	if opts.Term != "" {
+		termID, err := resolveTermID(ctx, client, opts.AccountID, opts.Term)
+		if err != nil {
+			return nil, err
+		}
+		courseOpts.EnrollmentTermID = termID
+	}
+
+	courses, err := api.NewAccountsService(client).ListCourses(ctx, opts.AccountID, courseOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account courses: %w", err)
+	}
+
+	return courses, nil
+}
+
+// tabLayout is a course navigation tab order and visibility defined in YAML
+type tabLayout struct {
+	Tabs         []tabLayoutEntry `yaml:"tabs"`
+	HideUnlisted bool             `yaml:"hide_unlisted"`
+}
+
+// tabLayoutEntry matches a tab by ID or label. A nil Hidden keeps the tab's
+// current visibility.
+type tabLayoutEntry struct {
+	ID     string `yaml:"id,omitempty"`
+	Label  string `yaml:"label,omitempty"`
+	Hidden *bool  `yaml:"hidden,omitempty"`
+}
+
+func (e tabLayoutEntry) String() string {
+	if e.ID != "" {
+		return e.ID
+	}
+	return e.Label
+}
+
+func (e tabLayoutEntry) matches(tab *api.Tab) bool {
+	if e.ID != "" {
+		return tab.ID == e.ID
+	}
+	return strings.EqualFold(tab.Label, e.Label)
+}
+
+func loadTabLayout(path string) (*tabLayout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tab layout file: %w", err)
+	}
+
+	var layout tabLayout
+	if err := yaml.Unmarshal(data, &layout); err != nil {
+		return nil, fmt.Errorf("failed to parse tab layout file: %w", err)
+	}
+
+	if err := layout.validate(); err != nil {
+		return nil, fmt.Errorf("invalid tab layout file: %w", err)
+	}
+
+	return &layout, nil
+}
+
+func (l *tabLayout) validate() error {
+	if len(l.Tabs) == 0 && !l.HideUnlisted {
+		return fmt.Errorf("no tabs given")
+	}
+
+	seen := make(map[string]bool)
+	for i, entry := range l.Tabs {
+		if (entry.ID == "") == (entry.Label == "") {
+			return fmt.Errorf("tab %d: exactly one of id or label is required", i+1)
+		}
+		if entry.ID == "home" || entry.ID == "settings" {
+			return fmt.Errorf("tab %d: the %s tab cannot be moved or hidden", i+1, entry.ID)
+		}
+		key := strings.ToLower(entry.String())
+		if seen[key] {
+			return fmt.Errorf("tab %d: %s is listed more than once", i+1, entry)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// plan works out the updates that give a course's tabs the layout, in the
+// order they have to be made, and which listed tabs the course doesn't have
+func (l *tabLayout) plan(tabs []api.Tab) ([]tabChange, []string) {
+	current := make([]api.Tab, len(tabs))
+	copy(current, tabs)
+	sort.SliceStable(current, func(i, j int) bool {
+		return current[i].Position < current[j].Position
+	})
+
+	// Build the target order: fixed tabs keep their place, listed tabs
+	// follow the home tab and unlisted tabs come after them
+	var head, listed, unlisted, tail []api.Tab
+	hidden := make(map[string]*bool)
+	used := make(map[string]bool)
+	var missing []string
+
+	for _, entry := range l.Tabs {
+		found := false
+		for _, tab := range current {
+			if !tab.Fixed() && !used[tab.ID] && entry.matches(&tab) {
+				listed = append(listed, tab)
+				hidden[tab.ID] = entry.Hidden
+				used[tab.ID] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, entry.String())
+		}
+	}
+
+	hide := true
+	for _, tab := range current {
+		switch {
+		case tab.ID == "home":
+			head = append(head, tab)
+		case tab.ID == "settings":
+			tail = append(tail, tab)
+		case !used[tab.ID]:
+			unlisted = append(unlisted, tab)
+			if l.HideUnlisted {
+				hidden[tab.ID] = &hide
+			}
+		}
+	}
+
+	target := append(append(append(head, listed...), unlisted...), tail...)
+
+	// Moving a tab in Canvas inserts it at the new position, so replay the
+	// moves on a copy of the current order to find which tabs must move
+	order := make([]string, len(current))
+	for i, tab := range current {
+		order[i] = tab.ID
+	}
+
+	var changes []tabChange
+	for i, tab := range target {
+		change := tabChange{Tab: tab}
+
+		if !tab.Fixed() && order[i] != tab.ID {
+			from := indexOf(order, tab.ID)
+			order = append(order[:from], order[from+1:]...)
+			order = append(order[:i], append([]string{tab.ID}, order[i:]...)...)
+			change.From, change.Position = from+1, i+1
+		}
+		if want := hidden[tab.ID]; want != nil && *want != tab.Hidden {
+			change.Hidden = want
+		}
+
+		if change.Position != 0 || change.Hidden != nil {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, missing
+}
+
+func indexOf(ids []string, id string) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// tabChange is a single tab update. A zero Position or nil Hidden leaves
+// that setting unchanged. From is the tab's position when it is moved, after
+// the moves before it.
+type tabChange struct {
+	Tab      api.Tab
+	From     int
+	Position int
+	Hidden   *bool
+}
+
+func (c tabChange) params() *api.UpdateTabParams {
+	params := &api.UpdateTabParams{Hidden: c.Hidden}
+	if c.Position != 0 {
+		position := c.Position
+		params.Position = &position
+	}
+	return params
+}
+
+func (c tabChange) String() string {
+	var parts []string
+	if c.Position != 0 {
+		parts = append(parts, fmt.Sprintf("position %d -> %d", c.From, c.Position))
+	}
+	if c.Hidden != nil {
+		parts = append(parts, fmt.Sprintf("hidden %t -> %t", c.Tab.Hidden, *c.Hidden))
+	}
+	return fmt.Sprintf("%s (%s): %s", c.Tab.Label, c.Tab.ID, strings.Join(parts, ", "))
+}
+
+// tabPlan holds the tab changes of one course
+type tabPlan struct {
+	Course  api.Course
+	Read    bool
+	Changes []tabChange
+	Missing []string
+}
+
+func (p *tabPlan) print() {
+	if p.Course.Name != "" {
+		fmt.Printf("Course %d: %s\n", p.Course.ID, p.Course.Name)
+	} else {
+		fmt.Printf("Course %d\n", p.Course.ID)
+	}
+	for _, change := range p.Changes {
+		fmt.Printf("  ~ %s\n", change)
+	}
+	for _, name := range p.Missing {
+		fmt.Printf("  ! %s: no such tab, skipped\n", name)
+	}
+	fmt.Println()
+}
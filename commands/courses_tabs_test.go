@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+const courseTabsJSON = `[
+	{"id":"home","label":"Home","type":"internal","position":1},
+	{"id":"announcements","label":"Announcements","type":"internal","position":2},
+	{"id":"people","label":"People","type":"internal","position":3},
+	{"id":"modules","label":"Modules","type":"internal","position":4},
+	{"id":"context_external_tool_42","label":"Zoom","type":"external","position":5,"hidden":true},
+	{"id":"settings","label":"Settings","type":"internal","position":6}
+]`
+
+func TestTabLayoutPlan(t *testing.T) {
+	tabs := []api.Tab{
+		{ID: "home", Label: "Home", Position: 1},
+		{ID: "announcements", Label: "Announcements", Position: 2},
+		{ID: "people", Label: "People", Position: 3},
+		{ID: "modules", Label: "Modules", Position: 4},
+		{ID: "context_external_tool_42", Label: "Zoom", Position: 5, Hidden: true},
+		{ID: "settings", Label: "Settings", Position: 6},
+	}
+	visible, hidden := false, true
+
+	t.Run("reorders and hides", func(t *testing.T) {
+		layout := &tabLayout{Tabs: []tabLayoutEntry{
+			{ID: "modules"},
+			{Label: "zoom", Hidden: &visible},
+			{ID: "people", Hidden: &hidden},
+			{ID: "grades"},
+		}}
+
+		changes, missing := layout.plan(tabs)
+
+		var got []string
+		for _, change := range changes {
+			got = append(got, change.String())
+		}
+		want := []string{
+			"Modules (modules): position 4 -> 2",
+			"Zoom (context_external_tool_42): position 5 -> 3, hidden true -> false",
+			"People (people): position 5 -> 4, hidden false -> true",
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("Unexpected changes:\n%s", strings.Join(got, "\n"))
+		}
+		if len(missing) != 1 || missing[0] != "grades" {
+			t.Errorf("Expected grades to be missing, got %v", missing)
+		}
+	})
+
+	t.Run("matching layout has no changes", func(t *testing.T) {
+		layout := &tabLayout{Tabs: []tabLayoutEntry{
+			{ID: "announcements"},
+			{ID: "people"},
+		}}
+
+		if changes, _ := layout.plan(tabs); len(changes) != 0 {
+			t.Errorf("Expected no changes, got %v", changes)
+		}
+	})
+
+	t.Run("hide unlisted", func(t *testing.T) {
+		layout := &tabLayout{
+			Tabs:         []tabLayoutEntry{{ID: "modules"}},
+			HideUnlisted: true,
+		}
+
+		changes, _ := layout.plan(tabs)
+		if len(changes) != 3 {
+			t.Fatalf("Expected 3 changes, got %v", changes)
+		}
+		if changes[0].Tab.ID != "modules" || changes[0].Position != 2 || changes[0].Hidden != nil {
+			t.Errorf("Unexpected first change: %v", changes[0])
+		}
+		for _, change := range changes[1:] {
+			if change.Hidden == nil || !*change.Hidden || change.Position != 0 {
+				t.Errorf("Expected %s to be hidden in place, got %v", change.Tab.ID, change)
+			}
+		}
+	})
+}
+
+func TestCoursesTabsApplyCmd(t *testing.T) {
+	dir := t.TempDir()
+	writeLayout := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write layout: %v", err)
+		}
+		return path
+	}
+
+	layout := writeLayout("tabs.yaml", "tabs:\n  - id: modules\n  - id: people\n    hidden: true\n")
+	matching := writeLayout("matching.yaml", "tabs:\n  - id: announcements\n")
+	withHome := writeLayout("home.yaml", "tabs:\n  - id: home\n")
+	ambiguous := writeLayout("ambiguous.yaml", "tabs:\n  - id: modules\n    label: Modules\n")
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "dry run shows diff",
+			Args: []string{"--file", layout, "--course-id", "123", "--dry-run"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/tabs": cmdtest.NewMockResponse(courseTabsJSON),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				for _, want := range []string{
+					"DRY RUN",
+					"Course 123",
+					"~ Modules (modules): position 4 -> 2",
+					"~ People (people): position 4 -> 3, hidden false -> true",
+					"1 of 1 courses would change",
+				} {
+					if !strings.Contains(output, want) {
+						t.Errorf("Expected %q in output: %s", want, output)
+					}
+				}
+			},
+		},
+		{
+			Name: "apply with force",
+			Args: []string{"--file", layout, "--course-id", "123", "--force"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/tabs/modules": cmdtest.NewMockResponse(`{"id":"modules","label":"Modules","position":2}`),
+				"/api/v1/courses/123/tabs/people":  cmdtest.NewMockResponse(`{"id":"people","label":"People","position":4,"hidden":true}`),
+				"/api/v1/courses/123/tabs":         cmdtest.NewMockResponse(courseTabsJSON),
+			},
+			ExpectOutput: "Applied tab layout to 1 of 1 courses",
+		},
+		{
+			Name: "already matching",
+			Args: []string{"--file", matching, "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/tabs": cmdtest.NewMockResponse(courseTabsJSON),
+			},
+			ExpectOutput: "1 of 1 courses already match the tab layout",
+		},
+		{
+			Name: "failed update",
+			Args: []string{"--file", layout, "--course-id", "123", "--force"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/tabs/modules": {StatusCode: 400, Body: `{"errors":[{"message":"bad position"}]}`},
+				"/api/v1/courses/123/tabs":         cmdtest.NewMockResponse(courseTabsJSON),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "home tab cannot be listed",
+			Args:        []string{"--file", withHome, "--course-id", "123"},
+			ExpectError: true,
+		},
+		{
+			Name:        "id and label are exclusive",
+			Args:        []string{"--file", ambiguous, "--course-id", "123"},
+			ExpectError: true,
+		},
+		{
+			Name:        "course IDs and term are exclusive",
+			Args:        []string{"--file", layout, "--course-id", "123", "--term", "Fall 2026"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newCoursesTabsApplyCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestCoursesTabsUpdateCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "hide tab",
+			Args: []string{"123", "people", "--hidden"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/tabs/people": cmdtest.NewMockResponse(`{"id":"people","label":"People","position":3,"hidden":true}`),
+			},
+			ExpectOutput: `Tab "People" updated`,
+		},
+		{
+			Name:        "home cannot be moved",
+			Args:        []string{"123", "home", "--position", "3"},
+			ExpectError: true,
+		},
+		{
+			Name:        "hidden and visible are exclusive",
+			Args:        []string{"123", "people", "--hidden", "--visible"},
+			ExpectError: true,
+		},
+		{
+			Name:        "change required",
+			Args:        []string{"123", "people"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newCoursesTabsUpdateCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"path/filepath"
 	"strings"
 	"testing"
 
 	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
 )
 
 func TestCoursesListCmd(t *testing.T) {
@@ -72,6 +74,35 @@ func TestCoursesListCmd(t *testing.T) {
 	}
 }
 
+func TestCoursesListCmd_NDJSON(t *testing.T) {
+	oldFormat := outputFormat
+	outputFormat = "ndjson"
+	defer func() { outputFormat = oldFormat }()
+
+	tc := cmdtest.CommandTestCase{
+		Name: "stream courses in a term as ndjson",
+		Args: []string{"--term", "Fall 2026"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/v1/courses": cmdtest.NewMockResponse(`[
+				{"id": 1, "name": "Biology", "term": {"id": 5, "name": "Fall 2026"}},
+				{"id": 2, "name": "Chemistry", "term": {"id": 4, "name": "Spring 2026"}},
+				{"id": 3, "name": "Physics", "term": {"id": 5, "name": "Fall 2026"}}
+			]`),
+		},
+		ValidateOutput: func(t *testing.T, output string) {
+			lines := strings.Split(strings.TrimSpace(output), "\n")
+			if len(lines) != 2 {
+				t.Fatalf("Expected 2 ndjson lines, got %d: %q", len(lines), output)
+			}
+			if !strings.Contains(lines[0], "Biology") || !strings.Contains(lines[1], "Physics") {
+				t.Errorf("Unexpected ndjson output: %q", output)
+			}
+		},
+	}
+
+	cmdtest.RunCommandTest(t, newCoursesListCmd(), tc)
+}
+
 func TestCoursesGetCmd(t *testing.T) {
 	tests := []cmdtest.CommandTestCase{
 		{
@@ -232,3 +263,34 @@ func TestCoursesDeleteCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestCoursesGetCmd_FromCassette(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), api.CassetteFileName)
+	cassette := &api.Cassette{
+		Interactions: []api.Interaction{
+			{
+				Request: api.CassetteRequest{Method: "GET", URL: "/api/v1/courses/7?include%5B%5D=term"},
+				Response: api.CassetteResponse{
+					StatusCode: 200,
+					Body:       `{"id": 7, "name": "Recorded Biology", "course_code": "BIO-7"}`,
+				},
+			},
+		},
+	}
+	if err := cassette.Save(cassettePath); err != nil {
+		t.Fatalf("failed to save cassette: %v", err)
+	}
+
+	responses, err := cmdtest.NewCassetteResponses(cassettePath)
+	if err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	responses["/api/v1/accounts"] = cmdtest.NewMockResponse(`[]`)
+
+	cmdtest.RunCommandTest(t, newCoursesGetCmd(), cmdtest.CommandTestCase{
+		Name:          "get course from recorded cassette",
+		Args:          []string{"7"},
+		MockResponses: responses,
+		ExpectOutput:  "Recorded Biology",
+	})
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"iter"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -39,8 +40,9 @@ func newEnrollmentsListCmd() *cobra.Command {
 	opts := &options.EnrollmentsListOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "list",
-		Short: "List enrollments",
+		Use:         "list",
+		Short:       "List enrollments",
+		Annotations: streamingAnnotations(),
 		Long: `List enrollments in a course or for a user.
 
 You must specify one of --course-id or --user-id to indicate the context.
@@ -328,6 +330,29 @@ func runEnrollmentsList(ctx context.Context, client *api.Client, opts *options.E
 		Include: opts.Include,
 	}
 
+	// Stream enrollments page by page for NDJSON output
+	if isStreamingOutput() {
+		var seq iter.Seq2[api.Enrollment, error]
+		if opts.CourseID > 0 {
+			seq = enrollmentsService.ListCourseIter(ctx, opts.CourseID, apiOpts)
+		} else {
+			seq = enrollmentsService.ListUserIter(ctx, opts.UserID, apiOpts)
+		}
+
+		count, err := streamNDJSON(seq)
+		if err != nil {
+			logger.LogCommandError(ctx, "enrollments.list", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"user_id":   opts.UserID,
+				"written":   count,
+			})
+			return fmt.Errorf("failed to list enrollments: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "enrollments.list", count)
+		return nil
+	}
+
 	// List enrollments based on context
 	var enrollments []api.Enrollment
 	var contextName string
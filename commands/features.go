@@ -0,0 +1,526 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+	"github.com/jjuanrivvera/canvas-cli/internal/batch"
+)
+
+// featuresCmd represents the features command group
+var featuresCmd = &cobra.Command{
+	Use:     "features",
+	Aliases: []string{"feature-flags"},
+	Short:   "Manage feature flags",
+	Long: `Manage Canvas feature flags for accounts, courses and users.
+
+Use --account-id, --course-id or --user-id to select the context. If none is
+specified, uses the default account ID from config.
+
+Examples:
+  canvas features list --course-id 123
+  canvas features diff 123 456
+  canvas features set quizzes_next --state on --course-id 123
+  canvas features set quizzes_next --state on --term "Fall 2026" --dry-run`,
+}
+
+func init() {
+	rootCmd.AddCommand(featuresCmd)
+	featuresCmd.AddCommand(newFeaturesListCmd())
+	featuresCmd.AddCommand(newFeaturesGetCmd())
+	featuresCmd.AddCommand(newFeaturesSetCmd())
+	featuresCmd.AddCommand(newFeaturesUnsetCmd())
+	featuresCmd.AddCommand(newFeaturesDiffCmd())
+}
+
+func addFeatureContextFlags(cmd *cobra.Command, opts *options.FeatureContextOptions) {
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+	cmd.Flags().Int64Var(&opts.UserID, "user-id", 0, "User ID")
+}
+
+// resolveFeatureContext returns the selected feature context, falling back
+// to the default account
+func resolveFeatureContext(opts *options.FeatureContextOptions, command string) (api.FeatureContext, error) {
+	switch {
+	case opts.CourseID > 0:
+		return api.CourseFeatures(opts.CourseID), nil
+	case opts.UserID > 0:
+		return api.UserFeatures(opts.UserID), nil
+	}
+
+	accountID, err := resolveAccountID(opts.AccountID, command)
+	if err != nil {
+		return api.FeatureContext{}, err
+	}
+	opts.AccountID = accountID
+
+	return api.AccountFeatures(accountID), nil
+}
+
+func newFeaturesListCmd() *cobra.Command {
+	opts := &options.FeaturesListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List features and their flag states",
+		Long: `List the features available in a context with their flag states.
+
+Examples:
+  canvas features list
+  canvas features list --course-id 123
+  canvas features list --user-id 456 --enabled`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			fc, err := resolveFeatureContext(&opts.FeatureContextOptions, "features list")
+			if err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFeaturesList(cmd.Context(), client, fc, opts)
+		},
+	}
+
+	addFeatureContextFlags(cmd, &opts.FeatureContextOptions)
+	cmd.Flags().BoolVar(&opts.Enabled, "enabled", false, "Only list the names of enabled features")
+
+	return cmd
+}
+
+func newFeaturesGetCmd() *cobra.Command {
+	opts := &options.FeaturesGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <feature>",
+		Short: "Get a feature flag",
+		Long: `Get the flag of a feature in a context. The flag may be inherited from a
+parent account.
+
+Examples:
+  canvas features get quizzes_next --course-id 123
+  canvas features get quizzes_next --account-id 1`,
+		Args: ExactArgsWithUsage(1, "feature"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Feature = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			fc, err := resolveFeatureContext(&opts.FeatureContextOptions, "features get")
+			if err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFeaturesGet(cmd.Context(), client, fc, opts)
+		},
+	}
+
+	addFeatureContextFlags(cmd, &opts.FeatureContextOptions)
+
+	return cmd
+}
+
+func newFeaturesSetCmd() *cobra.Command {
+	opts := &options.FeaturesSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <feature>",
+		Short: "Set a feature flag",
+		Long: `Set the state of a feature in a context.
+
+States:
+  off         Disabled (accounts: locked off for sub-accounts and courses)
+  allowed     Accounts only: sub-accounts and courses decide, default off
+  allowed_on  Accounts only: sub-accounts and courses decide, default on
+  on          Enabled (accounts: locked on for sub-accounts and courses)
+
+With --term, the state is applied to every course of the account in that
+term. The term may be given by ID, SIS term ID or name.
+
+Examples:
+  canvas features set quizzes_next --state on --course-id 123
+  canvas features set react_discussions_post --state allowed --account-id 5
+  canvas features set quizzes_next --state on --term "Fall 2026" --dry-run
+  canvas features set quizzes_next --state on --term 2026FA --concurrency 10`,
+		Args: ExactArgsWithUsage(1, "feature"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Feature = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			fc, err := resolveFeatureContext(&opts.FeatureContextOptions, "features set")
+			if err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			if opts.Term != "" {
+				return runFeaturesSetTerm(cmd.Context(), client, opts)
+			}
+			return runFeaturesSet(cmd.Context(), client, fc, opts)
+		},
+	}
+
+	addFeatureContextFlags(cmd, &opts.FeatureContextOptions)
+	cmd.Flags().StringVar(&opts.State, "state", "", "Flag state: off, allowed, allowed_on, on (required)")
+	cmd.Flags().StringVar(&opts.Term, "term", "", "Apply to every course in this term (ID, SIS term ID or name)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of courses updated in parallel (with --term)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which courses would change without making changes (with --term)")
+	cmd.MarkFlagRequired("state")
+
+	return cmd
+}
+
+func newFeaturesUnsetCmd() *cobra.Command {
+	opts := &options.FeaturesUnsetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "unset <feature>",
+		Short: "Remove a feature flag",
+		Long: `Remove a feature flag so the context inherits the state of its parent
+account.
+
+Examples:
+  canvas features unset quizzes_next --course-id 123
+  canvas features unset quizzes_next --account-id 5 --force`,
+		Args: ExactArgsWithUsage(1, "feature"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Feature = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			fc, err := resolveFeatureContext(&opts.FeatureContextOptions, "features unset")
+			if err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFeaturesUnset(cmd.Context(), client, fc, opts)
+		},
+	}
+
+	addFeatureContextFlags(cmd, &opts.FeatureContextOptions)
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newFeaturesDiffCmd() *cobra.Command {
+	opts := &options.FeaturesDiffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diff <id-a> <id-b>",
+		Short: "Compare feature flags of two courses or accounts",
+		Long: `Compare the feature flag states of two courses, or of two accounts with
+--accounts. Only features whose states differ are listed unless --all is set.
+
+The state_a and state_b columns hold the states of the first and second ID.
+
+Examples:
+  canvas features diff 123 456
+  canvas features diff 5 6 --accounts
+  canvas features diff 123 456 --all -o csv > features.csv`,
+		Args: ExactArgsWithUsage(2, "id-a", "id-b"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			idA, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid ID: %s", args[0])
+			}
+			opts.IDA = idA
+
+			idB, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid ID: %s", args[1])
+			}
+			opts.IDB = idB
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFeaturesDiff(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Accounts, "accounts", false, "Compare accounts instead of courses")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Include features with the same state")
+
+	return cmd
+}
+
+func runFeaturesList(ctx context.Context, client *api.Client, fc api.FeatureContext, opts *options.FeaturesListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "features.list", map[string]interface{}{
+		"context": fc.String(),
+		"enabled": opts.Enabled,
+	})
+
+	service := api.NewFeatureFlagsService(client)
+
+	if opts.Enabled {
+		names, err := service.ListEnabled(ctx, fc)
+		if err != nil {
+			logger.LogCommandError(ctx, "features.list", err, map[string]interface{}{
+				"context": fc.String(),
+			})
+			return fmt.Errorf("failed to list enabled features: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "features.list", len(names))
+		return formatEmptyOrOutput(names, fmt.Sprintf("No features enabled in %s", fc))
+	}
+
+	features, err := service.List(ctx, fc)
+	if err != nil {
+		logger.LogCommandError(ctx, "features.list", err, map[string]interface{}{
+			"context": fc.String(),
+		})
+		return fmt.Errorf("failed to list features: %w", err)
+	}
+
+	printVerbose("Found %d features in %s:\n\n", len(features), fc)
+
+	logger.LogCommandComplete(ctx, "features.list", len(features))
+	return formatEmptyOrOutput(features, "No features found")
+}
+
+func runFeaturesGet(ctx context.Context, client *api.Client, fc api.FeatureContext, opts *options.FeaturesGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "features.get", map[string]interface{}{
+		"context": fc.String(),
+		"feature": opts.Feature,
+	})
+
+	service := api.NewFeatureFlagsService(client)
+
+	flag, err := service.GetFlag(ctx, fc, opts.Feature)
+	if err != nil {
+		logger.LogCommandError(ctx, "features.get", err, map[string]interface{}{
+			"context": fc.String(),
+			"feature": opts.Feature,
+		})
+		return fmt.Errorf("failed to get feature flag: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "features.get", 1)
+	return formatOutput(flag, nil)
+}
+
+func runFeaturesSet(ctx context.Context, client *api.Client, fc api.FeatureContext, opts *options.FeaturesSetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "features.set", map[string]interface{}{
+		"context": fc.String(),
+		"feature": opts.Feature,
+		"state":   opts.State,
+	})
+
+	service := api.NewFeatureFlagsService(client)
+
+	flag, err := service.SetFlag(ctx, fc, opts.Feature, opts.State)
+	if err != nil {
+		logger.LogCommandError(ctx, "features.set", err, map[string]interface{}{
+			"context": fc.String(),
+			"feature": opts.Feature,
+		})
+		return fmt.Errorf("failed to set feature flag: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "features.set", 1)
+	return formatSuccessOutput(flag, fmt.Sprintf("Feature %s set to %s in %s", opts.Feature, flag.State, fc))
+}
+
+func runFeaturesSetTerm(ctx context.Context, client *api.Client, opts *options.FeaturesSetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "features.set_term", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"term":       opts.Term,
+		"feature":    opts.Feature,
+		"state":      opts.State,
+		"dry_run":    opts.DryRun,
+	})
+
+	termID, err := resolveTermID(ctx, client, opts.AccountID, opts.Term)
+	if err != nil {
+		logger.LogCommandError(ctx, "features.set_term", err, map[string]interface{}{
+			"term": opts.Term,
+		})
+		return err
+	}
+
+	courses, err := api.NewAccountsService(client).ListCourses(ctx, opts.AccountID, &api.ListAccountCoursesOptions{
+		EnrollmentTermID: termID,
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "features.set_term", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"term_id":    termID,
+		})
+		return fmt.Errorf("failed to list term courses: %w", err)
+	}
+
+	if len(courses) == 0 {
+		fmt.Printf("No courses found in term %s\n", opts.Term)
+		logger.LogCommandComplete(ctx, "features.set_term", 0)
+		return nil
+	}
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+		fmt.Println()
+		fmt.Printf("Feature %s would be set to %s in %d courses:\n", opts.Feature, opts.State, len(courses))
+		for _, course := range courses {
+			fmt.Printf("  - %d: %s\n", course.ID, course.Name)
+		}
+		logger.LogCommandComplete(ctx, "features.set_term", 0)
+		return nil
+	}
+
+	service := api.NewFeatureFlagsService(client)
+
+	items := make([]interface{}, len(courses))
+	for i, course := range courses {
+		items[i] = course
+	}
+
+	processor := batch.New(opts.Concurrency, false, batch.NewConsoleProgress(time.Second))
+	summary, err := processor.Process(ctx, items, func(ctx context.Context, item interface{}) error {
+		course := item.(api.Course)
+		if _, err := service.SetFlag(ctx, api.CourseFeatures(course.ID), opts.Feature, opts.State); err != nil {
+			return fmt.Errorf("course %d: %w", course.ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "features.set_term", err, map[string]interface{}{})
+		return err
+	}
+
+	fmt.Printf("\nSet %s to %s in %d of %d courses\n", opts.Feature, opts.State, summary.Succeeded, summary.Total)
+	if summary.Failed > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range summary.Errors() {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, "features.set_term", summary.Succeeded)
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("feature flag update completed with %d errors", summary.Failed)
+	}
+
+	return nil
+}
+
+func runFeaturesUnset(ctx context.Context, client *api.Client, fc api.FeatureContext, opts *options.FeaturesUnsetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "features.unset", map[string]interface{}{
+		"context": fc.String(),
+		"feature": opts.Feature,
+		"force":   opts.Force,
+	})
+
+	confirmed, err := confirmDelete("feature flag", opts.Feature, opts.Force)
+	if err != nil {
+		logger.LogCommandError(ctx, "features.unset", err, map[string]interface{}{})
+		return err
+	}
+	if !confirmed {
+		logger.LogCommandComplete(ctx, "features.unset", 0)
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	service := api.NewFeatureFlagsService(client)
+
+	if _, err := service.DeleteFlag(ctx, fc, opts.Feature); err != nil {
+		logger.LogCommandError(ctx, "features.unset", err, map[string]interface{}{
+			"context": fc.String(),
+			"feature": opts.Feature,
+		})
+		return fmt.Errorf("failed to remove feature flag: %w", err)
+	}
+
+	fmt.Printf("Feature flag %s removed from %s\n", opts.Feature, fc)
+	logger.LogCommandComplete(ctx, "features.unset", 1)
+	return nil
+}
+
+func runFeaturesDiff(ctx context.Context, client *api.Client, opts *options.FeaturesDiffOptions) error {
+	contextA := api.CourseFeatures(opts.IDA)
+	contextB := api.CourseFeatures(opts.IDB)
+	if opts.Accounts {
+		contextA = api.AccountFeatures(opts.IDA)
+		contextB = api.AccountFeatures(opts.IDB)
+	}
+
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "features.diff", map[string]interface{}{
+		"context_a": contextA.String(),
+		"context_b": contextB.String(),
+		"all":       opts.All,
+	})
+
+	service := api.NewFeatureFlagsService(client)
+
+	featuresA, err := service.List(ctx, contextA)
+	if err != nil {
+		logger.LogCommandError(ctx, "features.diff", err, map[string]interface{}{
+			"context": contextA.String(),
+		})
+		return fmt.Errorf("failed to list features of %s: %w", contextA, err)
+	}
+
+	featuresB, err := service.List(ctx, contextB)
+	if err != nil {
+		logger.LogCommandError(ctx, "features.diff", err, map[string]interface{}{
+			"context": contextB.String(),
+		})
+		return fmt.Errorf("failed to list features of %s: %w", contextB, err)
+	}
+
+	diffs := api.DiffFeatures(featuresA, featuresB, opts.All)
+
+	printVerbose("Comparing %s (state_a) with %s (state_b):\n\n", contextA, contextB)
+
+	logger.LogCommandComplete(ctx, "features.diff", len(diffs))
+	return formatEmptyOrOutput(diffs, fmt.Sprintf("Feature flags of %s and %s are identical", contextA, contextB))
+}
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestFeaturesDiffCmd(t *testing.T) {
+	oldFormat := outputFormat
+	outputFormat = "json"
+	defer func() { outputFormat = oldFormat }()
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "diff courses",
+			Args: []string{"101", "102"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/101/features": cmdtest.NewMockResponse(`[{"feature":"quizzes_next","feature_flag":{"state":"on"}},{"feature":"same","feature_flag":{"state":"off"}}]`),
+				"/api/v1/courses/102/features": cmdtest.NewMockResponse(`[{"feature":"quizzes_next","feature_flag":{"state":"off"}},{"feature":"same","feature_flag":{"state":"off"}}]`),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, `"state_a": "on"`) || !strings.Contains(output, `"state_b": "off"`) {
+					t.Errorf("Expected quizzes_next diff, got: %s", output)
+				}
+				if strings.Contains(output, `"same"`) {
+					t.Errorf("Expected identical feature to be omitted, got: %s", output)
+				}
+			},
+		},
+		{
+			Name:        "same ID",
+			Args:        []string{"101", "101"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newFeaturesDiffCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestFeaturesSetCmd(t *testing.T) {
+	termsResponse := `{"enrollment_terms":[{"id":7,"name":"Fall 2026"}]}`
+	coursesResponse := `[{"id":201,"name":"Biology"},{"id":202,"name":"Chemistry"}]`
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "set on course",
+			Args: []string{"quizzes_next", "--state", "on", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/features/flags/quizzes_next": cmdtest.NewMockResponse(`{"context_type":"Course","context_id":123,"feature":"quizzes_next","state":"on"}`),
+			},
+			ExpectOutput: "Feature quizzes_next set to on in course 123",
+		},
+		{
+			Name: "set across term",
+			Args: []string{"quizzes_next", "--state", "on", "--account-id", "1", "--term", "Fall 2026"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1":                              cmdtest.NewMockResponse(`{"id":1,"name":"Root"}`),
+				"/api/v1/accounts/1/terms":                        cmdtest.NewMockResponse(termsResponse),
+				"/api/v1/accounts/1/courses":                      cmdtest.NewMockResponse(coursesResponse),
+				"/api/v1/courses/201/features/flags/quizzes_next": cmdtest.NewMockResponse(`{"state":"on"}`),
+				"/api/v1/courses/202/features/flags/quizzes_next": cmdtest.NewMockResponse(`{"state":"on"}`),
+			},
+			ExpectOutput: "Set quizzes_next to on in 2 of 2 courses",
+		},
+		{
+			Name: "dry run across term",
+			Args: []string{"quizzes_next", "--state", "off", "--account-id", "1", "--term", "Fall 2026", "--dry-run"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1":         cmdtest.NewMockResponse(`{"id":1,"name":"Root"}`),
+				"/api/v1/accounts/1/terms":   cmdtest.NewMockResponse(termsResponse),
+				"/api/v1/accounts/1/courses": cmdtest.NewMockResponse(coursesResponse),
+			},
+			ExpectOutput: "202: Chemistry",
+		},
+		{
+			Name:        "allowed on course",
+			Args:        []string{"quizzes_next", "--state", "allowed", "--course-id", "123"},
+			ExpectError: true,
+		},
+		{
+			Name:        "term with course",
+			Args:        []string{"quizzes_next", "--state", "on", "--course-id", "123", "--term", "Fall 2026"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newFeaturesSetCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
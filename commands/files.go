@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"iter"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -20,12 +21,18 @@ var filesCmd = &cobra.Command{
 	Short: "Manage Canvas files",
 	Long: `Manage Canvas files including listing, uploading, downloading, and deleting files.
 
+Course folders are managed with mkdir, mv, cp, lock, unlock and usage-rights,
+which take paths relative to the course's root folder, such as
+"course files/week1/slides".
+
 Examples:
   canvas files list --course-id 123
   canvas files get 456
   canvas files upload --course-id 123 document.pdf
   canvas files download 456 --destination ./downloaded.pdf
-  canvas files delete 456`,
+  canvas files delete 456
+  canvas files mkdir "course files/week1/slides" --course-id 123 -p
+  canvas files mv week1/intro.pdf week1/slides --course-id 123`,
 }
 
 func init() {
@@ -42,8 +49,9 @@ func newFilesListCmd() *cobra.Command {
 	opts := &options.FilesListOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "list",
-		Short: "List files",
+		Use:         "list",
+		Short:       "List files",
+		Annotations: streamingAnnotations(),
 		Long: `List files in a course, folder, or user's files.
 
 You must specify one of --course-id, --folder-id, or --user-id.
@@ -53,7 +61,8 @@ Examples:
   canvas files list --folder-id 456
   canvas files list --user-id 789
   canvas files list --course-id 123 --search "assignment"
-  canvas files list --course-id 123 --sort name --order asc`,
+  canvas files list --course-id 123 --sort name --order asc
+  canvas files list --course-id 123 -o ndjson`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.Validate(); err != nil {
 				return err
@@ -284,6 +293,32 @@ func runFilesList(ctx context.Context, client *api.Client, opts *options.FilesLi
 		Order:        opts.Order,
 	}
 
+	// Stream files page by page for NDJSON output
+	if isStreamingOutput() {
+		var seq iter.Seq2[api.Attachment, error]
+		if opts.CourseID > 0 {
+			seq = filesService.ListCourseFilesIter(ctx, opts.CourseID, apiOpts)
+		} else if opts.FolderID > 0 {
+			seq = filesService.ListFolderFilesIter(ctx, opts.FolderID, apiOpts)
+		} else {
+			seq = filesService.ListUserFilesIter(ctx, opts.UserID, apiOpts)
+		}
+
+		count, err := streamNDJSON(seq)
+		if err != nil {
+			logger.LogCommandError(ctx, "files.list", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"folder_id": opts.FolderID,
+				"user_id":   opts.UserID,
+				"written":   count,
+			})
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "files.list", count)
+		return nil
+	}
+
 	var files []api.Attachment
 	var err error
 
@@ -0,0 +1,593 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+func init() {
+	filesCmd.AddCommand(newFilesMkdirCmd())
+	filesCmd.AddCommand(newFilesMoveCmd())
+	filesCmd.AddCommand(newFilesCopyCmd())
+	filesCmd.AddCommand(newFilesLockCmd(false))
+	filesCmd.AddCommand(newFilesLockCmd(true))
+}
+
+func newFilesMkdirCmd() *cobra.Command {
+	opts := &options.FilesMkdirOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "mkdir <path>",
+		Short: "Create a course folder",
+		Long: `Create a folder in a course's files. Paths are relative to the course's
+root folder, which may be named as "course files".
+
+With -p, missing parent folders are created too and an existing folder is
+not an error.
+
+Examples:
+  canvas files mkdir "course files/week1" --course-id 123
+  canvas files mkdir week1/slides/drafts --course-id 123 -p`,
+		Args: ExactArgsWithUsage(1, "path"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Path = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFilesMkdir(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().BoolVarP(&opts.Parents, "parents", "p", false, "Create missing parent folders; no error if the folder exists")
+	cmd.MarkFlagRequired("course-id")
+
+	return cmd
+}
+
+func newFilesMoveCmd() *cobra.Command {
+	opts := &options.FilesMoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "mv <source> <destination>",
+		Short: "Move or rename a course file or folder",
+		Long: `Move or rename a file or folder in a course's files. Paths are relative to
+the course's root folder, which may be named as "course files".
+
+If the destination is an existing folder, the source is moved into it.
+Otherwise the source is moved into the destination's parent folder and
+renamed to the destination's last path element.
+
+Examples:
+  canvas files mv "course files/syllabus.pdf" "course files/week1" --course-id 123
+  canvas files mv week1/slides week2/slides-old --course-id 123
+  canvas files mv week1/intro.pdf week1/welcome.pdf --course-id 123 --on-duplicate overwrite`,
+		Args: ExactArgsWithUsage(2, "source", "destination"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Source = args[0]
+			opts.Destination = args[1]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFilesMove(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().StringVar(&opts.OnDuplicate, "on-duplicate", "", "How to handle a file with the same name (overwrite, rename)")
+	cmd.MarkFlagRequired("course-id")
+
+	return cmd
+}
+
+func newFilesCopyCmd() *cobra.Command {
+	opts := &options.FilesCopyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "cp <source> <destination>",
+		Short: "Copy a course file or folder",
+		Long: `Copy a file, or a folder and everything in it, within a course's files.
+Paths are relative to the course's root folder, which may be named as
+"course files".
+
+If the destination is an existing folder, the source is copied into it.
+Otherwise the copy goes into the destination's parent folder and is named
+after the destination's last path element.
+
+Examples:
+  canvas files cp week1/slides week2 --course-id 123
+  canvas files cp week1/intro.pdf week2/intro.pdf --course-id 123 --on-duplicate rename
+  canvas files cp "course files/templates" "course files/week3" --course-id 123`,
+		Args: ExactArgsWithUsage(2, "source", "destination"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Source = args[0]
+			opts.Destination = args[1]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFilesCopy(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().StringVar(&opts.OnDuplicate, "on-duplicate", "", "How to handle a file with the same name (overwrite, rename)")
+	cmd.MarkFlagRequired("course-id")
+
+	return cmd
+}
+
+func newFilesLockCmd(unlock bool) *cobra.Command {
+	opts := &options.FilesLockOptions{Unlock: unlock}
+
+	cmd := &cobra.Command{
+		Use:   "lock <path>...",
+		Short: "Lock course files and folders",
+		Long: `Lock files and folders in a course's files so students can't access them,
+now or between --lock-at and --unlock-at. Paths are relative to the course's
+root folder, which may be named as "course files".
+
+A locked folder locks everything in it. With --recursive every file and
+folder below a folder is locked individually as well.
+
+Examples:
+  canvas files lock week1/answers.pdf --course-id 123
+  canvas files lock week2 week3 --course-id 123 --unlock-at 2026-09-01T08:00:00Z
+  canvas files lock "course files/exams" --course-id 123 --recursive`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Paths = args
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFilesLock(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().BoolVarP(&opts.Recursive, "recursive", "r", false, "Also apply to every file and folder below folders")
+	cmd.MarkFlagRequired("course-id")
+
+	if unlock {
+		cmd.Use = "unlock <path>..."
+		cmd.Short = "Unlock course files and folders"
+		cmd.Long = `Unlock files and folders in a course's files, clearing any scheduled lock
+dates. Paths are relative to the course's root folder, which may be named as
+"course files".
+
+With --recursive every file and folder below a folder is unlocked as well,
+including ones that were locked individually.
+
+Examples:
+  canvas files unlock week1/answers.pdf --course-id 123
+  canvas files unlock "course files/exams" --course-id 123 --recursive`
+		return cmd
+	}
+
+	cmd.Flags().StringVar(&opts.LockAt, "lock-at", "", "Lock from this date (ISO8601 format)")
+	cmd.Flags().StringVar(&opts.UnlockAt, "unlock-at", "", "Lock until this date (ISO8601 format)")
+
+	return cmd
+}
+
+func runFilesMkdir(ctx context.Context, client *api.Client, opts *options.FilesMkdirOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "files.mkdir", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"path":      opts.Path,
+		"parents":   opts.Parents,
+	})
+
+	folders := api.NewFoldersService(client)
+
+	parentPath, name := splitCoursePath(opts.Path)
+	if name == "" {
+		return fmt.Errorf("the course root folder already exists")
+	}
+
+	existing, err := folders.ResolveCoursePath(ctx, opts.CourseID, opts.Path)
+	if err == nil {
+		if !opts.Parents {
+			return fmt.Errorf("folder %s already exists", existing.FullName)
+		}
+		logger.LogCommandComplete(ctx, "files.mkdir", 0)
+		return formatSuccessOutput(existing, fmt.Sprintf("Folder %s already exists", existing.FullName))
+	}
+	if !api.IsNotFoundError(err) {
+		logger.LogCommandError(ctx, "files.mkdir", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"path":      opts.Path,
+		})
+		return fmt.Errorf("failed to look up folder: %w", err)
+	}
+
+	var folder *api.Folder
+	if opts.Parents {
+		// Canvas creates the missing folders along the parent path
+		folder, err = folders.CreateInCourse(ctx, opts.CourseID, &api.FolderParams{
+			Name:             name,
+			ParentFolderPath: parentPath,
+		})
+	} else {
+		var parent *api.Folder
+		parent, err = folders.ResolveCoursePath(ctx, opts.CourseID, parentPath)
+		if err != nil {
+			if api.IsNotFoundError(err) {
+				return fmt.Errorf("parent folder %s does not exist (use -p to create it)", displayCoursePath(parentPath))
+			}
+			logger.LogCommandError(ctx, "files.mkdir", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"path":      parentPath,
+			})
+			return fmt.Errorf("failed to look up parent folder: %w", err)
+		}
+		folder, err = folders.Create(ctx, parent.ID, &api.FolderParams{Name: name})
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "files.mkdir", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"path":      opts.Path,
+		})
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "files.mkdir", 1)
+	return formatSuccessOutput(folder, fmt.Sprintf("Created folder %s", folder.FullName))
+}
+
+func runFilesMove(ctx context.Context, client *api.Client, opts *options.FilesMoveOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "files.mv", map[string]interface{}{
+		"course_id":   opts.CourseID,
+		"source":      opts.Source,
+		"destination": opts.Destination,
+	})
+
+	folders := api.NewFoldersService(client)
+
+	source, dest, name, err := resolveCourseTransfer(ctx, folders, opts.CourseID, opts.Source, opts.Destination)
+	if err != nil {
+		logger.LogCommandError(ctx, "files.mv", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return err
+	}
+
+	var result interface{}
+	var target string
+	if source.File != nil {
+		file, err := api.NewFilesService(client).Update(ctx, source.File.ID, &api.UpdateParams{
+			Name:           name,
+			ParentFolderID: &dest.ID,
+			OnDuplicate:    opts.OnDuplicate,
+		})
+		if err != nil {
+			logger.LogCommandError(ctx, "files.mv", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"file_id":   source.File.ID,
+			})
+			return fmt.Errorf("failed to move file: %w", err)
+		}
+		result, target = file, dest.FullName+"/"+file.DisplayName
+	} else {
+		folder, err := folders.Update(ctx, source.Folder.ID, &api.FolderParams{
+			Name:           name,
+			ParentFolderID: &dest.ID,
+		})
+		if err != nil {
+			logger.LogCommandError(ctx, "files.mv", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"folder_id": source.Folder.ID,
+			})
+			return fmt.Errorf("failed to move folder: %w", err)
+		}
+		result, target = folder, folder.FullName
+	}
+
+	logger.LogCommandComplete(ctx, "files.mv", 1)
+	return formatSuccessOutput(result, fmt.Sprintf("Moved %s to %s", source.Path, target))
+}
+
+func runFilesCopy(ctx context.Context, client *api.Client, opts *options.FilesCopyOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "files.cp", map[string]interface{}{
+		"course_id":   opts.CourseID,
+		"source":      opts.Source,
+		"destination": opts.Destination,
+	})
+
+	folders := api.NewFoldersService(client)
+
+	source, dest, name, err := resolveCourseTransfer(ctx, folders, opts.CourseID, opts.Source, opts.Destination)
+	if err != nil {
+		logger.LogCommandError(ctx, "files.cp", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return err
+	}
+
+	var result interface{}
+	var target string
+	if source.File != nil {
+		file, err := folders.CopyFile(ctx, source.File.ID, dest.ID, opts.OnDuplicate)
+		if err == nil && name != "" && name != file.DisplayName {
+			file, err = api.NewFilesService(client).Update(ctx, file.ID, &api.UpdateParams{
+				Name:        name,
+				OnDuplicate: opts.OnDuplicate,
+			})
+		}
+		if err != nil {
+			logger.LogCommandError(ctx, "files.cp", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"file_id":   source.File.ID,
+			})
+			return fmt.Errorf("failed to copy file: %w", err)
+		}
+		result, target = file, dest.FullName+"/"+file.DisplayName
+	} else {
+		folder, err := folders.Copy(ctx, source.Folder.ID, dest.ID)
+		if err == nil && name != "" && name != folder.Name {
+			folder, err = folders.Update(ctx, folder.ID, &api.FolderParams{Name: name})
+		}
+		if err != nil {
+			logger.LogCommandError(ctx, "files.cp", err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"folder_id": source.Folder.ID,
+			})
+			return fmt.Errorf("failed to copy folder: %w", err)
+		}
+		result, target = folder, folder.FullName
+	}
+
+	logger.LogCommandComplete(ctx, "files.cp", 1)
+	return formatSuccessOutput(result, fmt.Sprintf("Copied %s to %s", source.Path, target))
+}
+
+func runFilesLock(ctx context.Context, client *api.Client, opts *options.FilesLockOptions) error {
+	action, verb := "lock", "Locked"
+	if opts.Unlock {
+		action, verb = "unlock", "Unlocked"
+	}
+	command := "files." + action
+
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, command, map[string]interface{}{
+		"course_id": opts.CourseID,
+		"paths":     opts.Paths,
+		"recursive": opts.Recursive,
+	})
+
+	folders := api.NewFoldersService(client)
+	files := api.NewFilesService(client)
+
+	var entries []*courseEntry
+	for _, path := range opts.Paths {
+		entry, err := resolveCourseEntry(ctx, folders, opts.CourseID, path)
+		if err != nil {
+			logger.LogCommandError(ctx, command, err, map[string]interface{}{
+				"course_id": opts.CourseID,
+				"path":      path,
+			})
+			return err
+		}
+		entries = append(entries, entry)
+
+		if opts.Recursive && entry.Folder != nil {
+			below, err := courseFolderContents(ctx, folders, files, entry.Folder)
+			if err != nil {
+				logger.LogCommandError(ctx, command, err, map[string]interface{}{
+					"course_id": opts.CourseID,
+					"folder_id": entry.Folder.ID,
+				})
+				return fmt.Errorf("failed to list the contents of %s: %w", entry.Path, err)
+			}
+			entries = append(entries, below...)
+		}
+	}
+
+	locked := !opts.Unlock
+	var lockAt, unlockAt *string
+	if opts.Unlock {
+		// Clear scheduled locks too, so the item stays unlocked
+		empty := ""
+		lockAt, unlockAt = &empty, &empty
+	} else if opts.LockAt != "" || opts.UnlockAt != "" {
+		// Canvas locks the item between the dates instead of right away
+		lockAt, unlockAt = &opts.LockAt, &opts.UnlockAt
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		var err error
+		if entry.File != nil {
+			params := &api.UpdateParams{LockAt: lockAt, UnlockAt: unlockAt}
+			if lockAt == nil || opts.Unlock {
+				params.Locked = &locked
+			}
+			_, err = files.Update(ctx, entry.File.ID, params)
+		} else {
+			params := &api.FolderParams{LockAt: lockAt, UnlockAt: unlockAt}
+			if lockAt == nil || opts.Unlock {
+				params.Locked = &locked
+			}
+			_, err = folders.Update(ctx, entry.Folder.ID, params)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Path, err))
+			continue
+		}
+		printVerbose("%s %s\n", verb, entry.Path)
+	}
+
+	succeeded := len(entries) - len(errs)
+	fmt.Printf("%s %d of %d files and folders\n", verb, succeeded, len(entries))
+	if len(errs) > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range errs {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, command, succeeded)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s completed with %d errors", action, len(errs))
+	}
+
+	return nil
+}
+
+// courseEntry is a course file or folder found by its course-relative path
+type courseEntry struct {
+	Path   string
+	Folder *api.Folder
+	File   *api.Attachment
+}
+
+// splitCoursePath splits a course-relative path into its parent folder path
+// and last element. Both are empty for the root folder.
+func splitCoursePath(path string) (string, string) {
+	rel := api.CourseFolderPath(path)
+	if i := strings.LastIndex(rel, "/"); i >= 0 {
+		return rel[:i], rel[i+1:]
+	}
+	return "", rel
+}
+
+// displayCoursePath shows a course-relative path the way Canvas names it
+func displayCoursePath(path string) string {
+	if rel := api.CourseFolderPath(path); rel != "" {
+		return "course files/" + rel
+	}
+	return "course files"
+}
+
+// resolveCourseEntry finds the folder or, failing that, the file at a
+// course-relative path
+func resolveCourseEntry(ctx context.Context, folders *api.FoldersService, courseID int64, path string) (*courseEntry, error) {
+	folder, err := folders.ResolveCoursePath(ctx, courseID, path)
+	if err == nil {
+		return &courseEntry{Path: folder.FullName, Folder: folder}, nil
+	}
+	if !api.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to look up %s: %w", path, err)
+	}
+
+	parentPath, name := splitCoursePath(path)
+	parent, err := folders.ResolveCoursePath(ctx, courseID, parentPath)
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return nil, fmt.Errorf("no such file or folder: %s", displayCoursePath(path))
+		}
+		return nil, fmt.Errorf("failed to look up %s: %w", parentPath, err)
+	}
+
+	file, err := folders.FindFile(ctx, parent.ID, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %s: %w", path, err)
+	}
+	if file == nil {
+		return nil, fmt.Errorf("no such file or folder: %s", displayCoursePath(path))
+	}
+
+	return &courseEntry{Path: parent.FullName + "/" + file.DisplayName, File: file}, nil
+}
+
+// resolveCourseTransfer finds the source of a move or copy, the folder it
+// goes into and, when the destination isn't an existing folder, the name it
+// gets there
+func resolveCourseTransfer(ctx context.Context, folders *api.FoldersService, courseID int64, sourcePath, destPath string) (*courseEntry, *api.Folder, string, error) {
+	source, err := resolveCourseEntry(ctx, folders, courseID, sourcePath)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if source.Folder != nil && source.Folder.IsRoot() {
+		return nil, nil, "", fmt.Errorf("the course root folder cannot be moved or copied")
+	}
+
+	var name string
+	dest, err := folders.ResolveCoursePath(ctx, courseID, destPath)
+	if err != nil {
+		if !api.IsNotFoundError(err) {
+			return nil, nil, "", fmt.Errorf("failed to look up %s: %w", destPath, err)
+		}
+
+		var parentPath string
+		parentPath, name = splitCoursePath(destPath)
+		dest, err = folders.ResolveCoursePath(ctx, courseID, parentPath)
+		if err != nil {
+			if api.IsNotFoundError(err) {
+				return nil, nil, "", fmt.Errorf("destination folder %s does not exist", displayCoursePath(parentPath))
+			}
+			return nil, nil, "", fmt.Errorf("failed to look up %s: %w", parentPath, err)
+		}
+	}
+
+	if source.Folder != nil && (dest.ID == source.Folder.ID || strings.HasPrefix(dest.FullName, source.Folder.FullName+"/")) {
+		return nil, nil, "", fmt.Errorf("cannot put %s inside itself", source.Path)
+	}
+
+	return source, dest, name, nil
+}
+
+// courseFolderContents lists every folder and file below a folder
+func courseFolderContents(ctx context.Context, folders *api.FoldersService, files *api.FilesService, folder *api.Folder) ([]*courseEntry, error) {
+	subfolders, err := folders.ListRecursive(ctx, folder.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*courseEntry
+	for _, f := range append([]api.Folder{*folder}, subfolders...) {
+		if f.ID != folder.ID {
+			entries = append(entries, &courseEntry{Path: f.FullName, Folder: &f})
+		}
+		if f.FilesCount == 0 {
+			continue
+		}
+
+		attachments, err := files.ListFolderFiles(ctx, f.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i := range attachments {
+			entries = append(entries, &courseEntry{Path: f.FullName + "/" + attachments[i].DisplayName, File: &attachments[i]})
+		}
+	}
+
+	return entries, nil
+}
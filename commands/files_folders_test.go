@@ -0,0 +1,234 @@
+package commands
+
+import (
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+const (
+	rootFolderJSON  = `{"id":1,"name":"course files","full_name":"course files","parent_folder_id":null}`
+	week1FolderJSON = `{"id":10,"name":"week1","full_name":"course files/week1","parent_folder_id":1,"files_count":1}`
+	week2FolderJSON = `{"id":20,"name":"week2","full_name":"course files/week2","parent_folder_id":1}`
+)
+
+func TestFilesMkdirCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "create in existing parent",
+			Args: []string{"week1/slides", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1/slides": cmdtest.NewErrorResponse(404, "not found"),
+				"/api/v1/courses/123/folders/by_path/week1":        cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/folders/10/folders":                       cmdtest.NewMockResponse(`{"id":11,"name":"slides","full_name":"course files/week1/slides","parent_folder_id":10}`),
+			},
+			ExpectOutput: "Created folder course files/week1/slides",
+		},
+		{
+			Name: "missing parent without -p",
+			Args: []string{"week3/slides", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path": cmdtest.NewErrorResponse(404, "not found"),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "create parents",
+			Args: []string{"course files/week3/slides", "--course-id", "123", "-p"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path": cmdtest.NewErrorResponse(404, "not found"),
+				"/api/v1/courses/123/folders":         cmdtest.NewMockResponse(`{"id":31,"name":"slides","full_name":"course files/week3/slides","parent_folder_id":30}`),
+			},
+			ExpectOutput: "Created folder course files/week3/slides",
+		},
+		{
+			Name: "existing folder with -p",
+			Args: []string{"week1", "--course-id", "123", "-p"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+			},
+			ExpectOutput: "Folder course files/week1 already exists",
+		},
+		{
+			Name: "existing folder without -p",
+			Args: []string{"week1", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newFilesMkdirCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestFilesMoveCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "move file into folder",
+			Args: []string{"week1/intro.pdf", "course files/week2", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1/intro.pdf": cmdtest.NewErrorResponse(404, "not found"),
+				"/api/v1/courses/123/folders/by_path/week1":           cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/courses/123/folders/by_path/week2":           cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week2FolderJSON + `]`),
+				"/api/v1/folders/10/files":                            cmdtest.NewMockResponse(`[{"id":55,"display_name":"intro.pdf","folder_id":10}]`),
+				"/api/v1/files/55":                                    cmdtest.NewMockResponse(`{"id":55,"display_name":"intro.pdf","folder_id":20}`),
+			},
+			ExpectOutput: "Moved course files/week1/intro.pdf to course files/week2/intro.pdf",
+		},
+		{
+			Name: "rename folder",
+			Args: []string{"week1", "week1-old", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1-old": cmdtest.NewErrorResponse(404, "not found"),
+				"/api/v1/courses/123/folders/by_path/week1":     cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/courses/123/folders/by_path":           cmdtest.NewMockResponse(`[` + rootFolderJSON + `]`),
+				"/api/v1/folders/10":                            cmdtest.NewMockResponse(`{"id":10,"name":"week1-old","full_name":"course files/week1-old","parent_folder_id":1}`),
+			},
+			ExpectOutput: "Moved course files/week1 to course files/week1-old",
+		},
+		{
+			Name: "folder into itself",
+			Args: []string{"week1", "week1", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "missing source",
+			Args: []string{"week1/missing.pdf", "week2", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1/missing.pdf": cmdtest.NewErrorResponse(404, "not found"),
+				"/api/v1/courses/123/folders/by_path/week1":             cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/folders/10/files":                              cmdtest.NewMockResponse(`[{"id":55,"display_name":"intro.pdf","folder_id":10}]`),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid on-duplicate",
+			Args:        []string{"week1", "week2", "--course-id", "123", "--on-duplicate", "skip"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newFilesMoveCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestFilesCopyCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "copy file with new name",
+			Args: []string{"week1/intro.pdf", "week2/welcome.pdf", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1/intro.pdf":   cmdtest.NewErrorResponse(404, "not found"),
+				"/api/v1/courses/123/folders/by_path/week2/welcome.pdf": cmdtest.NewErrorResponse(404, "not found"),
+				"/api/v1/courses/123/folders/by_path/week1":             cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/courses/123/folders/by_path/week2":             cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week2FolderJSON + `]`),
+				"/api/v1/folders/10/files":                              cmdtest.NewMockResponse(`[{"id":55,"display_name":"intro.pdf","folder_id":10}]`),
+				"/api/v1/folders/20/copy_file":                          cmdtest.NewMockResponse(`{"id":56,"display_name":"intro.pdf","folder_id":20}`),
+				"/api/v1/files/56":                                      cmdtest.NewMockResponse(`{"id":56,"display_name":"welcome.pdf","folder_id":20}`),
+			},
+			ExpectOutput: "Copied course files/week1/intro.pdf to course files/week2/welcome.pdf",
+		},
+		{
+			Name: "copy folder",
+			Args: []string{"week1", "week2", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/courses/123/folders/by_path/week2": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week2FolderJSON + `]`),
+				"/api/v1/folders/20/copy_folder":            cmdtest.NewMockResponse(`{"id":21,"name":"week1","full_name":"course files/week2/week1","parent_folder_id":20}`),
+			},
+			ExpectOutput: "Copied course files/week1 to course files/week2/week1",
+		},
+		{
+			Name: "root folder",
+			Args: []string{"course files", "week2", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path": cmdtest.NewMockResponse(`[` + rootFolderJSON + `]`),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newFilesCopyCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestFilesLockCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "lock folder recursively",
+			Args: []string{"week1", "--course-id", "123", "--recursive"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/folders/10/folders":                cmdtest.NewMockResponse(`[]`),
+				"/api/v1/folders/10/files":                  cmdtest.NewMockResponse(`[{"id":55,"display_name":"intro.pdf","folder_id":10}]`),
+				"/api/v1/folders/10":                        cmdtest.NewMockResponse(week1FolderJSON),
+				"/api/v1/files/55":                          cmdtest.NewMockResponse(`{"id":55,"display_name":"intro.pdf","locked":true}`),
+			},
+			ExpectOutput: "Locked 2 of 2 files and folders",
+		},
+		{
+			Name: "failed lock",
+			Args: []string{"week1", "--course-id", "123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/folders/10":                        cmdtest.NewErrorResponse(403, "unauthorized"),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newFilesLockCmd(false)
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestFilesUsageRightsSetCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "set on folder",
+			Args: []string{"week1", "--course-id", "123", "--justification", "own_copyright"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/folders/by_path/week1": cmdtest.NewMockResponse(`[` + rootFolderJSON + `,` + week1FolderJSON + `]`),
+				"/api/v1/courses/123/usage_rights":          cmdtest.NewMockResponse(`{"use_justification":"own_copyright","message":"2 files updated","file_ids":[55,56]}`),
+			},
+			ExpectOutput: "Usage rights set on 2 files",
+		},
+		{
+			Name:        "creative commons requires license",
+			Args:        []string{"week1", "--course-id", "123", "--justification", "creative_commons"},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid justification",
+			Args:        []string{"week1", "--course-id", "123", "--justification", "mine"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newFilesUsageRightsSetCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
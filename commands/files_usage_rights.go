@@ -0,0 +1,250 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// filesUsageRightsCmd represents the files usage-rights command group
+var filesUsageRightsCmd = &cobra.Command{
+	Use:   "usage-rights",
+	Short: "Manage the usage rights of course files",
+	Long: `Manage the copyright and license of files in a course. Canvas requires
+usage rights before files can be published in courses that enforce them.
+
+Paths are relative to the course's root folder, which may be named as
+"course files". Setting rights on a folder applies them to every file in it.
+
+Examples:
+  canvas files usage-rights set week1 --course-id 123 --justification own_copyright --copyright "2026 Jane Doe"
+  canvas files usage-rights set week1/map.png --course-id 123 --justification creative_commons --license cc_by_sa
+  canvas files usage-rights remove week1 --course-id 123
+  canvas files usage-rights licenses --course-id 123`,
+}
+
+func init() {
+	filesCmd.AddCommand(filesUsageRightsCmd)
+	filesUsageRightsCmd.AddCommand(newFilesUsageRightsSetCmd())
+	filesUsageRightsCmd.AddCommand(newFilesUsageRightsRemoveCmd())
+	filesUsageRightsCmd.AddCommand(newFilesUsageRightsLicensesCmd())
+}
+
+func newFilesUsageRightsSetCmd() *cobra.Command {
+	opts := &options.FilesUsageRightsSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <path>...",
+		Short: "Set the usage rights of course files",
+		Long: `Set the usage rights of course files and folders.
+
+Justifications: own_copyright, used_by_permission, fair_use, public_domain,
+creative_commons. creative_commons requires --license; see 'canvas files
+usage-rights licenses' for the licenses available.
+
+Examples:
+  canvas files usage-rights set week1 week2 --course-id 123 --justification own_copyright
+  canvas files usage-rights set week1/map.png --course-id 123 --justification creative_commons --license cc_by_sa
+  canvas files usage-rights set "course files/readings" --course-id 123 --justification fair_use --publish`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Paths = args
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFilesUsageRightsSet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().StringVar(&opts.Justification, "justification", "", "Use justification (required)")
+	cmd.Flags().StringVar(&opts.Copyright, "copyright", "", "Copyright holder")
+	cmd.Flags().StringVar(&opts.License, "license", "", "Creative Commons license, e.g. cc_by_sa")
+	cmd.Flags().BoolVar(&opts.Publish, "publish", false, "Also publish the files")
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("justification")
+
+	return cmd
+}
+
+func newFilesUsageRightsRemoveCmd() *cobra.Command {
+	opts := &options.FilesUsageRightsRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <path>...",
+		Short: "Remove the usage rights of course files",
+		Long: `Remove the usage rights of course files and folders.
+
+Examples:
+  canvas files usage-rights remove week1/map.png --course-id 123
+  canvas files usage-rights remove week1 week2 --course-id 123`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Paths = args
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFilesUsageRightsRemove(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.MarkFlagRequired("course-id")
+
+	return cmd
+}
+
+func newFilesUsageRightsLicensesCmd() *cobra.Command {
+	opts := &options.FilesUsageRightsLicensesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "licenses",
+		Short: "List the licenses available to a course",
+		Long: `List the Creative Commons licenses that can be applied to course files.
+
+Examples:
+  canvas files usage-rights licenses --course-id 123`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runFilesUsageRightsLicenses(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.MarkFlagRequired("course-id")
+
+	return cmd
+}
+
+func runFilesUsageRightsSet(ctx context.Context, client *api.Client, opts *options.FilesUsageRightsSetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "files.usage_rights.set", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"paths":         opts.Paths,
+		"justification": opts.Justification,
+	})
+
+	fileIDs, folderIDs, err := resolveCourseEntryIDs(ctx, client, opts.CourseID, opts.Paths)
+	if err != nil {
+		logger.LogCommandError(ctx, "files.usage_rights.set", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return err
+	}
+
+	service := api.NewUsageRightsService(client)
+
+	rights, err := service.SetCourse(ctx, opts.CourseID, &api.SetUsageRightsParams{
+		FileIDs:          fileIDs,
+		FolderIDs:        folderIDs,
+		UseJustification: opts.Justification,
+		LegalCopyright:   opts.Copyright,
+		License:          opts.License,
+		Publish:          opts.Publish,
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "files.usage_rights.set", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to set usage rights: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "files.usage_rights.set", len(rights.FileIDs))
+	return formatSuccessOutput(rights, fmt.Sprintf("Usage rights set on %d files", len(rights.FileIDs)))
+}
+
+func runFilesUsageRightsRemove(ctx context.Context, client *api.Client, opts *options.FilesUsageRightsRemoveOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "files.usage_rights.remove", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"paths":     opts.Paths,
+	})
+
+	fileIDs, folderIDs, err := resolveCourseEntryIDs(ctx, client, opts.CourseID, opts.Paths)
+	if err != nil {
+		logger.LogCommandError(ctx, "files.usage_rights.remove", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return err
+	}
+
+	service := api.NewUsageRightsService(client)
+
+	result, err := service.RemoveCourse(ctx, opts.CourseID, fileIDs, folderIDs)
+	if err != nil {
+		logger.LogCommandError(ctx, "files.usage_rights.remove", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to remove usage rights: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "files.usage_rights.remove", len(result.FileIDs))
+	return formatSuccessOutput(result, fmt.Sprintf("Usage rights removed from %d files", len(result.FileIDs)))
+}
+
+func runFilesUsageRightsLicenses(ctx context.Context, client *api.Client, opts *options.FilesUsageRightsLicensesOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "files.usage_rights.licenses", map[string]interface{}{
+		"course_id": opts.CourseID,
+	})
+
+	service := api.NewUsageRightsService(client)
+
+	licenses, err := service.ListCourseLicenses(ctx, opts.CourseID)
+	if err != nil {
+		logger.LogCommandError(ctx, "files.usage_rights.licenses", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to list licenses: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "files.usage_rights.licenses", len(licenses))
+	return formatEmptyOrOutput(licenses, "No licenses found")
+}
+
+// resolveCourseEntryIDs resolves course-relative paths to file and folder IDs
+func resolveCourseEntryIDs(ctx context.Context, client *api.Client, courseID int64, paths []string) ([]int64, []int64, error) {
+	folders := api.NewFoldersService(client)
+
+	var fileIDs, folderIDs []int64
+	for _, path := range paths {
+		entry, err := resolveCourseEntry(ctx, folders, courseID, path)
+		if err != nil {
+			return nil, nil, err
+		}
+		if entry.File != nil {
+			fileIDs = append(fileIDs, entry.File.ID)
+		} else {
+			folderIDs = append(folderIDs, entry.Folder.ID)
+		}
+	}
+
+	return fileIDs, folderIDs, nil
+}
@@ -10,20 +10,23 @@ import (
 	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
 	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
 	"github.com/jjuanrivvera/canvas-cli/internal/api"
+	"github.com/jjuanrivvera/canvas-cli/internal/batch"
 )
 
 // gradesCmd represents the grades command group
 var gradesCmd = &cobra.Command{
 	Use:   "grades",
 	Short: "Manage Canvas gradebook",
-	Long: `Manage Canvas gradebook history and custom columns.
+	Long: `Manage Canvas gradebook history, custom columns, and grade posting.
 
-View gradebook history, manage custom gradebook columns, and update grades.
+View gradebook history, manage custom gradebook columns, update grades, and
+post or hide grades from students.
 
 Examples:
   canvas grades history --course-id 123
   canvas grades feed --course-id 123 --user-id 456
-  canvas grades columns list --course-id 123`,
+  canvas grades columns list --course-id 123
+  canvas grades post --course-id 123 --assignment-id 456`,
 }
 
 // gradesColumnsCmd represents the grades columns command group
@@ -54,6 +57,7 @@ func init() {
 	rootCmd.AddCommand(gradesCmd)
 	gradesCmd.AddCommand(newGradesHistoryCmd())
 	gradesCmd.AddCommand(newGradesFeedCmd())
+	gradesCmd.AddCommand(newGradesBulkUpdateCmd())
 	gradesCmd.AddCommand(gradesColumnsCmd)
 
 	gradesColumnsCmd.AddCommand(newGradesColumnsListCmd())
@@ -136,6 +140,47 @@ Examples:
 	return cmd
 }
 
+func newGradesBulkUpdateCmd() *cobra.Command {
+	opts := &options.GradesBulkUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "bulk-update",
+		Short: "Update many grades in a single request",
+		Long: `Update many grades in a single asynchronous request.
+
+Unlike 'submissions bulk-grade', which grades one submission per request,
+this submits every grade at once and Canvas applies them in the background.
+Use --wait to block until the job finishes.
+
+The CSV file should have the following format:
+  user_id,assignment_id,grade
+
+Examples:
+  canvas grades bulk-update --course-id 123 --csv grades.csv
+  canvas grades bulk-update --course-id 123 --csv grades.csv --wait --timeout 5m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradesBulkUpdate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().StringVar(&opts.CSV, "csv", "", "CSV file with grades (required)")
+	addWaitFlags(cmd, &opts.WaitOptions)
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("csv")
+
+	return cmd
+}
+
 func newGradesColumnsListCmd() *cobra.Command {
 	opts := &options.GradesColumnsListOptions{}
 
@@ -702,3 +747,63 @@ func runGradesColumnsDataSet(ctx context.Context, client *api.Client, opts *opti
 	logger.LogCommandComplete(ctx, "grades.columns.data.set", 1)
 	return formatOutput(datum, nil)
 }
+
+func runGradesBulkUpdate(ctx context.Context, client *api.Client, opts *options.GradesBulkUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "grades.bulk_update", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"csv_file":  opts.CSV,
+	})
+
+	records, err := batch.ReadGradesCSV(opts.CSV)
+	if err != nil {
+		logger.LogCommandError(ctx, "grades.bulk_update", err, map[string]interface{}{
+			"csv_file": opts.CSV,
+		})
+		return fmt.Errorf("failed to read CSV file: %w", err)
+	}
+
+	if len(records) == 0 {
+		return fmt.Errorf("no grades found in CSV file")
+	}
+
+	grades := make([]api.BulkUpdateGrade, 0, len(records))
+	for _, record := range records {
+		grades = append(grades, api.BulkUpdateGrade{
+			StudentID:    record.UserID,
+			AssignmentID: record.AssignmentID,
+			Grade:        record.Grade,
+		})
+	}
+
+	service := api.NewGradesService(client)
+
+	progress, err := service.BulkUpdateGrades(ctx, opts.CourseID, grades)
+	if err != nil {
+		logger.LogCommandError(ctx, "grades.bulk_update", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to bulk update grades: %w", err)
+	}
+
+	fmt.Printf("Grade update started for %d grades (Progress ID: %d)\n", len(grades), progress.ID)
+	fmt.Printf("Workflow state: %s\n", progress.WorkflowState)
+
+	if opts.Wait {
+		progressService := api.NewProgressService(client)
+		if _, err := waitForJob(ctx, opts.WaitOptions, func(ctx context.Context) (*api.JobProgress, error) {
+			return progressService.Get(ctx, progress.ID)
+		}); err != nil {
+			logger.LogCommandError(ctx, "grades.bulk_update", err, map[string]interface{}{
+				"course_id":   opts.CourseID,
+				"progress_id": progress.ID,
+			})
+			return fmt.Errorf("grade update did not complete: %w", err)
+		}
+
+		fmt.Println("Grade update completed")
+	}
+
+	logger.LogCommandComplete(ctx, "grades.bulk_update", len(grades))
+	return nil
+}
@@ -0,0 +1,373 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// gradesPostPolicyCmd represents the grades post-policy command group
+var gradesPostPolicyCmd = &cobra.Command{
+	Use:   "post-policy",
+	Short: "Manage grade post policies",
+	Long: `Manage whether grades are shown to students automatically as they are
+entered, or only once they are posted with 'canvas grades post'.
+
+Assignments without a policy of their own follow the course policy.
+
+Examples:
+  canvas grades post-policy get --course-id 123
+  canvas grades post-policy set --course-id 123 --manual
+  canvas grades post-policy set --assignment-id 456 --automatic`,
+}
+
+func init() {
+	gradesCmd.AddCommand(newGradesPostCmd())
+	gradesCmd.AddCommand(newGradesHideCmd())
+	gradesCmd.AddCommand(gradesPostPolicyCmd)
+	gradesPostPolicyCmd.AddCommand(newGradesPostPolicyGetCmd())
+	gradesPostPolicyCmd.AddCommand(newGradesPostPolicySetCmd())
+}
+
+func newGradesPostCmd() *cobra.Command {
+	opts := &options.GradesPostOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "post",
+		Short: "Post assignment grades to students",
+		Long: `Post the grades of one or more assignments, making them visible to
+students. Use --all to post every published assignment of the course, e.g.
+to release grades at the end of a term.
+
+Canvas posts grades in the background; use --wait to block until done.
+
+Examples:
+  canvas grades post --course-id 123 --assignment-id 456
+  canvas grades post --course-id 123 --assignment-id 456 --section-id 11,12 --graded-only
+  canvas grades post --course-id 123 --all --wait
+  canvas grades post --course-id 123 --all --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradesPost(cmd.Context(), client, opts, true)
+		},
+	}
+
+	addGradesPostFlags(cmd, opts)
+	cmd.Flags().BoolVar(&opts.GradedOnly, "graded-only", false, "Post only submissions that have been graded")
+
+	return cmd
+}
+
+func newGradesHideCmd() *cobra.Command {
+	opts := &options.GradesPostOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "hide",
+		Short: "Hide assignment grades from students",
+		Long: `Hide the grades of one or more assignments from students until they are
+posted again.
+
+Canvas hides grades in the background; use --wait to block until done.
+
+Examples:
+  canvas grades hide --course-id 123 --assignment-id 456
+  canvas grades hide --course-id 123 --assignment-id 456 --section-id 11
+  canvas grades hide --course-id 123 --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradesPost(cmd.Context(), client, opts, false)
+		},
+	}
+
+	addGradesPostFlags(cmd, opts)
+
+	return cmd
+}
+
+// addGradesPostFlags registers the flags shared by grades post and hide
+func addGradesPostFlags(cmd *cobra.Command, opts *options.GradesPostOptions) {
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().Int64SliceVar(&opts.AssignmentIDs, "assignment-id", nil, "Assignment IDs (comma-separated)")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "All published assignments of the course")
+	cmd.Flags().Int64SliceVar(&opts.SectionIDs, "section-id", nil, "Limit to these section IDs (comma-separated)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the assignments that would be affected without changing them")
+	addWaitFlags(cmd, &opts.WaitOptions)
+	cmd.MarkFlagRequired("course-id")
+}
+
+func newGradesPostPolicyGetCmd() *cobra.Command {
+	opts := &options.GradesPostPolicyGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get a course or assignment post policy",
+		Long: `Get the post policy of a course, or of an assignment with --assignment-id.
+
+Examples:
+  canvas grades post-policy get --course-id 123
+  canvas grades post-policy get --assignment-id 456`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradesPostPolicyGet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID")
+
+	return cmd
+}
+
+func newGradesPostPolicySetCmd() *cobra.Command {
+	opts := &options.GradesPostPolicySetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set a course or assignment post policy",
+		Long: `Set the post policy of a course, or of an assignment with --assignment-id.
+
+Setting the course policy also applies it to every assignment that does not
+have a policy of its own.
+
+Examples:
+  canvas grades post-policy set --course-id 123 --manual
+  canvas grades post-policy set --assignment-id 456 --automatic`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradesPostPolicySet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID")
+	cmd.Flags().BoolVar(&opts.Manual, "manual", false, "Hide grades until they are posted")
+	cmd.Flags().BoolVar(&opts.Automatic, "automatic", false, "Show grades as soon as they are entered")
+
+	return cmd
+}
+
+func runGradesPost(ctx context.Context, client *api.Client, opts *options.GradesPostOptions, post bool) error {
+	command, verb, past := "grades.post", "post", "Posted"
+	if !post {
+		command, verb, past = "grades.hide", "hide", "Hid"
+	}
+
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, command, map[string]interface{}{
+		"course_id":      opts.CourseID,
+		"assignment_ids": opts.AssignmentIDs,
+		"all":            opts.All,
+		"section_ids":    opts.SectionIDs,
+	})
+
+	assignments, err := resolvePostAssignments(ctx, client, opts)
+	if err != nil {
+		logger.LogCommandError(ctx, command, err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return err
+	}
+
+	if len(assignments) == 0 {
+		fmt.Println("No published assignments found")
+		logger.LogCommandComplete(ctx, command, 0)
+		return nil
+	}
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+		fmt.Printf("\nWould %s grades of %d assignments:\n", verb, len(assignments))
+		for _, assignment := range assignments {
+			fmt.Printf("  %d: %s\n", assignment.ID, assignment.Name)
+		}
+		logger.LogCommandComplete(ctx, command, 0)
+		return nil
+	}
+
+	service := api.NewPostPoliciesService(client)
+	postOpts := &api.PostGradesOptions{
+		SectionIDs: opts.SectionIDs,
+		GradedOnly: opts.GradedOnly,
+	}
+
+	var errs []error
+	for _, assignment := range assignments {
+		if err := postAssignmentGrades(ctx, client, service, opts.CourseID, assignment, postOpts, post, opts.WaitOptions); err != nil {
+			errs = append(errs, fmt.Errorf("assignment %d: %w", assignment.ID, err))
+			continue
+		}
+		fmt.Printf("%s grades of assignment %d (%s)\n", past, assignment.ID, assignment.Name)
+	}
+
+	succeeded := len(assignments) - len(errs)
+	if len(assignments) > 1 {
+		fmt.Printf("\n%s grades of %d of %d assignments\n", past, succeeded, len(assignments))
+	}
+	if len(errs) > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range errs {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, command, succeeded)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to %s grades of %d assignments", verb, len(errs))
+	}
+
+	return nil
+}
+
+// resolvePostAssignments returns the assignments named by --assignment-id, or
+// every published assignment of the course with --all
+func resolvePostAssignments(ctx context.Context, client *api.Client, opts *options.GradesPostOptions) ([]api.Assignment, error) {
+	if !opts.All {
+		assignments := make([]api.Assignment, len(opts.AssignmentIDs))
+		for i, id := range opts.AssignmentIDs {
+			assignments[i] = api.Assignment{ID: id}
+		}
+		return assignments, nil
+	}
+
+	all, err := api.NewAssignmentsService(client).List(ctx, opts.CourseID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+
+	var published []api.Assignment
+	for _, assignment := range all {
+		if assignment.Published {
+			published = append(published, assignment)
+		}
+	}
+
+	return published, nil
+}
+
+// postAssignmentGrades posts or hides the grades of one assignment, waiting
+// for the background job when requested
+func postAssignmentGrades(ctx context.Context, client *api.Client, service *api.PostPoliciesService, courseID int64, assignment api.Assignment, postOpts *api.PostGradesOptions, post bool, wait options.WaitOptions) error {
+	var progress *api.JobProgress
+	var err error
+	if post {
+		progress, err = service.PostGrades(ctx, courseID, assignment.ID, postOpts)
+	} else {
+		progress, err = service.HideGrades(ctx, courseID, assignment.ID, postOpts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !wait.Wait {
+		return nil
+	}
+
+	progressService := api.NewProgressService(client)
+	_, err = waitForJob(ctx, wait, func(ctx context.Context) (*api.JobProgress, error) {
+		return progressService.Get(ctx, progress.ID)
+	})
+	return err
+}
+
+func runGradesPostPolicyGet(ctx context.Context, client *api.Client, opts *options.GradesPostPolicyGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "grades.post_policy.get", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"assignment_id": opts.AssignmentID,
+	})
+
+	service := api.NewPostPoliciesService(client)
+
+	var policy *api.PostPolicy
+	var err error
+	if opts.AssignmentID > 0 {
+		policy, err = service.GetAssignmentPolicy(ctx, opts.AssignmentID)
+	} else {
+		policy, err = service.GetCoursePolicy(ctx, opts.CourseID)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "grades.post_policy.get", err, map[string]interface{}{
+			"course_id":     opts.CourseID,
+			"assignment_id": opts.AssignmentID,
+		})
+		return fmt.Errorf("failed to get post policy: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "grades.post_policy.get", 1)
+	return formatOutput(policy, nil)
+}
+
+func runGradesPostPolicySet(ctx context.Context, client *api.Client, opts *options.GradesPostPolicySetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "grades.post_policy.set", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"assignment_id": opts.AssignmentID,
+		"manual":        opts.Manual,
+	})
+
+	service := api.NewPostPoliciesService(client)
+
+	var policy *api.PostPolicy
+	var target string
+	var err error
+	if opts.AssignmentID > 0 {
+		policy, err = service.SetAssignmentPolicy(ctx, opts.AssignmentID, opts.Manual)
+		target = fmt.Sprintf("assignment %d", opts.AssignmentID)
+	} else {
+		policy, err = service.SetCoursePolicy(ctx, opts.CourseID, opts.Manual)
+		target = fmt.Sprintf("course %d", opts.CourseID)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "grades.post_policy.set", err, map[string]interface{}{
+			"course_id":     opts.CourseID,
+			"assignment_id": opts.AssignmentID,
+		})
+		return fmt.Errorf("failed to set post policy: %w", err)
+	}
+
+	mode := "automatically"
+	if opts.Manual {
+		mode = "manually"
+	}
+
+	logger.LogCommandComplete(ctx, "grades.post_policy.set", 1)
+	return formatSuccessOutput(policy, fmt.Sprintf("Grades of %s are now posted %s", target, mode))
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestGradesPostCmd(t *testing.T) {
+	assignments := `[{"id":456,"name":"Essay 1","published":true},{"id":457,"name":"Draft","published":false},{"id":458,"name":"Final Exam","published":true}]`
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "post single assignment",
+			Args: []string{"--course-id", "123", "--assignment-id", "456"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/graphql": cmdtest.NewMockResponse(`{"data":{"postAssignmentGrades":{"progress":{"_id":"77","state":"queued"},"errors":null}}}`),
+			},
+			ExpectOutput: "Posted grades of assignment 456",
+		},
+		{
+			Name: "dry run with all skips unpublished",
+			Args: []string{"--course-id", "123", "--all", "--dry-run"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/assignments": cmdtest.NewMockResponse(assignments),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "Would post grades of 2 assignments") {
+					t.Errorf("Expected 2 published assignments, got: %s", output)
+				}
+				if strings.Contains(output, "Draft") {
+					t.Errorf("Expected unpublished assignment to be skipped, got: %s", output)
+				}
+			},
+		},
+		{
+			Name: "mutation errors are reported",
+			Args: []string{"--course-id", "123", "--assignment-id", "456,458"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/graphql": cmdtest.NewMockResponse(`{"data":{"postAssignmentGrades":{"progress":null,"errors":[{"attribute":"assignment","message":"not found"}]}}}`),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "requires assignment or all",
+			Args:        []string{"--course-id", "123"},
+			ExpectError: true,
+		},
+		{
+			Name:        "assignment and all are exclusive",
+			Args:        []string{"--course-id", "123", "--assignment-id", "456", "--all"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newGradesPostCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestGradesHideCmd(t *testing.T) {
+	tc := cmdtest.CommandTestCase{
+		Name: "hide for sections",
+		Args: []string{"--course-id", "123", "--assignment-id", "456", "--section-id", "11"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/graphql": cmdtest.NewMockResponse(`{"data":{"hideAssignmentGradesForSections":{"progress":{"_id":"78","state":"queued"},"errors":null}}}`),
+		},
+		ExpectOutput: "Hid grades of assignment 456",
+	}
+
+	cmd := newGradesHideCmd()
+	cmdtest.RunCommandTest(t, cmd, tc)
+}
+
+func TestGradesPostPolicySetCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "set course policy to manual",
+			Args: []string{"--course-id", "123", "--manual"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/graphql": cmdtest.NewMockResponse(`{"data":{"setCoursePostPolicy":{"postPolicy":{"postManually":true},"errors":null}}}`),
+			},
+			ExpectOutput: "Grades of course 123 are now posted manually",
+		},
+		{
+			Name:        "requires a mode",
+			Args:        []string{"--course-id", "123"},
+			ExpectError: true,
+		},
+		{
+			Name:        "manual and automatic are exclusive",
+			Args:        []string{"--assignment-id", "456", "--manual", "--automatic"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newGradesPostPolicySetCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
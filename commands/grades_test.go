@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -356,3 +358,46 @@ func TestGradesColumnsDataSetCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestGradesBulkUpdateCmd(t *testing.T) {
+	csvPath := filepath.Join(t.TempDir(), "grades.csv")
+	if err := os.WriteFile(csvPath, []byte("user_id,assignment_id,grade\n1,10,95\n2,10,88\n"), 0600); err != nil {
+		t.Fatalf("failed to write CSV: %v", err)
+	}
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "bulk update and wait for completion",
+			Args: []string{"--course-id", "1", "--csv", csvPath, "--wait"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts": cmdtest.NewMockResponse(`[]`),
+				"/api/v1/courses/1/submissions/update_grades": cmdtest.NewMockResponse(`{"id": 42, "workflow_state": "queued"}`),
+				"/api/v1/progress/42":                         cmdtest.NewMockResponse(`{"id": 42, "workflow_state": "completed", "completion": 100}`),
+			},
+			ExpectError:  false,
+			ExpectOutput: "Grade update completed",
+		},
+		{
+			Name: "bulk update wait exits with error when job fails",
+			Args: []string{"--course-id", "1", "--csv", csvPath, "--wait"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts": cmdtest.NewMockResponse(`[]`),
+				"/api/v1/courses/1/submissions/update_grades": cmdtest.NewMockResponse(`{"id": 42, "workflow_state": "queued"}`),
+				"/api/v1/progress/42":                         cmdtest.NewMockResponse(`{"id": 42, "workflow_state": "failed", "message": "invalid grade"}`),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "bulk update - missing CSV",
+			Args:        []string{"--course-id", "1"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newGradesBulkUpdateCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
@@ -0,0 +1,660 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+	"github.com/jjuanrivvera/canvas-cli/internal/batch"
+)
+
+// gradingStandardsCmd represents the grading-standards command group
+var gradingStandardsCmd = &cobra.Command{
+	Use:     "grading-standards",
+	Aliases: []string{"grading-schemes"},
+	Short:   "Manage grading standards",
+	Long: `Manage grading standards (grading schemes) for accounts and courses.
+
+If neither --account-id nor --course-id is specified, uses the default account.
+
+A grading scheme can be defined in YAML, optionally with a late policy, and
+pushed to every course of a sub-account:
+
+  title: Department Letter Grades
+  entries:                # Lowest percentage that earns each grade
+    - {name: A, value: 94}
+    - {name: B, value: 84}
+    - {name: C, value: 74}
+    - {name: D, value: 64}
+    - {name: F, value: 0}
+  late_policy:            # Optional; omitted deductions are disabled
+    late_submission_deduction: 10
+    late_submission_interval: day
+    late_submission_minimum_percent: 50
+    missing_submission_deduction: 100
+
+Examples:
+  canvas grading-standards list --course-id 123
+  canvas grading-standards create --file scheme.yaml --account-id 5
+  canvas grading-standards push --file scheme.yaml --account-id 5 --term "Fall 2026" --dry-run`,
+}
+
+func init() {
+	rootCmd.AddCommand(gradingStandardsCmd)
+	gradingStandardsCmd.AddCommand(newGradingStandardsListCmd())
+	gradingStandardsCmd.AddCommand(newGradingStandardsGetCmd())
+	gradingStandardsCmd.AddCommand(newGradingStandardsCreateCmd())
+	gradingStandardsCmd.AddCommand(newGradingStandardsPushCmd())
+}
+
+func newGradingStandardsListCmd() *cobra.Command {
+	opts := &options.GradingStandardsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List grading standards",
+		Long: `List the grading standards available to an account or course, including
+those inherited from parent accounts.
+
+Examples:
+  canvas grading-standards list
+  canvas grading-standards list --account-id 5
+  canvas grading-standards list --course-id 123`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if opts.CourseID == 0 {
+				accountID, err := resolveAccountID(opts.AccountID, "grading-standards list")
+				if err != nil {
+					return err
+				}
+				opts.AccountID = accountID
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradingStandardsList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+
+	return cmd
+}
+
+func newGradingStandardsGetCmd() *cobra.Command {
+	opts := &options.GradingStandardsGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <grading-standard-id>",
+		Short: "Get a grading standard",
+		Long: `Get a grading standard with its entries.
+
+Examples:
+  canvas grading-standards get 12 --course-id 123
+  canvas grading-standards get 12 --account-id 5 -o yaml`,
+		Args: ExactArgsWithUsage(1, "grading-standard-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			standardID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid grading standard ID: %s", args[0])
+			}
+			opts.StandardID = standardID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if opts.CourseID == 0 {
+				accountID, err := resolveAccountID(opts.AccountID, "grading-standards get")
+				if err != nil {
+					return err
+				}
+				opts.AccountID = accountID
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradingStandardsGet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+
+	return cmd
+}
+
+func newGradingStandardsCreateCmd() *cobra.Command {
+	opts := &options.GradingStandardsCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a grading standard",
+		Long: `Create a grading standard from a YAML file or from --entry flags. Entry
+values are the lowest percentage that earns the grade.
+
+Examples:
+  canvas grading-standards create --file scheme.yaml --account-id 5
+  canvas grading-standards create --course-id 123 --title "Pass/Fail" --entry Pass=60 --entry Fail=0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if opts.CourseID == 0 {
+				accountID, err := resolveAccountID(opts.AccountID, "grading-standards create")
+				if err != nil {
+					return err
+				}
+				opts.AccountID = accountID
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradingStandardsCreate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID")
+	cmd.Flags().StringVar(&opts.File, "file", "", "YAML file with the grading scheme")
+	cmd.Flags().StringVar(&opts.Title, "title", "", "Grading standard title")
+	cmd.Flags().StringArrayVar(&opts.Entries, "entry", nil, "Grade and lowest percentage, e.g. A=94 (repeatable, highest first)")
+	cmd.Flags().BoolVar(&opts.PointsBased, "points-based", false, "Show grades as points instead of percentages")
+	cmd.Flags().Float64Var(&opts.ScalingFactor, "scaling-factor", 0, "Maximum points of a points-based scheme, e.g. 4.0")
+
+	return cmd
+}
+
+func newGradingStandardsPushCmd() *cobra.Command {
+	opts := &options.GradingStandardsPushOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "push",
+		Short: "Apply a grading scheme to every course of an account",
+		Long: `Apply a grading scheme defined in YAML to every course of an account and
+its sub-accounts, optionally limited to one term.
+
+The grading standard is created once in the account, or reused if the account
+already has one with the same title and entries, and set as the grading
+scheme of each course. If the file has a late_policy, it is applied to each
+course as well.
+
+Examples:
+  canvas grading-standards push --file scheme.yaml --account-id 5 --dry-run
+  canvas grading-standards push --file scheme.yaml --account-id 5 --term "Fall 2026"
+  canvas grading-standards push --file scheme.yaml --account-id 5 --concurrency 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accountID, err := resolveAccountID(opts.AccountID, "grading-standards push")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGradingStandardsPush(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.File, "file", "", "YAML file with the grading scheme (required)")
+	cmd.Flags().StringVar(&opts.Term, "term", "", "Only courses in this term (ID, SIS term ID or name)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of courses updated in parallel")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which courses would change without making changes")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runGradingStandardsList(ctx context.Context, client *api.Client, opts *options.GradingStandardsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "grading_standards.list", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"course_id":  opts.CourseID,
+	})
+
+	service := api.NewGradingStandardsService(client)
+
+	var standards []api.GradingStandard
+	var err error
+
+	if opts.CourseID > 0 {
+		standards, err = service.ListCourse(ctx, opts.CourseID)
+	} else {
+		standards, err = service.ListAccount(ctx, opts.AccountID)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.list", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"course_id":  opts.CourseID,
+		})
+		return fmt.Errorf("failed to list grading standards: %w", err)
+	}
+
+	printVerbose("Found %d grading standards:\n\n", len(standards))
+
+	logger.LogCommandComplete(ctx, "grading_standards.list", len(standards))
+	return formatEmptyOrOutput(standards, "No grading standards found")
+}
+
+func runGradingStandardsGet(ctx context.Context, client *api.Client, opts *options.GradingStandardsGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "grading_standards.get", map[string]interface{}{
+		"account_id":          opts.AccountID,
+		"course_id":           opts.CourseID,
+		"grading_standard_id": opts.StandardID,
+	})
+
+	service := api.NewGradingStandardsService(client)
+
+	var standard *api.GradingStandard
+	var err error
+
+	if opts.CourseID > 0 {
+		standard, err = service.GetCourse(ctx, opts.CourseID, opts.StandardID)
+	} else {
+		standard, err = service.GetAccount(ctx, opts.AccountID, opts.StandardID)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.get", err, map[string]interface{}{
+			"grading_standard_id": opts.StandardID,
+		})
+		return fmt.Errorf("failed to get grading standard: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "grading_standards.get", 1)
+	return formatOutput(standard, func() {
+		fmt.Printf("%s (ID: %d, %s %d)\n\n", standard.Title, standard.ID, standard.ContextType, standard.ContextID)
+		for _, entry := range standard.GradingScheme {
+			fmt.Printf("  %-8s %g%%\n", entry.Name, math.Round(entry.Value*10000)/100)
+		}
+	})
+}
+
+func runGradingStandardsCreate(ctx context.Context, client *api.Client, opts *options.GradingStandardsCreateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "grading_standards.create", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"course_id":  opts.CourseID,
+		"file":       opts.File,
+	})
+
+	var scheme *gradingScheme
+	var err error
+
+	if opts.File != "" {
+		scheme, err = loadGradingScheme(opts.File)
+	} else {
+		scheme = &gradingScheme{
+			Title:         opts.Title,
+			PointsBased:   opts.PointsBased,
+			ScalingFactor: opts.ScalingFactor,
+		}
+		scheme.Entries, err = parseGradingEntries(opts.Entries)
+		if err == nil {
+			err = scheme.validate()
+		}
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.create", err, map[string]interface{}{})
+		return err
+	}
+
+	service := api.NewGradingStandardsService(client)
+
+	var standard *api.GradingStandard
+	if opts.CourseID > 0 {
+		standard, err = service.CreateInCourse(ctx, opts.CourseID, scheme.params())
+	} else {
+		standard, err = service.CreateInAccount(ctx, opts.AccountID, scheme.params())
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.create", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"course_id":  opts.CourseID,
+		})
+		return fmt.Errorf("failed to create grading standard: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "grading_standards.create", 1)
+	return formatSuccessOutput(standard, fmt.Sprintf("Grading standard %q created successfully (ID: %d)", standard.Title, standard.ID))
+}
+
+func runGradingStandardsPush(ctx context.Context, client *api.Client, opts *options.GradingStandardsPushOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "grading_standards.push", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"file":       opts.File,
+		"term":       opts.Term,
+		"dry_run":    opts.DryRun,
+	})
+
+	scheme, err := loadGradingScheme(opts.File)
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.push", err, map[string]interface{}{
+			"file": opts.File,
+		})
+		return err
+	}
+
+	courseOpts := &api.ListAccountCoursesOptions{}
+	if opts.Term != "" {
+		courseOpts.EnrollmentTermID, err = resolveTermID(ctx, client, opts.AccountID, opts.Term)
+		if err != nil {
+			logger.LogCommandError(ctx, "grading_standards.push", err, map[string]interface{}{
+				"term": opts.Term,
+			})
+			return err
+		}
+	}
+
+	courses, err := api.NewAccountsService(client).ListCourses(ctx, opts.AccountID, courseOpts)
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.push", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+		})
+		return fmt.Errorf("failed to list account courses: %w", err)
+	}
+
+	if len(courses) == 0 {
+		fmt.Println("No courses found")
+		logger.LogCommandComplete(ctx, "grading_standards.push", 0)
+		return nil
+	}
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+		fmt.Println()
+		fmt.Printf("Grading scheme %q (%d entries) would be applied to %d courses:\n", scheme.Title, len(scheme.Entries), len(courses))
+		if scheme.LatePolicy != nil {
+			fmt.Printf("Late policy: %s\n", scheme.LatePolicy.describe())
+		}
+		for _, course := range courses {
+			fmt.Printf("  - %d: %s\n", course.ID, course.Name)
+		}
+		logger.LogCommandComplete(ctx, "grading_standards.push", 0)
+		return nil
+	}
+
+	standard, err := findOrCreateAccountStandard(ctx, api.NewGradingStandardsService(client), opts.AccountID, scheme)
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.push", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+		})
+		return err
+	}
+
+	coursesService := api.NewCoursesService(client)
+	latePolicyService := api.NewLatePolicyService(client)
+
+	items := make([]interface{}, len(courses))
+	for i, course := range courses {
+		items[i] = course
+	}
+
+	processor := batch.New(opts.Concurrency, false, batch.NewConsoleProgress(time.Second))
+	summary, err := processor.Process(ctx, items, func(ctx context.Context, item interface{}) error {
+		course := item.(api.Course)
+		if _, err := coursesService.Update(ctx, course.ID, &api.UpdateCourseParams{GradingStandardID: standard.ID}); err != nil {
+			return fmt.Errorf("course %d: failed to set grading scheme: %w", course.ID, err)
+		}
+		if scheme.LatePolicy != nil {
+			if _, err := saveLatePolicy(ctx, latePolicyService, course.ID, scheme.LatePolicy.params()); err != nil {
+				return fmt.Errorf("course %d: failed to set late policy: %w", course.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "grading_standards.push", err, map[string]interface{}{})
+		return err
+	}
+
+	fmt.Printf("\nApplied grading scheme %q to %d of %d courses\n", standard.Title, summary.Succeeded, summary.Total)
+	if summary.Failed > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range summary.Errors() {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, "grading_standards.push", summary.Succeeded)
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("grading scheme push completed with %d errors", summary.Failed)
+	}
+
+	return nil
+}
+
+// findOrCreateAccountStandard reuses the account's grading standard with the
+// same title and entries, or creates one, so repeated pushes don't pile up
+// duplicate schemes
+func findOrCreateAccountStandard(ctx context.Context, service *api.GradingStandardsService, accountID int64, scheme *gradingScheme) (*api.GradingStandard, error) {
+	standards, err := service.ListAccount(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grading standards: %w", err)
+	}
+
+	for i := range standards {
+		standard := &standards[i]
+		if standard.ContextType == "Account" && standard.ContextID == accountID && scheme.matches(standard) {
+			fmt.Printf("Using existing grading standard %q (ID: %d)\n", standard.Title, standard.ID)
+			return standard, nil
+		}
+	}
+
+	standard, err := service.CreateInAccount(ctx, accountID, scheme.params())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grading standard: %w", err)
+	}
+
+	fmt.Printf("Created grading standard %q (ID: %d)\n", standard.Title, standard.ID)
+	return standard, nil
+}
+
+// gradingScheme is a grading scheme, and optionally a late policy, defined in YAML
+type gradingScheme struct {
+	Title         string                   `yaml:"title"`
+	PointsBased   bool                     `yaml:"points_based"`
+	ScalingFactor float64                  `yaml:"scaling_factor"`
+	Entries       []api.GradingSchemeEntry `yaml:"entries"` // Values as percentages
+	LatePolicy    *latePolicySettings      `yaml:"late_policy"`
+}
+
+// latePolicySettings is a late policy defined in YAML. Omitted deductions are disabled.
+type latePolicySettings struct {
+	LateSubmissionDeduction      *float64 `yaml:"late_submission_deduction"`
+	LateSubmissionInterval       string   `yaml:"late_submission_interval"`
+	LateSubmissionMinimumPercent *float64 `yaml:"late_submission_minimum_percent"`
+	MissingSubmissionDeduction   *float64 `yaml:"missing_submission_deduction"`
+}
+
+func loadGradingScheme(path string) (*gradingScheme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read grading scheme: %w", err)
+	}
+
+	var scheme gradingScheme
+	if err := yaml.Unmarshal(data, &scheme); err != nil {
+		return nil, fmt.Errorf("failed to parse grading scheme: %w", err)
+	}
+
+	if err := scheme.validate(); err != nil {
+		return nil, fmt.Errorf("invalid grading scheme %s: %w", path, err)
+	}
+
+	return &scheme, nil
+}
+
+func (s *gradingScheme) validate() error {
+	if s.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+	if len(s.Entries) == 0 {
+		return fmt.Errorf("at least one entry is required")
+	}
+
+	seen := make(map[string]bool, len(s.Entries))
+	for i, entry := range s.Entries {
+		if entry.Name == "" {
+			return fmt.Errorf("entry %d has no name", i+1)
+		}
+		if seen[entry.Name] {
+			return fmt.Errorf("duplicate entry %q", entry.Name)
+		}
+		seen[entry.Name] = true
+
+		if entry.Value < 0 || entry.Value > 100 {
+			return fmt.Errorf("entry %q: value must be between 0 and 100", entry.Name)
+		}
+		if i > 0 && entry.Value >= s.Entries[i-1].Value {
+			return fmt.Errorf("entry %q: values must be listed from highest to lowest", entry.Name)
+		}
+	}
+
+	if s.LatePolicy != nil {
+		return s.LatePolicy.validate()
+	}
+
+	return nil
+}
+
+func (s *gradingScheme) params() *api.CreateGradingStandardParams {
+	return &api.CreateGradingStandardParams{
+		Title:         s.Title,
+		PointsBased:   s.PointsBased,
+		ScalingFactor: s.ScalingFactor,
+		Entries:       s.Entries,
+	}
+}
+
+// matches reports whether a grading standard has the scheme's title, type
+// and entries. Canvas returns values as fractions. The scaling factor only
+// applies to points-based schemes.
+func (s *gradingScheme) matches(standard *api.GradingStandard) bool {
+	if standard.Title != s.Title || len(standard.GradingScheme) != len(s.Entries) {
+		return false
+	}
+	if standard.PointsBased != s.PointsBased {
+		return false
+	}
+	if s.PointsBased && math.Abs(standard.ScalingFactor-s.ScalingFactor) > 0.001 {
+		return false
+	}
+	for i, entry := range s.Entries {
+		other := standard.GradingScheme[i]
+		if other.Name != entry.Name || math.Abs(other.Value*100-entry.Value) > 0.001 {
+			return false
+		}
+	}
+	return true
+}
+
+// parseGradingEntries parses name=value entries, e.g. A=94
+func parseGradingEntries(values []string) ([]api.GradingSchemeEntry, error) {
+	entries := make([]api.GradingSchemeEntry, 0, len(values))
+	for _, value := range values {
+		idx := strings.LastIndex(value, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid entry %q: expected name=value, e.g. A=94", value)
+		}
+
+		percent, err := strconv.ParseFloat(strings.TrimSpace(value[idx+1:]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entry %q: value must be a number", value)
+		}
+
+		entries = append(entries, api.GradingSchemeEntry{
+			Name:  strings.TrimSpace(value[:idx]),
+			Value: percent,
+		})
+	}
+	return entries, nil
+}
+
+func (p *latePolicySettings) validate() error {
+	switch p.LateSubmissionInterval {
+	case "", "day", "hour":
+	default:
+		return fmt.Errorf("late_submission_interval must be day or hour")
+	}
+	for _, value := range []*float64{p.LateSubmissionDeduction, p.LateSubmissionMinimumPercent, p.MissingSubmissionDeduction} {
+		if value != nil && (*value < 0 || *value > 100) {
+			return fmt.Errorf("late policy percentages must be between 0 and 100")
+		}
+	}
+	return nil
+}
+
+func (p *latePolicySettings) params() *api.LatePolicyParams {
+	lateEnabled := p.LateSubmissionDeduction != nil
+	minimumEnabled := p.LateSubmissionMinimumPercent != nil
+	missingEnabled := p.MissingSubmissionDeduction != nil
+
+	params := &api.LatePolicyParams{
+		LateSubmissionDeductionEnabled:      &lateEnabled,
+		LateSubmissionDeduction:             p.LateSubmissionDeduction,
+		LateSubmissionMinimumPercentEnabled: &minimumEnabled,
+		LateSubmissionMinimumPercent:        p.LateSubmissionMinimumPercent,
+		MissingSubmissionDeductionEnabled:   &missingEnabled,
+		MissingSubmissionDeduction:          p.MissingSubmissionDeduction,
+	}
+	if p.LateSubmissionInterval != "" {
+		params.LateSubmissionInterval = &p.LateSubmissionInterval
+	}
+	return params
+}
+
+func (p *latePolicySettings) describe() string {
+	var parts []string
+	if p.LateSubmissionDeduction != nil {
+		interval := p.LateSubmissionInterval
+		if interval == "" {
+			interval = "day"
+		}
+		parts = append(parts, fmt.Sprintf("late %g%% per %s", *p.LateSubmissionDeduction, interval))
+	}
+	if p.LateSubmissionMinimumPercent != nil {
+		parts = append(parts, fmt.Sprintf("minimum %g%%", *p.LateSubmissionMinimumPercent))
+	}
+	if p.MissingSubmissionDeduction != nil {
+		parts = append(parts, fmt.Sprintf("missing %g%%", *p.MissingSubmissionDeduction))
+	}
+	if len(parts) == 0 {
+		return "all deductions disabled"
+	}
+	return strings.Join(parts, ", ")
+}
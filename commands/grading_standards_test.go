@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+const testGradingScheme = `title: Department Letter Grades
+entries:
+  - {name: A, value: 90}
+  - {name: B, value: 80}
+  - {name: F, value: 0}
+late_policy:
+  late_submission_deduction: 10
+  late_submission_interval: day
+  missing_submission_deduction: 100
+`
+
+func writeGradingScheme(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "scheme.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write grading scheme: %v", err)
+	}
+	return path
+}
+
+func TestLoadGradingScheme(t *testing.T) {
+	scheme, err := loadGradingScheme(writeGradingScheme(t, testGradingScheme))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(scheme.Entries) != 3 || scheme.Entries[1].Name != "B" || scheme.Entries[1].Value != 80 {
+		t.Errorf("Unexpected entries: %+v", scheme.Entries)
+	}
+
+	params := scheme.LatePolicy.params()
+	if !*params.LateSubmissionDeductionEnabled || *params.LateSubmissionMinimumPercentEnabled || !*params.MissingSubmissionDeductionEnabled {
+		t.Errorf("Expected omitted minimum percent to be disabled: %+v", params)
+	}
+
+	invalid := map[string]string{
+		"no title":       "entries:\n  - {name: A, value: 90}\n",
+		"not descending": "title: X\nentries:\n  - {name: B, value: 80}\n  - {name: A, value: 90}\n",
+		"duplicate":      "title: X\nentries:\n  - {name: A, value: 90}\n  - {name: A, value: 80}\n",
+		"bad interval":   "title: X\nentries:\n  - {name: A, value: 90}\nlate_policy:\n  late_submission_interval: week\n",
+	}
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := loadGradingScheme(writeGradingScheme(t, content)); err == nil {
+				t.Errorf("Expected error for %s", name)
+			}
+		})
+	}
+}
+
+func TestGradingSchemeMatches(t *testing.T) {
+	scheme := &gradingScheme{
+		Title:   "Points",
+		Entries: []api.GradingSchemeEntry{{Name: "A", Value: 90}, {Name: "F", Value: 0}},
+	}
+	entries := []api.GradingSchemeEntry{{Name: "A", Value: 0.9}, {Name: "F", Value: 0}}
+
+	if !scheme.matches(&api.GradingStandard{Title: "Points", ScalingFactor: 1, GradingScheme: entries}) {
+		t.Error("Expected a percentage standard to match regardless of its scaling factor")
+	}
+	if scheme.matches(&api.GradingStandard{Title: "Points", PointsBased: true, ScalingFactor: 4, GradingScheme: entries}) {
+		t.Error("Expected a points-based standard not to match a percentage scheme")
+	}
+
+	scheme.PointsBased = true
+	scheme.ScalingFactor = 4
+	if !scheme.matches(&api.GradingStandard{Title: "Points", PointsBased: true, ScalingFactor: 4, GradingScheme: entries}) {
+		t.Error("Expected an identical points-based standard to match")
+	}
+	if scheme.matches(&api.GradingStandard{Title: "Points", PointsBased: true, ScalingFactor: 5, GradingScheme: entries}) {
+		t.Error("Expected a different scaling factor not to match")
+	}
+}
+
+func TestGradingStandardsPushCmd(t *testing.T) {
+	schemePath := writeGradingScheme(t, testGradingScheme)
+
+	existingStandard := `[{"id":12,"title":"Department Letter Grades","context_type":"Account","context_id":5,"grading_scheme":[{"name":"A","value":0.9},{"name":"B","value":0.8},{"name":"F","value":0}]}]`
+	courses := `[{"id":201,"name":"Biology"},{"id":202,"name":"Chemistry"}]`
+	latePolicy := `{"late_policy":{"id":1,"late_submission_deduction_enabled":true,"late_submission_deduction":10}}`
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "push reusing existing standard",
+			Args: []string{"--file", schemePath, "--account-id", "5"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/5/grading_standards": cmdtest.NewMockResponse(existingStandard),
+				"/api/v1/accounts/5/courses":           cmdtest.NewMockResponse(courses),
+				"/api/v1/courses/201":                  cmdtest.NewMockResponse(`{"id":201,"grading_standard_id":12}`),
+				"/api/v1/courses/202":                  cmdtest.NewMockResponse(`{"id":202,"grading_standard_id":12}`),
+				"/api/v1/courses/201/late_policy":      cmdtest.NewMockResponse(latePolicy),
+				"/api/v1/courses/202/late_policy":      cmdtest.NewMockResponse(latePolicy),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, `Using existing grading standard "Department Letter Grades" (ID: 12)`) {
+					t.Errorf("Expected existing standard to be reused, got: %s", output)
+				}
+				if !strings.Contains(output, "to 2 of 2 courses") {
+					t.Errorf("Expected summary, got: %s", output)
+				}
+			},
+		},
+		{
+			Name: "dry run",
+			Args: []string{"--file", schemePath, "--account-id", "5", "--dry-run"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/5/courses": cmdtest.NewMockResponse(courses),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "Late policy: late 10% per day, missing 100%") {
+					t.Errorf("Expected late policy summary, got: %s", output)
+				}
+				if !strings.Contains(output, "202: Chemistry") {
+					t.Errorf("Expected course list, got: %s", output)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newGradingStandardsPushCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestGradingStandardsCreateCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "create from entries",
+			Args: []string{"--course-id", "123", "--title", "Pass/Fail", "--entry", "Pass=60", "--entry", "Fail=0"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/grading_standards": cmdtest.NewMockResponse(`{"id":13,"title":"Pass/Fail"}`),
+			},
+			ExpectOutput: `Grading standard "Pass/Fail" created successfully (ID: 13)`,
+		},
+		{
+			Name:        "malformed entry",
+			Args:        []string{"--course-id", "123", "--title", "Pass/Fail", "--entry", "Pass"},
+			ExpectError: true,
+		},
+		{
+			Name:        "file with entries",
+			Args:        []string{"--course-id", "123", "--file", "scheme.yaml", "--entry", "A=90"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newGradingStandardsCreateCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
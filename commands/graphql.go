@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+func init() {
+	rootCmd.AddCommand(newGraphQLCmd())
+}
+
+func newGraphQLCmd() *cobra.Command {
+	opts := &options.GraphQLOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "graphql <query-file>",
+		Short: "Run a query against the Canvas GraphQL API",
+		Long: `Run a query against the Canvas GraphQL API (/api/graphql).
+
+Some data is much cheaper to fetch through GraphQL, such as submissions with
+rubric assessments across many assignments in a single round-trip. Use "-" as
+the query file to read the query from stdin.
+
+Variables are passed with --var key=value. Values that are valid JSON
+(numbers, booleans, arrays, objects) are sent as JSON; anything else is sent
+as a string.
+
+With --paginate, the query is repeated following the Relay cursors of the
+connection at the given dotted path, and the nodes of every page are output.
+The query must declare an $after: String variable, pass it to the connection,
+and select pageInfo { hasNextPage endCursor }.
+
+Examples:
+  canvas graphql course.graphql --var courseId=123
+  echo '{ allCourses { _id name } }' | canvas graphql -
+  canvas graphql assignments.graphql --var courseId=123 --paginate course.assignmentsConnection -o json`,
+		Args: ExactArgsWithUsage(1, "query-file"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.QueryFile = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			query, err := readGraphQLQuery(cmd, opts.QueryFile)
+			if err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runGraphQL(cmd.Context(), client, opts, query)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.Vars, "var", nil, "Query variable (key=value, repeatable)")
+	cmd.Flags().StringVar(&opts.OperationName, "operation", "", "Operation to run when the document defines several")
+	cmd.Flags().StringVar(&opts.Paginate, "paginate", "", "Follow the cursors of the connection at this dotted path")
+
+	return cmd
+}
+
+func runGraphQL(ctx context.Context, client *api.Client, opts *options.GraphQLOptions, query string) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "graphql", map[string]interface{}{
+		"query_file": opts.QueryFile,
+		"paginate":   opts.Paginate,
+	})
+
+	variables, err := parseGraphQLVars(opts.Vars)
+	if err != nil {
+		return err
+	}
+
+	req := &api.GraphQLRequest{
+		Query:         query,
+		Variables:     variables,
+		OperationName: opts.OperationName,
+	}
+
+	service := api.NewGraphQLService(client)
+
+	if opts.Paginate != "" {
+		nodes, err := service.QueryAll(ctx, req, opts.Paginate)
+		if err != nil {
+			logger.LogCommandError(ctx, "graphql", err, map[string]interface{}{
+				"query_file": opts.QueryFile,
+			})
+			return fmt.Errorf("GraphQL query failed: %w", err)
+		}
+
+		items := make([]interface{}, 0, len(nodes))
+		for _, node := range nodes {
+			var item interface{}
+			if err := json.Unmarshal(node, &item); err != nil {
+				return fmt.Errorf("failed to decode GraphQL node: %w", err)
+			}
+			items = append(items, item)
+		}
+
+		logger.LogCommandComplete(ctx, "graphql", len(items))
+		return formatEmptyOrOutput(items, "No nodes found")
+	}
+
+	data, err := service.Query(ctx, req)
+	if err != nil {
+		logger.LogCommandError(ctx, "graphql", err, map[string]interface{}{
+			"query_file": opts.QueryFile,
+		})
+		return fmt.Errorf("GraphQL query failed: %w", err)
+	}
+
+	var result interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to decode GraphQL data: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "graphql", 1)
+	return formatOutput(result, nil)
+}
+
+// readGraphQLQuery reads the query document from a file or stdin
+func readGraphQLQuery(cmd *cobra.Command, path string) (string, error) {
+	var reader io.Reader
+	if path == "-" {
+		reader = cmd.InOrStdin()
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to open query file: %w", err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read query file: %w", err)
+	}
+
+	query := strings.TrimSpace(string(data))
+	if query == "" {
+		return "", fmt.Errorf("query file is empty")
+	}
+
+	return query, nil
+}
+
+// parseGraphQLVars converts key=value flags to query variables, decoding
+// values that are valid JSON and keeping the rest as strings
+func parseGraphQLVars(vars []string) (map[string]interface{}, error) {
+	if len(vars) == 0 {
+		return nil, nil
+	}
+
+	variables := make(map[string]interface{}, len(vars))
+	for _, v := range vars {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable format: %s (use key=value)", v)
+		}
+
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+			variables[key] = decoded
+		} else {
+			variables[key] = value
+		}
+	}
+
+	return variables, nil
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestGraphQLCmd(t *testing.T) {
+	dir := t.TempDir()
+	queryFile := filepath.Join(dir, "course.graphql")
+	if err := os.WriteFile(queryFile, []byte(`query($courseId: ID!) { course(id: $courseId) { name } }`), 0600); err != nil {
+		t.Fatalf("Failed to write query file: %v", err)
+	}
+
+	oldFormat := outputFormat
+	outputFormat = "json"
+	defer func() { outputFormat = oldFormat }()
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "run query with variables",
+			Args: []string{queryFile, "--var", "courseId=123"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/graphql": cmdtest.NewMockResponse(`{"data":{"course":{"name":"Physics 101"}}}`),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "Physics 101") {
+					t.Errorf("Expected course name in output, got: %s", output)
+				}
+			},
+		},
+		{
+			Name: "follow connection cursors",
+			Args: []string{queryFile, "--paginate", "course.assignmentsConnection"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/graphql": cmdtest.NewMockResponse(`{"data":{"course":{"assignmentsConnection":{"nodes":[{"_id":"7","name":"Essay"}],"pageInfo":{"hasNextPage":false,"endCursor":"MQ"}}}}}`),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "Essay") {
+					t.Errorf("Expected node in output, got: %s", output)
+				}
+			},
+		},
+		{
+			Name: "query errors",
+			Args: []string{queryFile},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/graphql": cmdtest.NewMockResponse(`{"data":null,"errors":[{"message":"Variable $courseId of type ID! was provided invalid value"}]}`),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid variable",
+			Args:        []string{queryFile, "--var", "courseId"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newGraphQLCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestParseGraphQLVars(t *testing.T) {
+	vars, err := parseGraphQLVars([]string{"courseId=123", "name=Physics 101", "ids=[1,2]", "published=true"})
+	if err != nil {
+		t.Fatalf("parseGraphQLVars failed: %v", err)
+	}
+
+	if vars["courseId"] != float64(123) {
+		t.Errorf("Expected numeric courseId, got %#v", vars["courseId"])
+	}
+	if vars["name"] != "Physics 101" {
+		t.Errorf("Expected string name, got %#v", vars["name"])
+	}
+	if ids, ok := vars["ids"].([]interface{}); !ok || len(ids) != 2 {
+		t.Errorf("Expected array ids, got %#v", vars["ids"])
+	}
+	if vars["published"] != true {
+		t.Errorf("Expected boolean published, got %#v", vars["published"])
+	}
+}
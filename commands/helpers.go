@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,22 +42,29 @@ func getAPIClient() (*api.Client, error) {
 
 		// Create cache if not disabled
 		var apiCache cache.CacheInterface
-		cacheEnabled := !noCache
+		cacheEnabled := !noCache && !isCassetteMode()
 		if cacheEnabled {
 			apiCache = createCache()
 		}
 
+		sharedRateLimit, _ := strconv.ParseBool(os.Getenv("CANVAS_SHARED_RATE_LIMIT"))
+
 		client, err := api.NewClient(api.ClientConfig{
-			BaseURL:        envURL,
-			Token:          envToken,
-			RequestsPerSec: requestsPerSec,
-			AsUserID:       asUserID,
-			Cache:          apiCache,
-			CacheEnabled:   cacheEnabled,
-			UserAgent:      getUserAgent(),
-			MaxResults:     globalLimit,
-			DryRun:         dryRun,
-			ShowToken:      showToken,
+			BaseURL:              envURL,
+			Token:                envToken,
+			RequestsPerSec:       requestsPerSec,
+			AsUserID:             asUserID,
+			Cache:                apiCache,
+			CacheEnabled:         cacheEnabled,
+			UserAgent:            getUserAgent(),
+			MaxResults:           globalLimit,
+			DryRun:               dryRun,
+			ShowToken:            showToken,
+			RecordDir:            recordDir,
+			ReplayDir:            replayDir,
+			SharedRateLimitDir:   getSharedRateLimitDir(sharedRateLimit),
+			StaleWhileRevalidate: staleWhileRevalidate,
+			TraceHAR:             traceHAR,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create API client from environment: %w", err)
@@ -100,7 +109,7 @@ func getAPIClient() (*api.Client, error) {
 
 	// Create cache if not disabled
 	var apiCache cache.CacheInterface
-	cacheEnabled := !noCache
+	cacheEnabled := !noCache && !isCassetteMode()
 	if cacheEnabled {
 		apiCache = createCache()
 	}
@@ -110,16 +119,23 @@ func getAPIClient() (*api.Client, error) {
 	// Check if instance has an API token configured (token auth - no OAuth required)
 	if instance.HasToken() {
 		clientConfig = api.ClientConfig{
-			BaseURL:        instance.URL,
-			Token:          instance.Token,
-			RequestsPerSec: cfg.Settings.RequestsPerSecond,
-			AsUserID:       asUserID,
-			Cache:          apiCache,
-			CacheEnabled:   cacheEnabled,
-			UserAgent:      getUserAgent(),
-			MaxResults:     globalLimit,
-			DryRun:         dryRun,
-			ShowToken:      showToken,
+			BaseURL:              instance.URL,
+			Token:                instance.Token,
+			RequestsPerSec:       cfg.Settings.RequestsPerSecond,
+			AsUserID:             asUserID,
+			Cache:                apiCache,
+			CacheEnabled:         cacheEnabled,
+			UserAgent:            getUserAgent(),
+			MaxResults:           globalLimit,
+			DryRun:               dryRun,
+			ShowToken:            showToken,
+			RecordDir:            recordDir,
+			ReplayDir:            replayDir,
+			SharedRateLimitDir:   getSharedRateLimitDir(cfg.Settings.SharedRateLimit),
+			StaleWhileRevalidate: staleWhileRevalidate,
+			TraceHAR:             traceHAR,
+			RetryMethods:         cfg.Settings.RetryMethods,
+			RetryStatuses:        cfg.Settings.RetryStatuses,
 		}
 
 		if verbose {
@@ -146,30 +162,44 @@ func getAPIClient() (*api.Client, error) {
 			tokenSource := auth.NewAutoRefreshTokenSource(oauth2Config, tokenStore, instance.Name, token)
 
 			clientConfig = api.ClientConfig{
-				BaseURL:        instance.URL,
-				TokenSource:    tokenSource,
-				RequestsPerSec: cfg.Settings.RequestsPerSecond,
-				AsUserID:       asUserID,
-				Cache:          apiCache,
-				CacheEnabled:   cacheEnabled,
-				UserAgent:      getUserAgent(),
-				MaxResults:     globalLimit,
-				DryRun:         dryRun,
-				ShowToken:      showToken,
+				BaseURL:              instance.URL,
+				TokenSource:          tokenSource,
+				RequestsPerSec:       cfg.Settings.RequestsPerSecond,
+				AsUserID:             asUserID,
+				Cache:                apiCache,
+				CacheEnabled:         cacheEnabled,
+				UserAgent:            getUserAgent(),
+				MaxResults:           globalLimit,
+				DryRun:               dryRun,
+				ShowToken:            showToken,
+				RecordDir:            recordDir,
+				ReplayDir:            replayDir,
+				SharedRateLimitDir:   getSharedRateLimitDir(cfg.Settings.SharedRateLimit),
+				StaleWhileRevalidate: staleWhileRevalidate,
+				TraceHAR:             traceHAR,
+				RetryMethods:         cfg.Settings.RetryMethods,
+				RetryStatuses:        cfg.Settings.RetryStatuses,
 			}
 		} else {
 			// Fall back to static token (no auto-refresh)
 			clientConfig = api.ClientConfig{
-				BaseURL:        instance.URL,
-				Token:          token.AccessToken,
-				RequestsPerSec: cfg.Settings.RequestsPerSecond,
-				AsUserID:       asUserID,
-				Cache:          apiCache,
-				CacheEnabled:   cacheEnabled,
-				UserAgent:      getUserAgent(),
-				MaxResults:     globalLimit,
-				DryRun:         dryRun,
-				ShowToken:      showToken,
+				BaseURL:              instance.URL,
+				Token:                token.AccessToken,
+				RequestsPerSec:       cfg.Settings.RequestsPerSecond,
+				AsUserID:             asUserID,
+				Cache:                apiCache,
+				CacheEnabled:         cacheEnabled,
+				UserAgent:            getUserAgent(),
+				MaxResults:           globalLimit,
+				DryRun:               dryRun,
+				ShowToken:            showToken,
+				RecordDir:            recordDir,
+				ReplayDir:            replayDir,
+				SharedRateLimitDir:   getSharedRateLimitDir(cfg.Settings.SharedRateLimit),
+				StaleWhileRevalidate: staleWhileRevalidate,
+				TraceHAR:             traceHAR,
+				RetryMethods:         cfg.Settings.RetryMethods,
+				RetryStatuses:        cfg.Settings.RetryStatuses,
 			}
 		}
 	}
@@ -192,6 +222,27 @@ func getAPIClient() (*api.Client, error) {
 	return client, nil
 }
 
+// isCassetteMode reports whether HTTP traffic is being recorded or replayed.
+// Caching is disabled in both modes so every request reaches the cassette.
+func isCassetteMode() bool {
+	return recordDir != "" || replayDir != ""
+}
+
+// getSharedRateLimitDir returns the directory for the cross-process rate limiter
+// state, or an empty string when sharing is disabled
+func getSharedRateLimitDir(enabled bool) string {
+	if !enabled {
+		return ""
+	}
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(configDir, "ratelimit")
+}
+
 // createCache creates a multi-tier cache for API responses
 func createCache() cache.CacheInterface {
 	// Get cache directory
@@ -286,9 +337,9 @@ func formatEmptyOrOutput(data interface{}, emptyMessage string) error {
 		data = applyFiltering(data)
 	}
 
-	// For structured formats (JSON, YAML, CSV), always output the data
+	// For structured formats (JSON, NDJSON, YAML, CSV), always output the data
 	// even if empty - the formatter will output [] for empty slices
-	if format == output.FormatJSON || format == output.FormatYAML || format == output.FormatCSV {
+	if format == output.FormatJSON || format == output.FormatNDJSON || format == output.FormatYAML || format == output.FormatCSV {
 		return output.WriteWithOptions(os.Stdout, data, format, verbose)
 	}
 
@@ -0,0 +1,40 @@
+package options
+
+import "fmt"
+
+// AuditGradesOptions contains options for listing grade change audit events
+type AuditGradesOptions struct {
+	CourseID     int64
+	AssignmentID int64
+	StudentID    int64
+	GraderID     int64
+	StartDate    string
+	EndDate      string
+}
+
+// Validate validates the options
+func (o *AuditGradesOptions) Validate() error {
+	if o.CourseID <= 0 && o.AssignmentID <= 0 && o.StudentID <= 0 && o.GraderID <= 0 {
+		return fmt.Errorf("at least one of --course-id, --assignment-id, --student-id or --grader-id is required")
+	}
+	return nil
+}
+
+// AuditCoursesOptions contains options for listing course audit events
+type AuditCoursesOptions struct {
+	CourseID  int64
+	AccountID int64
+	StartDate string
+	EndDate   string
+}
+
+// Validate validates the options
+func (o *AuditCoursesOptions) Validate() error {
+	if o.CourseID > 0 && o.AccountID > 0 {
+		return fmt.Errorf("--course-id and --account-id cannot be used together")
+	}
+	if o.CourseID <= 0 && o.AccountID <= 0 {
+		return fmt.Errorf("--course-id or --account-id is required")
+	}
+	return nil
+}
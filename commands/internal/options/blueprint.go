@@ -60,6 +60,7 @@ type BlueprintSyncOptions struct {
 	Notify       bool
 	CopySettings bool
 	Publish      bool
+	WaitOptions
 	// Track which fields were set
 	NotifySet       bool
 	CopySettingsSet bool
@@ -68,7 +69,10 @@ type BlueprintSyncOptions struct {
 
 // Validate validates the options
 func (o *BlueprintSyncOptions) Validate() error {
-	return ValidateRequired("course-id", o.CourseID)
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	return o.WaitOptions.Validate()
 }
 
 // BlueprintChangesOptions contains options for listing blueprint changes
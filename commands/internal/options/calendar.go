@@ -1,5 +1,9 @@
 package options
 
+import (
+	"fmt"
+)
+
 // CalendarListOptions contains options for listing calendar events
 type CalendarListOptions struct {
 	CourseID       int64
@@ -99,3 +103,158 @@ type CalendarReserveOptions struct {
 func (o *CalendarReserveOptions) Validate() error {
 	return ValidateRequired("event-id", o.EventID)
 }
+
+// CalendarAppointmentsListOptions contains options for listing appointment groups
+type CalendarAppointmentsListOptions struct {
+	Scope       string
+	CourseIDs   []int64
+	IncludePast bool
+}
+
+// Validate validates the options
+func (o *CalendarAppointmentsListOptions) Validate() error {
+	if o.Scope != "" {
+		switch o.Scope {
+		case "reservable", "manageable":
+		default:
+			return ErrInvalidValue("scope", o.Scope, "reservable", "manageable")
+		}
+	}
+	return nil
+}
+
+// CalendarAppointmentsGetOptions contains options for getting an appointment group
+type CalendarAppointmentsGetOptions struct {
+	GroupID int64
+}
+
+// Validate validates the options
+func (o *CalendarAppointmentsGetOptions) Validate() error {
+	return ValidateRequired("appointment-group-id", o.GroupID)
+}
+
+// CalendarAppointmentsCreateOptions contains options for creating an appointment group
+type CalendarAppointmentsCreateOptions struct {
+	CourseIDs                  []int64
+	SectionIDs                 []int64
+	Title                      string
+	Description                string
+	LocationName               string
+	LocationAddress            string
+	Schedules                  []string // e.g. "Mon/Wed 14:00-16:00 every 15m for 6 weeks"
+	Starting                   string   // YYYY-MM-DD, defaults to today
+	TimeZone                   string
+	ParticipantsPerAppointment int
+	MaxAppointmentsPerUser     int
+	Visibility                 string
+	Publish                    bool
+	DryRun                     bool
+}
+
+// Validate validates the options
+func (o *CalendarAppointmentsCreateOptions) Validate() error {
+	if len(o.CourseIDs) == 0 {
+		return fmt.Errorf("at least one --course-id is required")
+	}
+	if err := ValidateRequired("title", o.Title); err != nil {
+		return err
+	}
+	if len(o.Schedules) == 0 {
+		return fmt.Errorf("at least one --schedule is required")
+	}
+	return validateAppointmentVisibility(o.Visibility)
+}
+
+// CalendarAppointmentsUpdateOptions contains options for updating an appointment group
+type CalendarAppointmentsUpdateOptions struct {
+	GroupID                    int64
+	Title                      string
+	Description                string
+	LocationName               string
+	LocationAddress            string
+	Schedules                  []string // Slots to add
+	Starting                   string
+	TimeZone                   string
+	ParticipantsPerAppointment int
+	MaxAppointmentsPerUser     int
+	Visibility                 string
+	Publish                    bool
+	// Track which fields were set
+	TitleSet                      bool
+	DescriptionSet                bool
+	LocationNameSet               bool
+	LocationAddressSet            bool
+	ParticipantsPerAppointmentSet bool
+	MaxAppointmentsPerUserSet     bool
+	VisibilitySet                 bool
+}
+
+// Validate validates the options
+func (o *CalendarAppointmentsUpdateOptions) Validate() error {
+	if err := ValidateRequired("appointment-group-id", o.GroupID); err != nil {
+		return err
+	}
+	if !o.TitleSet && !o.DescriptionSet && !o.LocationNameSet && !o.LocationAddressSet &&
+		!o.ParticipantsPerAppointmentSet && !o.MaxAppointmentsPerUserSet && !o.VisibilitySet &&
+		!o.Publish && len(o.Schedules) == 0 {
+		return fmt.Errorf("at least one field to update is required")
+	}
+	return validateAppointmentVisibility(o.Visibility)
+}
+
+// CalendarAppointmentsDeleteOptions contains options for deleting an appointment group
+type CalendarAppointmentsDeleteOptions struct {
+	GroupID      int64
+	CancelReason string
+	Force        bool
+}
+
+// Validate validates the options
+func (o *CalendarAppointmentsDeleteOptions) Validate() error {
+	return ValidateRequired("appointment-group-id", o.GroupID)
+}
+
+// CalendarAppointmentsParticipantsOptions contains options for listing appointment group participants
+type CalendarAppointmentsParticipantsOptions struct {
+	GroupID            int64
+	RegistrationStatus string
+	Groups             bool
+}
+
+// Validate validates the options
+func (o *CalendarAppointmentsParticipantsOptions) Validate() error {
+	if err := ValidateRequired("appointment-group-id", o.GroupID); err != nil {
+		return err
+	}
+	if o.RegistrationStatus != "" {
+		switch o.RegistrationStatus {
+		case "all", "registered":
+		default:
+			return ErrInvalidValue("registration-status", o.RegistrationStatus, "all", "registered")
+		}
+	}
+	return nil
+}
+
+// CalendarAppointmentsNextOptions contains options for getting the next appointment
+type CalendarAppointmentsNextOptions struct {
+	GroupIDs []int64
+}
+
+// Validate validates the options
+func (o *CalendarAppointmentsNextOptions) Validate() error {
+	// No required fields
+	return nil
+}
+
+func validateAppointmentVisibility(visibility string) error {
+	if visibility == "" {
+		return nil
+	}
+	switch visibility {
+	case "private", "protected":
+		return nil
+	default:
+		return ErrInvalidValue("visibility", visibility, "private", "protected")
+	}
+}
@@ -35,6 +35,7 @@ type ContentMigrationsCreateOptions struct {
 	Selective      bool
 	CopyOptions    string // JSON string
 	DateShift      string // JSON string
+	WaitOptions
 	// Track which fields were set
 	SourceCourseIDSet bool
 	FolderIDSet       bool
@@ -46,7 +47,10 @@ func (o *ContentMigrationsCreateOptions) Validate() error {
 	if err := ValidateRequired("course-id", o.CourseID); err != nil {
 		return err
 	}
-	return ValidateRequired("type", o.Type)
+	if err := ValidateRequired("type", o.Type); err != nil {
+		return err
+	}
+	return o.WaitOptions.Validate()
 }
 
 // ContentMigrationsMigratorsOptions contains options for listing available migration types
@@ -164,6 +164,26 @@ func (o *ConversationsMarkAllReadOptions) Validate() error {
 	return nil
 }
 
+// ConversationsBatchUpdateOptions contains options for batch updating conversations
+type ConversationsBatchUpdateOptions struct {
+	ConversationIDs []int64
+	Event           string
+	WaitOptions
+}
+
+// Validate validates the options
+func (o *ConversationsBatchUpdateOptions) Validate() error {
+	if len(o.ConversationIDs) == 0 {
+		return fmt.Errorf("ids is required")
+	}
+	switch o.Event {
+	case "mark_as_read", "mark_as_unread", "star", "unstar", "archive", "destroy":
+	default:
+		return ErrInvalidValue("event", o.Event, "mark_as_read", "mark_as_unread", "star", "unstar", "archive", "destroy")
+	}
+	return o.WaitOptions.Validate()
+}
+
 // ConversationsDeleteOptions contains options for deleting a conversation
 type ConversationsDeleteOptions struct {
 	ConversationID int64
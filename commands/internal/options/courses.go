@@ -1,5 +1,10 @@
 package options
 
+import (
+	"fmt"
+	"time"
+)
+
 // CoursesListOptions encapsulates all flags for courses list command
 type CoursesListOptions struct {
 	// User context flags
@@ -7,6 +12,7 @@ type CoursesListOptions struct {
 	EnrollmentState string
 	Include         []string
 	State           []string
+	Term            string // Term ID, SIS term ID or name
 
 	// Account context flags (admin mode)
 	AccountID  int64
@@ -120,3 +126,279 @@ func (o *CoursesDeleteOptions) Validate() error {
 	}
 	return nil
 }
+
+// CoursesLatePolicyGetOptions contains options for getting a course late policy
+type CoursesLatePolicyGetOptions struct {
+	CourseID int64
+}
+
+// Validate validates the options
+func (o *CoursesLatePolicyGetOptions) Validate() error {
+	return ValidateRequired("course-id", o.CourseID)
+}
+
+// CoursesLatePolicySetOptions contains options for creating or updating a course late policy
+type CoursesLatePolicySetOptions struct {
+	CourseID         int64
+	LateDeduction    float64
+	LateInterval     string
+	MinimumPercent   float64
+	MissingDeduction float64
+	DisableLate      bool
+	DisableMinimum   bool
+	DisableMissing   bool
+	// Track which fields were set
+	LateDeductionSet    bool
+	LateIntervalSet     bool
+	MinimumPercentSet   bool
+	MissingDeductionSet bool
+}
+
+// Validate validates the options
+func (o *CoursesLatePolicySetOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if !o.LateDeductionSet && !o.LateIntervalSet && !o.MinimumPercentSet && !o.MissingDeductionSet &&
+		!o.DisableLate && !o.DisableMinimum && !o.DisableMissing {
+		return fmt.Errorf("at least one late policy setting is required")
+	}
+	if (o.LateDeductionSet && o.DisableLate) || (o.MinimumPercentSet && o.DisableMinimum) || (o.MissingDeductionSet && o.DisableMissing) {
+		return fmt.Errorf("cannot set and disable the same deduction")
+	}
+	if o.LateIntervalSet {
+		switch o.LateInterval {
+		case "day", "hour":
+		default:
+			return ErrInvalidValue("late-interval", o.LateInterval, "day", "hour")
+		}
+	}
+	if err := validatePercent("late-deduction", o.LateDeduction); err != nil {
+		return err
+	}
+	if err := validatePercent("minimum-percent", o.MinimumPercent); err != nil {
+		return err
+	}
+	return validatePercent("missing-deduction", o.MissingDeduction)
+}
+
+func validatePercent(field string, value float64) error {
+	if value < 0 || value > 100 {
+		return fmt.Errorf("%s must be between 0 and 100", field)
+	}
+	return nil
+}
+
+// paceSkipDays are the weekday abbreviations Course Pacing accepts
+var paceSkipDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// validatePaceTarget validates the section or student a pace command targets.
+// Without either, the command targets the course pace.
+func validatePaceTarget(courseID, sectionID, userID int64) error {
+	if err := ValidateRequired("course-id", courseID); err != nil {
+		return err
+	}
+	if sectionID < 0 || userID < 0 {
+		return fmt.Errorf("section-id and user-id must be greater than 0")
+	}
+	if sectionID > 0 && userID > 0 {
+		return fmt.Errorf("--section-id and --user-id are mutually exclusive")
+	}
+	return nil
+}
+
+// ValidatePaceSettings validates an end date and days to skip shared by pace
+// flags and pace files
+func ValidatePaceSettings(endDate string, skipDays []string) error {
+	if endDate != "" {
+		if _, err := time.Parse("2006-01-02", endDate); err != nil {
+			return fmt.Errorf("end-date must be a date in YYYY-MM-DD format: %s", endDate)
+		}
+	}
+	for _, day := range skipDays {
+		valid := false
+		for _, d := range paceSkipDays {
+			if day == d {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return ErrInvalidValue("skip-days", day, paceSkipDays...)
+		}
+	}
+	return nil
+}
+
+// CoursesPaceGetOptions contains options for getting a course pace
+type CoursesPaceGetOptions struct {
+	CourseID  int64
+	SectionID int64
+	UserID    int64
+}
+
+// Validate validates the options
+func (o *CoursesPaceGetOptions) Validate() error {
+	return validatePaceTarget(o.CourseID, o.SectionID, o.UserID)
+}
+
+// CoursesPaceSetOptions contains options for creating or updating a course pace
+type CoursesPaceSetOptions struct {
+	CourseID        int64
+	SectionID       int64
+	UserID          int64
+	EndDate         string
+	SkipDays        []string
+	ExcludeWeekends bool
+	HardEndDates    bool
+	Durations       []string // MODULE_ITEM_ID=DAYS
+	// Track which fields were set
+	EndDateSet         bool
+	SkipDaysSet        bool
+	ExcludeWeekendsSet bool
+	HardEndDatesSet    bool
+	WaitOptions
+}
+
+// Validate validates the options
+func (o *CoursesPaceSetOptions) Validate() error {
+	if err := validatePaceTarget(o.CourseID, o.SectionID, o.UserID); err != nil {
+		return err
+	}
+	if !o.EndDateSet && !o.SkipDaysSet && !o.ExcludeWeekendsSet && !o.HardEndDatesSet && len(o.Durations) == 0 {
+		return fmt.Errorf("at least one pace setting is required")
+	}
+	if err := ValidatePaceSettings(o.EndDate, o.SkipDays); err != nil {
+		return err
+	}
+	return o.WaitOptions.Validate()
+}
+
+// CoursesPacePublishOptions contains options for publishing a course pace
+type CoursesPacePublishOptions struct {
+	CourseID  int64
+	SectionID int64
+	UserID    int64
+	WaitOptions
+}
+
+// Validate validates the options
+func (o *CoursesPacePublishOptions) Validate() error {
+	if err := validatePaceTarget(o.CourseID, o.SectionID, o.UserID); err != nil {
+		return err
+	}
+	return o.WaitOptions.Validate()
+}
+
+// CoursesPaceExportOptions contains options for exporting a course pace to YAML
+type CoursesPaceExportOptions struct {
+	CourseID  int64
+	SectionID int64
+	UserID    int64
+	File      string
+}
+
+// Validate validates the options
+func (o *CoursesPaceExportOptions) Validate() error {
+	return validatePaceTarget(o.CourseID, o.SectionID, o.UserID)
+}
+
+// CoursesPaceImportOptions contains options for applying a YAML pace to a
+// course, sections or students
+type CoursesPaceImportOptions struct {
+	CourseID   int64
+	File       string
+	SectionIDs []int64
+	UserIDs    []int64
+	DryRun     bool
+}
+
+// Validate validates the options
+func (o *CoursesPaceImportOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("file", o.File); err != nil {
+		return err
+	}
+	for _, id := range append(append([]int64{}, o.SectionIDs...), o.UserIDs...) {
+		if id <= 0 {
+			return fmt.Errorf("section and user IDs must be greater than 0")
+		}
+	}
+	return nil
+}
+
+// CoursesTabsListOptions contains options for listing course navigation tabs
+type CoursesTabsListOptions struct {
+	CourseID int64
+}
+
+// Validate validates the options
+func (o *CoursesTabsListOptions) Validate() error {
+	return ValidateRequired("course-id", o.CourseID)
+}
+
+// CoursesTabsUpdateOptions contains options for moving or hiding a course navigation tab
+type CoursesTabsUpdateOptions struct {
+	CourseID int64
+	TabID    string
+	Position int
+	Hidden   bool
+	Visible  bool
+	// Track which fields were set
+	PositionSet bool
+}
+
+// Validate validates the options
+func (o *CoursesTabsUpdateOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("tab-id", o.TabID); err != nil {
+		return err
+	}
+	if o.TabID == "home" || o.TabID == "settings" {
+		return fmt.Errorf("the %s tab cannot be moved or hidden", o.TabID)
+	}
+	if o.Hidden && o.Visible {
+		return fmt.Errorf("cannot specify both --hidden and --visible")
+	}
+	if !o.PositionSet && !o.Hidden && !o.Visible {
+		return fmt.Errorf("at least one of --position, --hidden or --visible is required")
+	}
+	if o.PositionSet && o.Position < 2 {
+		return fmt.Errorf("position must be at least 2, the home tab is always first")
+	}
+	return nil
+}
+
+// CoursesTabsApplyOptions contains options for applying a tab layout to many courses
+type CoursesTabsApplyOptions struct {
+	File        string
+	CourseIDs   []int64
+	AccountID   int64
+	Term        string
+	Concurrency int
+	DryRun      bool
+	Force       bool
+}
+
+// Validate validates the options
+func (o *CoursesTabsApplyOptions) Validate() error {
+	if err := ValidateRequired("file", o.File); err != nil {
+		return err
+	}
+	if len(o.CourseIDs) > 0 && (o.AccountID > 0 || o.Term != "") {
+		return fmt.Errorf("cannot specify --course-id with --account-id or --term")
+	}
+	for _, id := range o.CourseIDs {
+		if id <= 0 {
+			return fmt.Errorf("course IDs must be greater than 0")
+		}
+	}
+	if o.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package options
+
+import (
+	"fmt"
+)
+
+// FeatureContextOptions selects the account, course or user of a feature command
+type FeatureContextOptions struct {
+	AccountID int64
+	CourseID  int64
+	UserID    int64
+}
+
+// Validate validates the options
+func (o *FeatureContextOptions) Validate() error {
+	set := 0
+	for _, id := range []int64{o.AccountID, o.CourseID, o.UserID} {
+		if id > 0 {
+			set++
+		}
+	}
+	if set > 1 {
+		return fmt.Errorf("can only specify one of --account-id, --course-id or --user-id")
+	}
+	// AccountID is resolved by resolveAccountID when none is set
+	return nil
+}
+
+// FeaturesListOptions contains options for listing features
+type FeaturesListOptions struct {
+	FeatureContextOptions
+	Enabled bool
+}
+
+// Validate validates the options
+func (o *FeaturesListOptions) Validate() error {
+	return o.FeatureContextOptions.Validate()
+}
+
+// FeaturesGetOptions contains options for getting a feature flag
+type FeaturesGetOptions struct {
+	FeatureContextOptions
+	Feature string
+}
+
+// Validate validates the options
+func (o *FeaturesGetOptions) Validate() error {
+	if err := o.FeatureContextOptions.Validate(); err != nil {
+		return err
+	}
+	return ValidateRequired("feature", o.Feature)
+}
+
+// FeaturesSetOptions contains options for setting a feature flag
+type FeaturesSetOptions struct {
+	FeatureContextOptions
+	Feature     string
+	State       string
+	Term        string // ID, SIS term ID or name; applies to every course in the term
+	Concurrency int
+	DryRun      bool
+}
+
+// Validate validates the options
+func (o *FeaturesSetOptions) Validate() error {
+	if err := o.FeatureContextOptions.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateRequired("feature", o.Feature); err != nil {
+		return err
+	}
+	if err := ValidateRequired("state", o.State); err != nil {
+		return err
+	}
+
+	// Only accounts can delegate the decision with allowed/allowed_on
+	if o.CourseID > 0 || o.UserID > 0 || o.Term != "" {
+		switch o.State {
+		case "on", "off":
+		default:
+			return ErrInvalidValue("state", o.State, "on", "off")
+		}
+	} else {
+		switch o.State {
+		case "off", "allowed", "allowed_on", "on":
+		default:
+			return ErrInvalidValue("state", o.State, "off", "allowed", "allowed_on", "on")
+		}
+	}
+
+	if o.Term != "" {
+		if o.CourseID > 0 || o.UserID > 0 {
+			return fmt.Errorf("--term can only be combined with --account-id")
+		}
+		if o.Concurrency < 1 {
+			return fmt.Errorf("concurrency must be at least 1")
+		}
+	} else if o.DryRun {
+		return fmt.Errorf("--dry-run requires --term")
+	}
+
+	return nil
+}
+
+// FeaturesUnsetOptions contains options for removing a feature flag
+type FeaturesUnsetOptions struct {
+	FeatureContextOptions
+	Feature string
+	Force   bool
+}
+
+// Validate validates the options
+func (o *FeaturesUnsetOptions) Validate() error {
+	if err := o.FeatureContextOptions.Validate(); err != nil {
+		return err
+	}
+	return ValidateRequired("feature", o.Feature)
+}
+
+// FeaturesDiffOptions contains options for comparing feature flags
+type FeaturesDiffOptions struct {
+	IDA      int64
+	IDB      int64
+	Accounts bool // Compare accounts instead of courses
+	All      bool // Include features with the same state
+}
+
+// Validate validates the options
+func (o *FeaturesDiffOptions) Validate() error {
+	if o.IDA <= 0 || o.IDB <= 0 {
+		return fmt.Errorf("two IDs are required")
+	}
+	if o.IDA == o.IDB {
+		return fmt.Errorf("cannot compare a context with itself")
+	}
+	return nil
+}
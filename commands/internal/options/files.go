@@ -129,3 +129,171 @@ func (o *FilesQuotaOptions) Validate() error {
 
 	return nil
 }
+
+// filesOnDuplicate are the accepted --on-duplicate values for moves and copies
+var filesOnDuplicate = []string{"overwrite", "rename"}
+
+func validateOnDuplicate(onDuplicate string) error {
+	if onDuplicate == "" {
+		return nil
+	}
+	for _, v := range filesOnDuplicate {
+		if onDuplicate == v {
+			return nil
+		}
+	}
+	return ErrInvalidValue("on-duplicate", onDuplicate, filesOnDuplicate...)
+}
+
+// FilesMkdirOptions contains options for creating a course folder
+type FilesMkdirOptions struct {
+	CourseID int64
+	Path     string
+	Parents  bool
+}
+
+// Validate validates the options
+func (o *FilesMkdirOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	return ValidateRequired("path", o.Path)
+}
+
+// FilesMoveOptions contains options for moving or renaming a course file or folder
+type FilesMoveOptions struct {
+	CourseID    int64
+	Source      string
+	Destination string
+	OnDuplicate string
+}
+
+// Validate validates the options
+func (o *FilesMoveOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("source", o.Source); err != nil {
+		return err
+	}
+	if err := ValidateRequired("destination", o.Destination); err != nil {
+		return err
+	}
+	return validateOnDuplicate(o.OnDuplicate)
+}
+
+// FilesCopyOptions contains options for copying a course file or folder
+type FilesCopyOptions struct {
+	CourseID    int64
+	Source      string
+	Destination string
+	OnDuplicate string
+}
+
+// Validate validates the options
+func (o *FilesCopyOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("source", o.Source); err != nil {
+		return err
+	}
+	if err := ValidateRequired("destination", o.Destination); err != nil {
+		return err
+	}
+	return validateOnDuplicate(o.OnDuplicate)
+}
+
+// FilesLockOptions contains options for locking or unlocking course files and folders
+type FilesLockOptions struct {
+	CourseID  int64
+	Paths     []string
+	Unlock    bool
+	LockAt    string
+	UnlockAt  string
+	Recursive bool
+}
+
+// Validate validates the options
+func (o *FilesLockOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if len(o.Paths) == 0 {
+		return fmt.Errorf("at least one path is required")
+	}
+	if o.Unlock && (o.LockAt != "" || o.UnlockAt != "") {
+		return fmt.Errorf("--lock-at and --unlock-at cannot be used with unlock")
+	}
+	return nil
+}
+
+// usageRightsJustifications are the accepted --justification values
+var usageRightsJustifications = []string{"own_copyright", "used_by_permission", "fair_use", "public_domain", "creative_commons"}
+
+// FilesUsageRightsSetOptions contains options for setting the usage rights of course files
+type FilesUsageRightsSetOptions struct {
+	CourseID      int64
+	Paths         []string
+	Justification string
+	Copyright     string
+	License       string
+	Publish       bool
+}
+
+// Validate validates the options
+func (o *FilesUsageRightsSetOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if len(o.Paths) == 0 {
+		return fmt.Errorf("at least one path is required")
+	}
+	if err := ValidateRequired("justification", o.Justification); err != nil {
+		return err
+	}
+	valid := false
+	for _, v := range usageRightsJustifications {
+		if o.Justification == v {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return ErrInvalidValue("justification", o.Justification, usageRightsJustifications...)
+	}
+	if o.Justification == "creative_commons" && o.License == "" {
+		return fmt.Errorf("--license is required for creative_commons")
+	}
+	if o.Justification != "creative_commons" && o.License != "" {
+		return fmt.Errorf("--license can only be used with creative_commons")
+	}
+	return nil
+}
+
+// FilesUsageRightsRemoveOptions contains options for removing the usage rights of course files
+type FilesUsageRightsRemoveOptions struct {
+	CourseID int64
+	Paths    []string
+}
+
+// Validate validates the options
+func (o *FilesUsageRightsRemoveOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if len(o.Paths) == 0 {
+		return fmt.Errorf("at least one path is required")
+	}
+	return nil
+}
+
+// FilesUsageRightsLicensesOptions contains options for listing the licenses available to a course
+type FilesUsageRightsLicensesOptions struct {
+	CourseID int64
+}
+
+// Validate validates the options
+func (o *FilesUsageRightsLicensesOptions) Validate() error {
+	return ValidateRequired("course-id", o.CourseID)
+}
@@ -152,3 +152,79 @@ func (o *GradesColumnsDataSetOptions) Validate() error {
 	}
 	return nil
 }
+
+// GradesBulkUpdateOptions contains options for bulk updating grades
+type GradesBulkUpdateOptions struct {
+	CourseID int64
+	CSV      string
+	WaitOptions
+}
+
+// Validate validates the options
+func (o *GradesBulkUpdateOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("csv", o.CSV); err != nil {
+		return err
+	}
+	return o.WaitOptions.Validate()
+}
+
+// GradesPostOptions contains options for posting or hiding assignment grades
+type GradesPostOptions struct {
+	CourseID      int64
+	AssignmentIDs []int64
+	All           bool
+	SectionIDs    []int64
+	GradedOnly    bool
+	DryRun        bool
+	WaitOptions
+}
+
+// Validate validates the options
+func (o *GradesPostOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if len(o.AssignmentIDs) == 0 && !o.All {
+		return fmt.Errorf("either --assignment-id or --all is required")
+	}
+	if len(o.AssignmentIDs) > 0 && o.All {
+		return fmt.Errorf("--assignment-id and --all are mutually exclusive")
+	}
+	return o.WaitOptions.Validate()
+}
+
+// GradesPostPolicyGetOptions contains options for getting a post policy
+type GradesPostPolicyGetOptions struct {
+	CourseID     int64
+	AssignmentID int64
+}
+
+// Validate validates the options
+func (o *GradesPostPolicyGetOptions) Validate() error {
+	if o.CourseID <= 0 && o.AssignmentID <= 0 {
+		return fmt.Errorf("either --course-id or --assignment-id is required")
+	}
+	return nil
+}
+
+// GradesPostPolicySetOptions contains options for setting a post policy
+type GradesPostPolicySetOptions struct {
+	CourseID     int64
+	AssignmentID int64
+	Manual       bool
+	Automatic    bool
+}
+
+// Validate validates the options
+func (o *GradesPostPolicySetOptions) Validate() error {
+	if o.CourseID <= 0 && o.AssignmentID <= 0 {
+		return fmt.Errorf("either --course-id or --assignment-id is required")
+	}
+	if o.Manual == o.Automatic {
+		return fmt.Errorf("exactly one of --manual or --automatic is required")
+	}
+	return nil
+}
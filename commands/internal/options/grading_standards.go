@@ -0,0 +1,90 @@
+package options
+
+import (
+	"fmt"
+)
+
+// GradingStandardsListOptions contains options for listing grading standards
+type GradingStandardsListOptions struct {
+	AccountID int64
+	CourseID  int64
+}
+
+// Validate validates the options
+func (o *GradingStandardsListOptions) Validate() error {
+	return validateGradingStandardContext(o.AccountID, o.CourseID)
+}
+
+// GradingStandardsGetOptions contains options for getting a grading standard
+type GradingStandardsGetOptions struct {
+	AccountID  int64
+	CourseID   int64
+	StandardID int64
+}
+
+// Validate validates the options
+func (o *GradingStandardsGetOptions) Validate() error {
+	if err := validateGradingStandardContext(o.AccountID, o.CourseID); err != nil {
+		return err
+	}
+	return ValidateRequired("grading-standard-id", o.StandardID)
+}
+
+// GradingStandardsCreateOptions contains options for creating a grading standard
+type GradingStandardsCreateOptions struct {
+	AccountID     int64
+	CourseID      int64
+	File          string
+	Title         string
+	Entries       []string // name=value
+	PointsBased   bool
+	ScalingFactor float64
+}
+
+// Validate validates the options
+func (o *GradingStandardsCreateOptions) Validate() error {
+	if err := validateGradingStandardContext(o.AccountID, o.CourseID); err != nil {
+		return err
+	}
+	if o.File != "" {
+		if o.Title != "" || len(o.Entries) > 0 {
+			return fmt.Errorf("--file cannot be combined with --title or --entry")
+		}
+		return nil
+	}
+	if err := ValidateRequired("title", o.Title); err != nil {
+		return err
+	}
+	if len(o.Entries) == 0 {
+		return fmt.Errorf("at least one --entry is required")
+	}
+	return nil
+}
+
+// GradingStandardsPushOptions contains options for pushing a grading scheme to the courses of an account
+type GradingStandardsPushOptions struct {
+	AccountID   int64
+	File        string
+	Term        string
+	Concurrency int
+	DryRun      bool
+}
+
+// Validate validates the options
+func (o *GradingStandardsPushOptions) Validate() error {
+	if err := ValidateRequired("file", o.File); err != nil {
+		return err
+	}
+	if o.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+	return nil
+}
+
+func validateGradingStandardContext(accountID, courseID int64) error {
+	if accountID > 0 && courseID > 0 {
+		return fmt.Errorf("can only specify one of --account-id or --course-id")
+	}
+	// AccountID is resolved by resolveAccountID when neither is set
+	return nil
+}
@@ -0,0 +1,29 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GraphQLOptions contains options for running a GraphQL query
+type GraphQLOptions struct {
+	QueryFile     string
+	Vars          []string
+	OperationName string
+	Paginate      string
+}
+
+// Validate validates the options
+func (o *GraphQLOptions) Validate() error {
+	if err := ValidateRequired("query-file", o.QueryFile); err != nil {
+		return err
+	}
+
+	for _, v := range o.Vars {
+		if key, _, ok := strings.Cut(v, "="); !ok || key == "" {
+			return fmt.Errorf("invalid variable format: %s (use key=value)", v)
+		}
+	}
+
+	return nil
+}
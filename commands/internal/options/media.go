@@ -0,0 +1,124 @@
+package options
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// MediaListOptions contains options for listing media objects
+type MediaListOptions struct {
+	CourseID int64
+	Sort     string
+	Order    string
+}
+
+// Validate validates the options
+func (o *MediaListOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	switch o.Sort {
+	case "", "title", "created_at":
+	default:
+		return ErrInvalidValue("sort", o.Sort, "title", "created_at")
+	}
+	switch o.Order {
+	case "", "asc", "desc":
+	default:
+		return ErrInvalidValue("order", o.Order, "asc", "desc")
+	}
+	return nil
+}
+
+// MediaGetOptions contains options for getting a media object
+type MediaGetOptions struct {
+	MediaID string
+}
+
+// Validate validates the options
+func (o *MediaGetOptions) Validate() error {
+	return ValidateRequired("media-id", o.MediaID)
+}
+
+// MediaUpdateOptions contains options for updating a media object
+type MediaUpdateOptions struct {
+	MediaID string
+	Title   string
+}
+
+// Validate validates the options
+func (o *MediaUpdateOptions) Validate() error {
+	if err := ValidateRequired("media-id", o.MediaID); err != nil {
+		return err
+	}
+	return ValidateRequired("title", o.Title)
+}
+
+// MediaCaptionsListOptions contains options for listing media tracks
+type MediaCaptionsListOptions struct {
+	MediaID string
+}
+
+// Validate validates the options
+func (o *MediaCaptionsListOptions) Validate() error {
+	return ValidateRequired("media-id", o.MediaID)
+}
+
+// MediaCaptionsUploadOptions contains options for uploading a media track
+type MediaCaptionsUploadOptions struct {
+	MediaID string
+	File    string
+	Locale  string
+	Kind    string
+}
+
+// Validate validates the options
+func (o *MediaCaptionsUploadOptions) Validate() error {
+	if err := ValidateRequired("media-id", o.MediaID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("file", o.File); err != nil {
+		return err
+	}
+	if err := ValidateRequired("locale", o.Locale); err != nil {
+		return err
+	}
+	switch strings.ToLower(filepath.Ext(o.File)) {
+	case ".srt", ".vtt":
+	default:
+		return fmt.Errorf("caption file must be SRT (.srt) or WebVTT (.vtt): %s", o.File)
+	}
+	switch o.Kind {
+	case "subtitles", "captions", "descriptions", "chapters", "metadata":
+	default:
+		return ErrInvalidValue("kind", o.Kind, "subtitles", "captions", "descriptions", "chapters", "metadata")
+	}
+	return nil
+}
+
+// MediaCaptionsDeleteOptions contains options for deleting a media track
+type MediaCaptionsDeleteOptions struct {
+	MediaID string
+	Locale  string
+	Force   bool
+}
+
+// Validate validates the options
+func (o *MediaCaptionsDeleteOptions) Validate() error {
+	if err := ValidateRequired("media-id", o.MediaID); err != nil {
+		return err
+	}
+	return ValidateRequired("locale", o.Locale)
+}
+
+// MediaCaptionsAuditOptions contains options for auditing course captions
+type MediaCaptionsAuditOptions struct {
+	CourseID int64
+	Locale   string
+}
+
+// Validate validates the options
+func (o *MediaCaptionsAuditOptions) Validate() error {
+	return ValidateRequired("course-id", o.CourseID)
+}
@@ -2,14 +2,33 @@ package options
 
 import "fmt"
 
+// Quiz engines selected with --engine
+const (
+	QuizEngineClassic = "classic"
+	QuizEngineNew     = "new"
+)
+
+// validateQuizEngine checks the --engine flag; empty means classic
+func validateQuizEngine(engine string) error {
+	switch engine {
+	case "", QuizEngineClassic, QuizEngineNew:
+		return nil
+	}
+	return ErrInvalidValue("engine", engine, QuizEngineClassic, QuizEngineNew)
+}
+
 // QuizzesListOptions contains options for listing quizzes
 type QuizzesListOptions struct {
 	CourseID   int64
+	Engine     string
 	SearchTerm string
 }
 
 // Validate validates the options
 func (o *QuizzesListOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -19,11 +38,15 @@ func (o *QuizzesListOptions) Validate() error {
 // QuizzesGetOptions contains options for getting a quiz
 type QuizzesGetOptions struct {
 	CourseID int64
+	Engine   string
 	QuizID   int64
 }
 
 // Validate validates the options
 func (o *QuizzesGetOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -36,6 +59,7 @@ func (o *QuizzesGetOptions) Validate() error {
 // QuizzesCreateOptions contains options for creating a quiz
 type QuizzesCreateOptions struct {
 	CourseID             int64
+	Engine               string
 	Title                string
 	Description          string
 	QuizType             string
@@ -59,6 +83,9 @@ type QuizzesCreateOptions struct {
 
 // Validate validates the options
 func (o *QuizzesCreateOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -71,6 +98,7 @@ func (o *QuizzesCreateOptions) Validate() error {
 // QuizzesUpdateOptions contains options for updating a quiz
 type QuizzesUpdateOptions struct {
 	CourseID             int64
+	Engine               string
 	QuizID               int64
 	Title                string
 	Description          string
@@ -115,6 +143,9 @@ type QuizzesUpdateOptions struct {
 
 // Validate validates the options
 func (o *QuizzesUpdateOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -135,12 +166,16 @@ func (o *QuizzesUpdateOptions) Validate() error {
 // QuizzesDeleteOptions contains options for deleting a quiz
 type QuizzesDeleteOptions struct {
 	CourseID int64
+	Engine   string
 	QuizID   int64
 	Force    bool
 }
 
 // Validate validates the options
 func (o *QuizzesDeleteOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -153,11 +188,15 @@ func (o *QuizzesDeleteOptions) Validate() error {
 // QuizzesQuestionsListOptions contains options for listing quiz questions
 type QuizzesQuestionsListOptions struct {
 	CourseID int64
+	Engine   string
 	QuizID   int64
 }
 
 // Validate validates the options
 func (o *QuizzesQuestionsListOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -170,12 +209,16 @@ func (o *QuizzesQuestionsListOptions) Validate() error {
 // QuizzesQuestionsGetOptions contains options for getting a quiz question
 type QuizzesQuestionsGetOptions struct {
 	CourseID   int64
+	Engine     string
 	QuizID     int64
 	QuestionID int64
 }
 
 // Validate validates the options
 func (o *QuizzesQuestionsGetOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -191,6 +234,7 @@ func (o *QuizzesQuestionsGetOptions) Validate() error {
 // QuizzesQuestionsCreateOptions contains options for creating a quiz question
 type QuizzesQuestionsCreateOptions struct {
 	CourseID          int64
+	Engine            string
 	QuizID            int64
 	QuestionName      string
 	QuestionText      string
@@ -198,10 +242,28 @@ type QuizzesQuestionsCreateOptions struct {
 	PointsPossible    float64
 	CorrectComments   string
 	IncorrectComments string
+	// New Quizzes only
+	Choices  []string
+	Correct  string
+	Margin   float64
+	Position int
 }
 
-// Validate validates the options
+// classicToNewQuestionTypes maps classic question types to the New Quizzes
+// interaction types with the same behavior
+var classicToNewQuestionTypes = map[string]string{
+	"multiple_choice_question": "choice",
+	"true_false_question":      "true-false",
+	"essay_question":           "essay",
+	"numerical_question":       "numeric",
+}
+
+// Validate validates the options. With --engine new, classic question types
+// are mapped to their New Quizzes equivalents.
 func (o *QuizzesQuestionsCreateOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -211,12 +273,57 @@ func (o *QuizzesQuestionsCreateOptions) Validate() error {
 	if o.QuestionText == "" {
 		return fmt.Errorf("text is required")
 	}
+	if o.Engine != QuizEngineNew {
+		if len(o.Choices) > 0 || o.Correct != "" || o.Margin != 0 || o.Position != 0 {
+			return fmt.Errorf("--choice, --correct, --margin and --position require --engine new")
+		}
+		return nil
+	}
+
+	if mapped, ok := classicToNewQuestionTypes[o.QuestionType]; ok {
+		o.QuestionType = mapped
+	}
+
+	switch o.QuestionType {
+	case "choice":
+		if len(o.Choices) < 2 {
+			return fmt.Errorf("choice questions need at least 2 --choice values")
+		}
+		if err := ValidateRequired("correct", o.Correct); err != nil {
+			return err
+		}
+	case "true-false":
+		if o.Correct != "true" && o.Correct != "false" {
+			return ErrInvalidValue("correct", o.Correct, "true", "false")
+		}
+	case "essay":
+		if o.Correct != "" {
+			return fmt.Errorf("essay questions have no correct answer")
+		}
+	case "numeric":
+		if err := ValidateRequired("correct", o.Correct); err != nil {
+			return err
+		}
+	default:
+		return ErrInvalidValue("type", o.QuestionType, "choice", "true-false", "essay", "numeric")
+	}
+
+	if len(o.Choices) > 0 && o.QuestionType != "choice" {
+		return fmt.Errorf("--choice is only used by choice questions")
+	}
+	if o.Margin != 0 && o.QuestionType != "numeric" {
+		return fmt.Errorf("--margin is only used by numeric questions")
+	}
+	if o.Margin < 0 {
+		return fmt.Errorf("margin must not be negative")
+	}
 	return nil
 }
 
 // QuizzesQuestionsDeleteOptions contains options for deleting a quiz question
 type QuizzesQuestionsDeleteOptions struct {
 	CourseID   int64
+	Engine     string
 	QuizID     int64
 	QuestionID int64
 	Force      bool
@@ -224,6 +331,9 @@ type QuizzesQuestionsDeleteOptions struct {
 
 // Validate validates the options
 func (o *QuizzesQuestionsDeleteOptions) Validate() error {
+	if err := validateQuizEngine(o.Engine); err != nil {
+		return err
+	}
 	if o.CourseID <= 0 {
 		return fmt.Errorf("course-id is required and must be greater than 0")
 	}
@@ -273,3 +383,37 @@ func (o *QuizzesSubmissionsGetOptions) Validate() error {
 	}
 	return nil
 }
+
+// QuizzesAccommodationsSetOptions contains options for setting New Quizzes accommodations
+type QuizzesAccommodationsSetOptions struct {
+	CourseID      int64
+	QuizID        int64
+	UserIDs       []int64
+	ExtraTime     int
+	ExtraAttempts int
+	ReduceChoices bool
+	// Track which fields were set
+	ExtraTimeSet     bool
+	ExtraAttemptsSet bool
+	ReduceChoicesSet bool
+}
+
+// Validate validates the options
+func (o *QuizzesAccommodationsSetOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if len(o.UserIDs) == 0 {
+		return fmt.Errorf("at least one --user-id is required")
+	}
+	if !o.ExtraTimeSet && !o.ExtraAttemptsSet && !o.ReduceChoicesSet {
+		return fmt.Errorf("at least one of --extra-time, --extra-attempts or --reduce-choices is required")
+	}
+	if o.ExtraTime < 0 || o.ExtraAttempts < 0 {
+		return fmt.Errorf("extra time and attempts must not be negative")
+	}
+	if o.ExtraAttemptsSet && o.QuizID <= 0 {
+		return fmt.Errorf("--extra-attempts requires --quiz-id")
+	}
+	return nil
+}
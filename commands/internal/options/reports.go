@@ -0,0 +1,95 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReportsListOptions contains options for listing available account reports
+type ReportsListOptions struct {
+	AccountID int64
+}
+
+// Validate validates the options
+func (o *ReportsListOptions) Validate() error {
+	return ValidateRequired("account-id", o.AccountID)
+}
+
+// ReportsRunOptions contains options for running an account report
+type ReportsRunOptions struct {
+	AccountID  int64
+	ReportType string
+	Params     []string // key=value
+	Term       string   // ID, SIS term ID or name
+	OutputPath string
+	WaitOptions
+}
+
+// Validate validates the options
+func (o *ReportsRunOptions) Validate() error {
+	if err := ValidateRequired("account-id", o.AccountID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("report-type", o.ReportType); err != nil {
+		return err
+	}
+	for _, param := range o.Params {
+		key, _, found := strings.Cut(param, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return fmt.Errorf("invalid param %q: expected key=value", param)
+		}
+	}
+	return o.WaitOptions.Validate()
+}
+
+// ReportsGetOptions contains options for a single report run
+type ReportsGetOptions struct {
+	AccountID  int64
+	ReportType string
+	ReportID   int64
+}
+
+// Validate validates the options
+func (o *ReportsGetOptions) Validate() error {
+	if err := ValidateRequired("account-id", o.AccountID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("report-type", o.ReportType); err != nil {
+		return err
+	}
+	return ValidateRequired("report-id", o.ReportID)
+}
+
+// ReportsHistoryOptions contains options for listing previous runs of a report
+type ReportsHistoryOptions struct {
+	AccountID  int64
+	ReportType string
+}
+
+// Validate validates the options
+func (o *ReportsHistoryOptions) Validate() error {
+	if err := ValidateRequired("account-id", o.AccountID); err != nil {
+		return err
+	}
+	return ValidateRequired("report-type", o.ReportType)
+}
+
+// ReportsDownloadOptions contains options for downloading a finished report
+type ReportsDownloadOptions struct {
+	ReportsGetOptions
+	OutputPath string
+}
+
+// Validate validates the options
+func (o *ReportsDownloadOptions) Validate() error {
+	if err := o.ReportsGetOptions.Validate(); err != nil {
+		return err
+	}
+	return ValidateRequired("out", o.OutputPath)
+}
+
+// ReportsDeleteOptions contains options for deleting a report run
+type ReportsDeleteOptions struct {
+	ReportsGetOptions
+	Force bool
+}
@@ -42,6 +42,7 @@ type SISImportsCreateOptions struct {
 	DiffingID          string
 	DiffingRemaster    bool
 	ChangeThreshold    float64
+	WaitOptions
 	// Track which fields were set
 	BatchModeSet          bool
 	BatchModeTermIDSet    bool
@@ -57,7 +58,10 @@ func (o *SISImportsCreateOptions) Validate() error {
 	if err := ValidateRequired("account-id", o.AccountID); err != nil {
 		return err
 	}
-	return ValidateRequired("file", o.FilePath)
+	if err := ValidateRequired("file", o.FilePath); err != nil {
+		return err
+	}
+	return o.WaitOptions.Validate()
 }
 
 // SISImportsAbortOptions contains options for aborting a SIS import
@@ -79,6 +83,7 @@ type SISImportsRestoreOptions struct {
 	AccountID int64
 	ImportID  int64
 	BatchMode bool
+	WaitOptions
 }
 
 // Validate validates the options
@@ -86,7 +91,10 @@ func (o *SISImportsRestoreOptions) Validate() error {
 	if err := ValidateRequired("account-id", o.AccountID); err != nil {
 		return err
 	}
-	return ValidateRequired("import-id", o.ImportID)
+	if err := ValidateRequired("import-id", o.ImportID); err != nil {
+		return err
+	}
+	return o.WaitOptions.Validate()
 }
 
 // SISImportsErrorsOptions contains options for listing SIS import errors
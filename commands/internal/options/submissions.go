@@ -162,3 +162,93 @@ func (o *SubmissionsDeleteCommentOptions) Validate() error {
 	}
 	return nil
 }
+
+// SubmissionsProvisionalListOptions contains options for listing provisional grades
+type SubmissionsProvisionalListOptions struct {
+	CourseID     int64
+	AssignmentID int64
+}
+
+// Validate validates the options
+func (o *SubmissionsProvisionalListOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	return ValidateRequired("assignment-id", o.AssignmentID)
+}
+
+// SubmissionsProvisionalStatusOptions contains options for checking whether
+// a student needs a provisional grade
+type SubmissionsProvisionalStatusOptions struct {
+	CourseID     int64
+	AssignmentID int64
+	UserID       int64
+}
+
+// Validate validates the options
+func (o *SubmissionsProvisionalStatusOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("assignment-id", o.AssignmentID); err != nil {
+		return err
+	}
+	return ValidateRequired("user-id", o.UserID)
+}
+
+// SubmissionsProvisionalSelectOptions contains options for selecting a provisional grade
+type SubmissionsProvisionalSelectOptions struct {
+	CourseID           int64
+	AssignmentID       int64
+	ProvisionalGradeID int64
+}
+
+// Validate validates the options
+func (o *SubmissionsProvisionalSelectOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("assignment-id", o.AssignmentID); err != nil {
+		return err
+	}
+	return ValidateRequired("provisional-grade-id", o.ProvisionalGradeID)
+}
+
+// SubmissionsProvisionalPublishOptions contains options for publishing provisional grades
+type SubmissionsProvisionalPublishOptions struct {
+	CourseID     int64
+	AssignmentID int64
+	Force        bool
+}
+
+// Validate validates the options
+func (o *SubmissionsProvisionalPublishOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	return ValidateRequired("assignment-id", o.AssignmentID)
+}
+
+// SubmissionsProvisionalStudentsOptions contains options for listing or
+// extending the moderation set
+type SubmissionsProvisionalStudentsOptions struct {
+	CourseID     int64
+	AssignmentID int64
+	Add          []int64
+}
+
+// Validate validates the options
+func (o *SubmissionsProvisionalStudentsOptions) Validate() error {
+	if err := ValidateRequired("course-id", o.CourseID); err != nil {
+		return err
+	}
+	if err := ValidateRequired("assignment-id", o.AssignmentID); err != nil {
+		return err
+	}
+	for _, id := range o.Add {
+		if id <= 0 {
+			return fmt.Errorf("invalid student ID: %d", id)
+		}
+	}
+	return nil
+}
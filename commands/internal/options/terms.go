@@ -0,0 +1,103 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TermsListOptions contains options for listing enrollment terms
+type TermsListOptions struct {
+	AccountID     int64
+	WorkflowState string
+	Include       []string
+	Name          string
+}
+
+// Validate validates the options
+func (o *TermsListOptions) Validate() error {
+	// AccountID is resolved by resolveAccountID, so no validation needed here
+	if o.WorkflowState != "" {
+		switch o.WorkflowState {
+		case "active", "deleted", "all":
+		default:
+			return ErrInvalidValue("state", o.WorkflowState, "active", "deleted", "all")
+		}
+	}
+	return nil
+}
+
+// TermsGetOptions contains options for getting an enrollment term
+type TermsGetOptions struct {
+	AccountID int64
+	Term      string // ID, SIS term ID or name
+}
+
+// Validate validates the options
+func (o *TermsGetOptions) Validate() error {
+	return ValidateRequired("term", o.Term)
+}
+
+// TermsCreateOptions contains options for creating an enrollment term
+type TermsCreateOptions struct {
+	AccountID int64
+	Name      string
+	StartAt   string
+	EndAt     string
+	SISTermID string
+	Overrides []string // EnrollmentType=start,end
+}
+
+// Validate validates the options
+func (o *TermsCreateOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	return validateTermOverrides(o.Overrides)
+}
+
+// TermsUpdateOptions contains options for updating an enrollment term
+type TermsUpdateOptions struct {
+	AccountID int64
+	TermID    int64
+	Name      string
+	StartAt   string
+	EndAt     string
+	SISTermID string
+	Overrides []string // EnrollmentType=start,end
+	// Track which fields were set
+	NameSet      bool
+	StartAtSet   bool
+	EndAtSet     bool
+	SISTermIDSet bool
+}
+
+// Validate validates the options
+func (o *TermsUpdateOptions) Validate() error {
+	if err := ValidateRequired("term-id", o.TermID); err != nil {
+		return err
+	}
+	return validateTermOverrides(o.Overrides)
+}
+
+// TermsDeleteOptions contains options for deleting an enrollment term
+type TermsDeleteOptions struct {
+	AccountID int64
+	TermID    int64
+	Force     bool
+}
+
+// Validate validates the options
+func (o *TermsDeleteOptions) Validate() error {
+	return ValidateRequired("term-id", o.TermID)
+}
+
+// validateTermOverrides checks the EnrollmentType=start,end format of --override values
+func validateTermOverrides(overrides []string) error {
+	for _, override := range overrides {
+		enrollmentType, dates, ok := strings.Cut(override, "=")
+		if !ok || enrollmentType == "" || !strings.Contains(dates, ",") {
+			return fmt.Errorf("invalid override: %s (use EnrollmentType=start,end, e.g. StudentEnrollment=2026-08-20,2026-12-20)", override)
+		}
+	}
+	return nil
+}
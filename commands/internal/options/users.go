@@ -103,3 +103,299 @@ func (o *UsersUpdateOptions) Validate() error {
 	}
 	return nil
 }
+
+// UsersChannelsListOptions contains options for listing communication channels
+type UsersChannelsListOptions struct {
+	UserID int64 // 0 means the current user
+}
+
+// Validate validates the options
+func (o *UsersChannelsListOptions) Validate() error {
+	return nil
+}
+
+// UsersChannelsCreateOptions contains options for creating a communication channel
+type UsersChannelsCreateOptions struct {
+	UserID           int64
+	Address          string
+	Type             string
+	SkipConfirmation bool
+}
+
+// Validate validates the options
+func (o *UsersChannelsCreateOptions) Validate() error {
+	if err := ValidateRequired("address", o.Address); err != nil {
+		return err
+	}
+	switch o.Type {
+	case "email", "sms", "push":
+	default:
+		return ErrInvalidValue("type", o.Type, "email", "sms", "push")
+	}
+	return nil
+}
+
+// UsersChannelsDeleteOptions contains options for deleting a communication channel
+type UsersChannelsDeleteOptions struct {
+	UserID    int64
+	ChannelID int64
+	Force     bool
+}
+
+// Validate validates the options
+func (o *UsersChannelsDeleteOptions) Validate() error {
+	return ValidateRequired("channel-id", o.ChannelID)
+}
+
+// UsersNotificationsListOptions contains options for listing notification preferences
+type UsersNotificationsListOptions struct {
+	UserID      int64
+	ChannelID   int64 // 0 means the user's primary email channel
+	ChannelType string
+	Category    string
+}
+
+// Validate validates the options
+func (o *UsersNotificationsListOptions) Validate() error {
+	return nil
+}
+
+// UsersNotificationsSetOptions contains options for updating notification preferences
+type UsersNotificationsSetOptions struct {
+	UserID       int64
+	ChannelID    int64
+	ChannelType  string
+	Category     string
+	Notification string
+	Frequency    string
+}
+
+// Validate validates the options
+func (o *UsersNotificationsSetOptions) Validate() error {
+	if (o.Category == "") == (o.Notification == "") {
+		return fmt.Errorf("exactly one of --category or --notification is required")
+	}
+	return validateFrequency(o.Frequency)
+}
+
+// UsersNotificationsApplyOptions contains options for applying a preference
+// template to many users
+type UsersNotificationsApplyOptions struct {
+	TemplatePath string
+	UserIDs      []int64
+	UsersCSV     string
+	Concurrency  int
+	DryRun       bool
+}
+
+// Validate validates the options
+func (o *UsersNotificationsApplyOptions) Validate() error {
+	if err := ValidateRequired("template", o.TemplatePath); err != nil {
+		return err
+	}
+	if len(o.UserIDs) == 0 && o.UsersCSV == "" {
+		return fmt.Errorf("--users or --users-csv is required")
+	}
+	if o.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+	return nil
+}
+
+// validateFrequency checks a notification frequency
+func validateFrequency(frequency string) error {
+	switch frequency {
+	case "immediately", "daily", "weekly", "never":
+		return nil
+	default:
+		return ErrInvalidValue("frequency", frequency, "immediately", "daily", "weekly", "never")
+	}
+}
+
+// UsersLoginsListOptions contains options for listing logins
+type UsersLoginsListOptions struct {
+	UserID    int64
+	AccountID int64
+}
+
+// Validate validates the options
+func (o *UsersLoginsListOptions) Validate() error {
+	if o.UserID > 0 && o.AccountID > 0 {
+		return fmt.Errorf("can only specify one of user-id or --account-id")
+	}
+	return nil
+}
+
+// UsersLoginsCreateOptions contains options for creating a login
+type UsersLoginsCreateOptions struct {
+	AccountID      int64
+	UserID         int64
+	UniqueID       string
+	Password       string
+	SISUserID      string
+	IntegrationID  string
+	AuthProviderID string
+}
+
+// Validate validates the options
+func (o *UsersLoginsCreateOptions) Validate() error {
+	if err := ValidateRequired("user-id", o.UserID); err != nil {
+		return err
+	}
+	return ValidateRequired("unique-id", o.UniqueID)
+}
+
+// UsersLoginsUpdateOptions contains options for updating a login
+type UsersLoginsUpdateOptions struct {
+	AccountID      int64
+	LoginID        int64
+	UniqueID       string
+	Password       string
+	OldPassword    string
+	SISUserID      string
+	IntegrationID  string
+	AuthProviderID string
+	State          string
+	// Track which fields were set
+	UniqueIDSet       bool
+	PasswordSet       bool
+	OldPasswordSet    bool
+	SISUserIDSet      bool
+	IntegrationIDSet  bool
+	AuthProviderIDSet bool
+	StateSet          bool
+}
+
+// Validate validates the options
+func (o *UsersLoginsUpdateOptions) Validate() error {
+	if err := ValidateRequired("login-id", o.LoginID); err != nil {
+		return err
+	}
+	if !o.UniqueIDSet && !o.PasswordSet && !o.SISUserIDSet && !o.IntegrationIDSet && !o.AuthProviderIDSet && !o.StateSet {
+		return fmt.Errorf("at least one field to update is required")
+	}
+	if o.StateSet {
+		switch o.State {
+		case "active", "suspended":
+		default:
+			return ErrInvalidValue("state", o.State, "active", "suspended")
+		}
+	}
+	return nil
+}
+
+// UsersLoginsDeleteOptions contains options for deleting a login
+type UsersLoginsDeleteOptions struct {
+	UserID  int64
+	LoginID int64
+	Force   bool
+}
+
+// Validate validates the options
+func (o *UsersLoginsDeleteOptions) Validate() error {
+	if err := ValidateRequired("user-id", o.UserID); err != nil {
+		return err
+	}
+	return ValidateRequired("login-id", o.LoginID)
+}
+
+// UsersLoginsBulkUpdateOptions contains options for updating logins from a CSV file
+type UsersLoginsBulkUpdateOptions struct {
+	AccountID   int64
+	CSV         string
+	Concurrency int
+	DryRun      bool
+}
+
+// Validate validates the options
+func (o *UsersLoginsBulkUpdateOptions) Validate() error {
+	if err := ValidateRequired("csv", o.CSV); err != nil {
+		return err
+	}
+	if o.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+	return nil
+}
+
+// UsersObserveesListOptions contains options for listing the students a user observes
+type UsersObserveesListOptions struct {
+	UserID int64
+}
+
+// Validate validates the options
+func (o *UsersObserveesListOptions) Validate() error {
+	return ValidateRequired("user-id", o.UserID)
+}
+
+// UsersObserversListOptions contains options for listing the observers of a student
+type UsersObserversListOptions struct {
+	StudentID int64
+}
+
+// Validate validates the options
+func (o *UsersObserversListOptions) Validate() error {
+	return ValidateRequired("student-id", o.StudentID)
+}
+
+// UsersObserveesAddOptions contains options for linking an observer to a student
+type UsersObserveesAddOptions struct {
+	ObserverID  int64
+	StudentID   int64
+	PairingCode string
+}
+
+// Validate validates the options
+func (o *UsersObserveesAddOptions) Validate() error {
+	if err := ValidateRequired("observer-id", o.ObserverID); err != nil {
+		return err
+	}
+	if (o.StudentID > 0) == (o.PairingCode != "") {
+		return fmt.Errorf("exactly one of --student-id or --pairing-code is required")
+	}
+	return nil
+}
+
+// UsersObserveesRemoveOptions contains options for unlinking an observer from a student
+type UsersObserveesRemoveOptions struct {
+	ObserverID    int64
+	StudentID     int64
+	RootAccountID int64
+	Force         bool
+}
+
+// Validate validates the options
+func (o *UsersObserveesRemoveOptions) Validate() error {
+	if err := ValidateRequired("observer-id", o.ObserverID); err != nil {
+		return err
+	}
+	return ValidateRequired("student-id", o.StudentID)
+}
+
+// UsersObserveesPairingCodeOptions contains options for generating a pairing code
+type UsersObserveesPairingCodeOptions struct {
+	StudentID int64
+}
+
+// Validate validates the options
+func (o *UsersObserveesPairingCodeOptions) Validate() error {
+	return ValidateRequired("student-id", o.StudentID)
+}
+
+// UsersObserveesLinkOptions contains options for linking observers to students from a CSV file
+type UsersObserveesLinkOptions struct {
+	CSV         string
+	Concurrency int
+	DryRun      bool
+}
+
+// Validate validates the options
+func (o *UsersObserveesLinkOptions) Validate() error {
+	if err := ValidateRequired("csv", o.CSV); err != nil {
+		return err
+	}
+	if o.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package options
+
+import (
+	"fmt"
+	"time"
+)
+
+// WaitOptions contains the shared --wait/--timeout flags for commands that
+// start asynchronous Canvas jobs
+type WaitOptions struct {
+	Wait    bool
+	Timeout time.Duration
+}
+
+// Validate validates the options
+func (o *WaitOptions) Validate() error {
+	if o.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative")
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"sort"
 	"strings"
@@ -199,3 +200,39 @@ func NewPaginatedResponse(body string, nextPage string) MockResponse {
 	}
 	return resp
 }
+
+// NewCassetteResponses loads a cassette recorded with --record and converts it
+// into mock responses keyed by request path, so a recorded session can drive
+// command tests. When a path was requested more than once, the first recorded
+// response is used.
+func NewCassetteResponses(path string) (map[string]MockResponse, error) {
+	cassette, err := api.LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make(map[string]MockResponse)
+	for _, interaction := range cassette.Interactions {
+		requestPath := interaction.Request.URL
+		if parsed, err := url.Parse(requestPath); err == nil {
+			requestPath = parsed.Path
+		}
+
+		if _, exists := responses[requestPath]; exists {
+			continue
+		}
+
+		headers := make(map[string]string)
+		for key := range interaction.Response.Headers {
+			headers[key] = interaction.Response.Headers.Get(key)
+		}
+
+		responses[requestPath] = MockResponse{
+			StatusCode: interaction.Response.StatusCode,
+			Body:       interaction.Response.Body,
+			Headers:    headers,
+		}
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,486 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// mediaCmd represents the media command group
+var mediaCmd = &cobra.Command{
+	Use:   "media",
+	Short: "Manage media objects",
+	Long: `Manage the audio and video recordings hosted by Canvas and their caption
+tracks.
+
+Examples:
+  canvas media list --course-id 123
+  canvas media update m-abc123 --title "Lecture 1"
+  canvas media captions upload m-abc123 --file lecture1.srt --locale en
+  canvas media captions audit --course-id 123`,
+}
+
+// mediaCaptionsCmd represents the media captions command group
+var mediaCaptionsCmd = &cobra.Command{
+	Use:     "captions",
+	Aliases: []string{"tracks"},
+	Short:   "Manage caption tracks",
+	Long: `Manage the caption and subtitle tracks of media objects. Tracks are
+uploaded as SRT or WebVTT files, one per locale.`,
+}
+
+func init() {
+	rootCmd.AddCommand(mediaCmd)
+	mediaCmd.AddCommand(newMediaListCmd())
+	mediaCmd.AddCommand(newMediaGetCmd())
+	mediaCmd.AddCommand(newMediaUpdateCmd())
+	mediaCmd.AddCommand(mediaCaptionsCmd)
+
+	mediaCaptionsCmd.AddCommand(newMediaCaptionsListCmd())
+	mediaCaptionsCmd.AddCommand(newMediaCaptionsUploadCmd())
+	mediaCaptionsCmd.AddCommand(newMediaCaptionsDeleteCmd())
+	mediaCaptionsCmd.AddCommand(newMediaCaptionsAuditCmd())
+}
+
+func newMediaListCmd() *cobra.Command {
+	opts := &options.MediaListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List media objects in a course",
+		Long: `List the media objects of a course.
+
+Examples:
+  canvas media list --course-id 123
+  canvas media list --course-id 123 --sort created_at --order desc`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runMediaList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().StringVar(&opts.Sort, "sort", "", "Sort by: title, created_at")
+	cmd.Flags().StringVar(&opts.Order, "order", "", "Sort order: asc, desc")
+	cmd.MarkFlagRequired("course-id")
+
+	return cmd
+}
+
+func newMediaGetCmd() *cobra.Command {
+	opts := &options.MediaGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <media-id>",
+		Short: "Get a media object",
+		Long: `Get a media object with its sources and caption tracks.
+
+Examples:
+  canvas media get m-abc123
+  canvas media get m-abc123 -o json`,
+		Args: ExactArgsWithUsage(1, "media-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.MediaID = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runMediaGet(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newMediaUpdateCmd() *cobra.Command {
+	opts := &options.MediaUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <media-id>",
+		Short: "Update a media object",
+		Long: `Update the title of a media object.
+
+Examples:
+  canvas media update m-abc123 --title "Lecture 1: Introduction"`,
+		Args: ExactArgsWithUsage(1, "media-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.MediaID = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runMediaUpdate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Title, "title", "", "New title (required)")
+	cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func newMediaCaptionsListCmd() *cobra.Command {
+	opts := &options.MediaCaptionsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <media-id>",
+		Short: "List the tracks of a media object",
+		Long: `List the caption and subtitle tracks of a media object.
+
+Examples:
+  canvas media captions list m-abc123`,
+		Args: ExactArgsWithUsage(1, "media-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.MediaID = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runMediaCaptionsList(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newMediaCaptionsUploadCmd() *cobra.Command {
+	opts := &options.MediaCaptionsUploadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upload <media-id>",
+		Short: "Upload a caption track",
+		Long: `Upload an SRT or WebVTT caption track to a media object. An existing track
+with the same locale is replaced.
+
+Examples:
+  canvas media captions upload m-abc123 --file lecture1.srt --locale en
+  canvas media captions upload m-abc123 --file lecture1.es.vtt --locale es --kind captions`,
+		Args: ExactArgsWithUsage(1, "media-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.MediaID = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runMediaCaptionsUpload(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.File, "file", "", "SRT or WebVTT file (required)")
+	cmd.Flags().StringVar(&opts.Locale, "locale", "", "Track language, e.g. en, es (required)")
+	cmd.Flags().StringVar(&opts.Kind, "kind", "subtitles", "Track kind: subtitles, captions, descriptions, chapters, metadata")
+	cmd.MarkFlagRequired("file")
+	cmd.MarkFlagRequired("locale")
+
+	return cmd
+}
+
+func newMediaCaptionsDeleteCmd() *cobra.Command {
+	opts := &options.MediaCaptionsDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <media-id>",
+		Short: "Delete a caption track",
+		Long: `Delete the track with the given locale from a media object.
+
+Examples:
+  canvas media captions delete m-abc123 --locale es
+  canvas media captions delete m-abc123 --locale es --force`,
+		Args: ExactArgsWithUsage(1, "media-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.MediaID = args[0]
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runMediaCaptionsDelete(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Locale, "locale", "", "Locale of the track to delete (required)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	cmd.MarkFlagRequired("locale")
+
+	return cmd
+}
+
+func newMediaCaptionsAuditCmd() *cobra.Command {
+	opts := &options.MediaCaptionsAuditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Report media objects without captions",
+		Long: `Report every media object in a course that has no caption track. Only
+subtitles and captions tracks count; with --locale, only tracks in that
+language count.
+
+Examples:
+  canvas media captions audit --course-id 123
+  canvas media captions audit --course-id 123 --locale en -o csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runMediaCaptionsAudit(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().StringVar(&opts.Locale, "locale", "", "Only count tracks in this locale")
+	cmd.MarkFlagRequired("course-id")
+
+	return cmd
+}
+
+func runMediaList(ctx context.Context, client *api.Client, opts *options.MediaListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "media.list", map[string]interface{}{
+		"course_id": opts.CourseID,
+	})
+
+	service := api.NewMediaObjectsService(client)
+
+	objects, err := service.ListCourse(ctx, opts.CourseID, &api.ListMediaObjectsOptions{
+		Sort:    opts.Sort,
+		Order:   opts.Order,
+		Exclude: []string{"sources"},
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "media.list", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to list media objects: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "media.list", len(objects))
+	return formatEmptyOrOutput(objects, "No media objects found")
+}
+
+func runMediaGet(ctx context.Context, client *api.Client, opts *options.MediaGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "media.get", map[string]interface{}{
+		"media_id": opts.MediaID,
+	})
+
+	service := api.NewMediaObjectsService(client)
+
+	object, err := service.Get(ctx, opts.MediaID)
+	if err != nil {
+		logger.LogCommandError(ctx, "media.get", err, map[string]interface{}{
+			"media_id": opts.MediaID,
+		})
+		return fmt.Errorf("failed to get media object: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "media.get", 1)
+	return formatOutput(object, nil)
+}
+
+func runMediaUpdate(ctx context.Context, client *api.Client, opts *options.MediaUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "media.update", map[string]interface{}{
+		"media_id": opts.MediaID,
+		"title":    opts.Title,
+	})
+
+	service := api.NewMediaObjectsService(client)
+
+	object, err := service.UpdateTitle(ctx, opts.MediaID, opts.Title)
+	if err != nil {
+		logger.LogCommandError(ctx, "media.update", err, map[string]interface{}{
+			"media_id": opts.MediaID,
+		})
+		return fmt.Errorf("failed to update media object: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "media.update", 1)
+	return formatSuccessOutput(object, fmt.Sprintf("Media object %s updated", opts.MediaID))
+}
+
+func runMediaCaptionsList(ctx context.Context, client *api.Client, opts *options.MediaCaptionsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "media.captions.list", map[string]interface{}{
+		"media_id": opts.MediaID,
+	})
+
+	service := api.NewMediaObjectsService(client)
+
+	tracks, err := service.ListTracks(ctx, opts.MediaID, false)
+	if err != nil {
+		logger.LogCommandError(ctx, "media.captions.list", err, map[string]interface{}{
+			"media_id": opts.MediaID,
+		})
+		return fmt.Errorf("failed to list media tracks: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "media.captions.list", len(tracks))
+	return formatEmptyOrOutput(tracks, fmt.Sprintf("Media object %s has no tracks", opts.MediaID))
+}
+
+func runMediaCaptionsUpload(ctx context.Context, client *api.Client, opts *options.MediaCaptionsUploadOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "media.captions.upload", map[string]interface{}{
+		"media_id": opts.MediaID,
+		"file":     opts.File,
+		"locale":   opts.Locale,
+	})
+
+	content, err := os.ReadFile(opts.File)
+	if err != nil {
+		return fmt.Errorf("failed to read caption file: %w", err)
+	}
+	if len(content) == 0 {
+		return fmt.Errorf("caption file %s is empty", opts.File)
+	}
+
+	service := api.NewMediaObjectsService(client)
+
+	tracks, err := service.UploadTrack(ctx, opts.MediaID, &api.MediaTrackParams{
+		Locale:  opts.Locale,
+		Kind:    opts.Kind,
+		Content: string(content),
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "media.captions.upload", err, map[string]interface{}{
+			"media_id": opts.MediaID,
+			"locale":   opts.Locale,
+		})
+		return fmt.Errorf("failed to upload caption track: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "media.captions.upload", 1)
+	return formatSuccessOutput(tracks, fmt.Sprintf("Uploaded %s track to media object %s", opts.Locale, opts.MediaID))
+}
+
+func runMediaCaptionsDelete(ctx context.Context, client *api.Client, opts *options.MediaCaptionsDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "media.captions.delete", map[string]interface{}{
+		"media_id": opts.MediaID,
+		"locale":   opts.Locale,
+	})
+
+	confirmed, err := confirmDelete("caption track", fmt.Sprintf("%s of media object %s", opts.Locale, opts.MediaID), opts.Force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	service := api.NewMediaObjectsService(client)
+
+	if _, err := service.DeleteTrack(ctx, opts.MediaID, opts.Locale); err != nil {
+		logger.LogCommandError(ctx, "media.captions.delete", err, map[string]interface{}{
+			"media_id": opts.MediaID,
+			"locale":   opts.Locale,
+		})
+		return fmt.Errorf("failed to delete caption track: %w", err)
+	}
+
+	fmt.Printf("Deleted %s track from media object %s\n", opts.Locale, opts.MediaID)
+
+	logger.LogCommandComplete(ctx, "media.captions.delete", 1)
+	return nil
+}
+
+func runMediaCaptionsAudit(ctx context.Context, client *api.Client, opts *options.MediaCaptionsAuditOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "media.captions.audit", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"locale":    opts.Locale,
+	})
+
+	service := api.NewMediaObjectsService(client)
+
+	objects, err := service.ListCourse(ctx, opts.CourseID, &api.ListMediaObjectsOptions{
+		Exclude: []string{"sources"},
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "media.captions.audit", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to list media objects: %w", err)
+	}
+
+	missing := make([]api.MediaObject, 0)
+	for _, object := range objects {
+		if !hasCaptionTrack(object, opts.Locale) {
+			missing = append(missing, object)
+		}
+	}
+
+	if err := formatEmptyOrOutput(missing, fmt.Sprintf("All %d media objects have captions", len(objects))); err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		fmt.Printf("\n%d of %d media objects have no captions\n", len(missing), len(objects))
+	}
+
+	logger.LogCommandComplete(ctx, "media.captions.audit", len(missing))
+	return nil
+}
+
+// hasCaptionTrack reports whether a media object has a subtitles or captions
+// track, in the given locale if one is set
+func hasCaptionTrack(object api.MediaObject, locale string) bool {
+	for _, track := range object.MediaTracks {
+		if track.Kind != "subtitles" && track.Kind != "captions" {
+			continue
+		}
+		if locale == "" || track.Locale == locale {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+const courseMediaMock = `[
+	{"media_id": "m-1", "title": "Lecture 1", "media_type": "video",
+	 "media_tracks": [{"id": 1, "kind": "subtitles", "locale": "en"}]},
+	{"media_id": "m-2", "title": "Lecture 2", "media_type": "video",
+	 "media_tracks": [{"id": 2, "kind": "chapters", "locale": "en"}]},
+	{"media_id": "m-3", "title": "Interview", "media_type": "audio",
+	 "media_tracks": [{"id": 3, "kind": "captions", "locale": "es"}]}
+]`
+
+func TestMediaCaptionsAuditCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "reports media without captions",
+			Args: []string{"--course-id", "1"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/1/media_objects": cmdtest.NewMockResponse(courseMediaMock),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "m-2") {
+					t.Error("Expected m-2, which only has chapters, in output")
+				}
+				if strings.Contains(output, "m-1") || strings.Contains(output, "m-3") {
+					t.Error("Expected captioned media to be left out")
+				}
+				if !strings.Contains(output, "1 of 3 media objects have no captions") {
+					t.Error("Expected summary in output")
+				}
+			},
+		},
+		{
+			Name: "reports media without captions in a locale",
+			Args: []string{"--course-id", "1", "--locale", "en"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/1/media_objects": cmdtest.NewMockResponse(courseMediaMock),
+			},
+			ExpectOutput: "2 of 3 media objects have no captions",
+		},
+		{
+			Name: "all captioned",
+			Args: []string{"--course-id", "1"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/1/media_objects": cmdtest.NewMockResponse(`[
+					{"media_id": "m-1", "title": "Lecture 1", "media_tracks": [{"id": 1, "kind": "subtitles", "locale": "en"}]}
+				]`),
+			},
+			ExpectOutput: "All 1 media objects have captions",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newMediaCaptionsAuditCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestMediaCaptionsUploadCmd(t *testing.T) {
+	dir := t.TempDir()
+	srt := filepath.Join(dir, "lecture.srt")
+	if err := os.WriteFile(srt, []byte("1\n00:00:00,000 --> 00:00:01,000\nHello\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "upload srt",
+			Args: []string{"m-1", "--file", srt, "--locale", "en"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/media_objects/m-1/media_tracks": cmdtest.NewMockResponse(`[{"id": 1, "kind": "subtitles", "locale": "en"}]`),
+			},
+			ExpectOutput: "Uploaded en track to media object m-1",
+		},
+		{
+			Name:        "unsupported file type",
+			Args:        []string{"m-1", "--file", filepath.Join(dir, "lecture.txt"), "--locale", "en"},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid kind",
+			Args:        []string{"m-1", "--file", srt, "--locale", "en", "--kind", "karaoke"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newMediaCaptionsUploadCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
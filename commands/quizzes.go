@@ -22,10 +22,22 @@ var quizzesCmd = &cobra.Command{
 Quizzes allow you to create assessments with various question types including
 multiple choice, true/false, short answer, and more.
 
+Commands use classic quizzes by default. Pass --engine new to work with New
+Quizzes instead, which are identified by their assignment ID.
+
 Examples:
   canvas quizzes list --course-id 123
   canvas quizzes get 456 --course-id 123
-  canvas quizzes create --course-id 123 --title "Midterm Exam" --quiz-type assignment`,
+  canvas quizzes create --course-id 123 --title "Midterm Exam" --quiz-type assignment
+  canvas quizzes list --course-id 123 --engine new
+  canvas quizzes questions create --course-id 123 --quiz-id 456 --engine new --type choice --text "2+2?" --choice 3 --choice 4 --correct 2`,
+}
+
+// classicOnlyQuizFlags are quiz create and update flags New Quizzes has no
+// equivalent for
+var classicOnlyQuizFlags = []string{
+	"quiz-type", "hide-results", "show-correct", "scoring-policy", "attempts",
+	"access-code", "ip-filter", "published", "anonymous",
 }
 
 // quizzesQuestionsCmd represents the quizzes questions command group
@@ -53,6 +65,7 @@ func init() {
 	quizzesCmd.AddCommand(newQuizzesDeleteCmd())
 	quizzesCmd.AddCommand(quizzesQuestionsCmd)
 	quizzesCmd.AddCommand(quizzesSubmissionsCmd)
+	quizzesCmd.AddCommand(quizzesAccommodationsCmd)
 
 	// Questions subcommands
 	quizzesQuestionsCmd.AddCommand(newQuizzesQuestionsListCmd())
@@ -60,6 +73,9 @@ func init() {
 	quizzesQuestionsCmd.AddCommand(newQuizzesQuestionsCreateCmd())
 	quizzesQuestionsCmd.AddCommand(newQuizzesQuestionsDeleteCmd())
 
+	// Accommodations subcommands
+	quizzesAccommodationsCmd.AddCommand(newQuizzesAccommodationsSetCmd())
+
 	// Submissions subcommands
 	quizzesSubmissionsCmd.AddCommand(newQuizzesSubmissionsListCmd())
 	quizzesSubmissionsCmd.AddCommand(newQuizzesSubmissionsGetCmd())
@@ -91,6 +107,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().StringVar(&opts.SearchTerm, "search", "", "Search term")
 	cmd.MarkFlagRequired("course-id")
 
@@ -129,6 +146,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.MarkFlagRequired("course-id")
 
 	return cmd
@@ -145,11 +163,15 @@ func newQuizzesCreateCmd() *cobra.Command {
 Examples:
   canvas quizzes create --course-id 123 --title "Midterm Exam" --quiz-type assignment
   canvas quizzes create --course-id 123 --title "Practice Quiz" --quiz-type practice_quiz --time-limit 30
-  canvas quizzes create --course-id 123 --title "Survey" --quiz-type survey --anonymous`,
+  canvas quizzes create --course-id 123 --title "Survey" --quiz-type survey --anonymous
+  canvas quizzes create --course-id 123 --title "Unit 1 Check" --engine new --time-limit 20`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.Validate(); err != nil {
 				return err
 			}
+			if err := rejectClassicQuizFlags(cmd, opts.Engine, classicOnlyQuizFlags...); err != nil {
+				return err
+			}
 
 			client, err := getAPIClient()
 			if err != nil {
@@ -161,6 +183,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().StringVar(&opts.Title, "title", "", "Quiz title (required)")
 	cmd.Flags().StringVar(&opts.Description, "description", "", "Quiz description")
 	cmd.Flags().StringVar(&opts.QuizType, "quiz-type", "assignment", "Quiz type: practice_quiz, assignment, graded_survey, survey")
@@ -230,6 +253,9 @@ Examples:
 			if err := opts.Validate(); err != nil {
 				return err
 			}
+			if err := rejectClassicQuizFlags(cmd, opts.Engine, classicOnlyQuizFlags...); err != nil {
+				return err
+			}
 
 			client, err := getAPIClient()
 			if err != nil {
@@ -241,6 +267,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().StringVar(&opts.Title, "title", "", "Quiz title")
 	cmd.Flags().StringVar(&opts.Description, "description", "", "Quiz description")
 	cmd.Flags().StringVar(&opts.QuizType, "quiz-type", "", "Quiz type")
@@ -298,6 +325,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
 	cmd.MarkFlagRequired("course-id")
 
@@ -329,6 +357,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().Int64Var(&opts.QuizID, "quiz-id", 0, "Quiz ID (required)")
 	cmd.MarkFlagRequired("course-id")
 	cmd.MarkFlagRequired("quiz-id")
@@ -368,6 +397,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().Int64Var(&opts.QuizID, "quiz-id", 0, "Quiz ID (required)")
 	cmd.MarkFlagRequired("course-id")
 	cmd.MarkFlagRequired("quiz-id")
@@ -383,12 +413,22 @@ func newQuizzesQuestionsCreateCmd() *cobra.Command {
 		Short: "Create a new question",
 		Long: `Create a new question in a quiz.
 
+With --engine new the question is created as a New Quizzes item of type
+choice, true-false, essay or numeric; the matching classic types are accepted
+too. --correct is the number of the correct --choice, true or false, or the
+numeric answer.
+
 Examples:
-  canvas quizzes questions create --course-id 123 --quiz-id 456 --text "What is 2+2?" --type multiple_choice_question --points 10`,
+  canvas quizzes questions create --course-id 123 --quiz-id 456 --text "What is 2+2?" --type multiple_choice_question --points 10
+  canvas quizzes questions create --course-id 123 --quiz-id 456 --engine new --type choice --text "What is 2+2?" --choice 3 --choice 4 --correct 2
+  canvas quizzes questions create --course-id 123 --quiz-id 456 --engine new --type numeric --text "Value of pi?" --correct 3.14 --margin 0.01`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.Validate(); err != nil {
 				return err
 			}
+			if err := rejectClassicQuizFlags(cmd, opts.Engine, "correct-comments", "incorrect-comments"); err != nil {
+				return err
+			}
 
 			client, err := getAPIClient()
 			if err != nil {
@@ -400,6 +440,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().Int64Var(&opts.QuizID, "quiz-id", 0, "Quiz ID (required)")
 	cmd.Flags().StringVar(&opts.QuestionName, "name", "", "Question name")
 	cmd.Flags().StringVar(&opts.QuestionText, "text", "", "Question text (required)")
@@ -407,6 +448,10 @@ Examples:
 	cmd.Flags().Float64Var(&opts.PointsPossible, "points", 0, "Points possible")
 	cmd.Flags().StringVar(&opts.CorrectComments, "correct-comments", "", "Comments for correct answer")
 	cmd.Flags().StringVar(&opts.IncorrectComments, "incorrect-comments", "", "Comments for incorrect answer")
+	cmd.Flags().StringArrayVar(&opts.Choices, "choice", nil, "Answer choice, repeatable (New Quizzes choice questions)")
+	cmd.Flags().StringVar(&opts.Correct, "correct", "", "Correct answer (New Quizzes)")
+	cmd.Flags().Float64Var(&opts.Margin, "margin", 0, "Accepted margin of error (New Quizzes numeric questions)")
+	cmd.Flags().IntVar(&opts.Position, "position", 0, "Position in the quiz (New Quizzes)")
 	cmd.MarkFlagRequired("course-id")
 	cmd.MarkFlagRequired("quiz-id")
 	cmd.MarkFlagRequired("text")
@@ -446,6 +491,7 @@ Examples:
 	}
 
 	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	addQuizEngineFlag(cmd, &opts.Engine)
 	cmd.Flags().Int64Var(&opts.QuizID, "quiz-id", 0, "Quiz ID (required)")
 	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
 	cmd.MarkFlagRequired("course-id")
@@ -528,6 +574,10 @@ Examples:
 // Run functions
 
 func runQuizzesList(ctx context.Context, client *api.Client, opts *options.QuizzesListOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizzesList(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.list", map[string]interface{}{
@@ -567,6 +617,10 @@ func runQuizzesList(ctx context.Context, client *api.Client, opts *options.Quizz
 }
 
 func runQuizzesGet(ctx context.Context, client *api.Client, opts *options.QuizzesGetOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizzesGet(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.get", map[string]interface{}{
@@ -594,6 +648,10 @@ func runQuizzesGet(ctx context.Context, client *api.Client, opts *options.Quizze
 }
 
 func runQuizzesCreate(ctx context.Context, client *api.Client, opts *options.QuizzesCreateOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizzesCreate(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.create", map[string]interface{}{
@@ -645,6 +703,10 @@ func runQuizzesCreate(ctx context.Context, client *api.Client, opts *options.Qui
 }
 
 func runQuizzesUpdate(ctx context.Context, client *api.Client, opts *options.QuizzesUpdateOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizzesUpdate(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.update", map[string]interface{}{
@@ -733,6 +795,10 @@ func runQuizzesUpdate(ctx context.Context, client *api.Client, opts *options.Qui
 }
 
 func runQuizzesDelete(ctx context.Context, client *api.Client, opts *options.QuizzesDeleteOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizzesDelete(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.delete", map[string]interface{}{
@@ -769,6 +835,10 @@ func runQuizzesDelete(ctx context.Context, client *api.Client, opts *options.Qui
 }
 
 func runQuizzesQuestionsList(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsListOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizItemsList(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.questions.list", map[string]interface{}{
@@ -796,6 +866,10 @@ func runQuizzesQuestionsList(ctx context.Context, client *api.Client, opts *opti
 }
 
 func runQuizzesQuestionsGet(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsGetOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizItemsGet(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.questions.get", map[string]interface{}{
@@ -825,6 +899,10 @@ func runQuizzesQuestionsGet(ctx context.Context, client *api.Client, opts *optio
 }
 
 func runQuizzesQuestionsCreate(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsCreateOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizItemsCreate(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.questions.create", map[string]interface{}{
@@ -863,6 +941,10 @@ func runQuizzesQuestionsCreate(ctx context.Context, client *api.Client, opts *op
 }
 
 func runQuizzesQuestionsDelete(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsDeleteOptions) error {
+	if opts.Engine == options.QuizEngineNew {
+		return runNewQuizItemsDelete(ctx, client, opts)
+	}
+
 	logger := logging.NewCommandLogger(verbose)
 
 	logger.LogCommandStart(ctx, "quizzes.questions.delete", map[string]interface{}{
@@ -0,0 +1,561 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// quizzesAccommodationsCmd represents the quizzes accommodations command group
+var quizzesAccommodationsCmd = &cobra.Command{
+	Use:   "accommodations",
+	Short: "Manage New Quizzes accommodations",
+	Long: `Manage the extra time, extra attempts and reduced choices New Quizzes
+gives to individual students. Accommodations apply to every New Quiz of the
+course, or to a single quiz with --quiz-id.
+
+Examples:
+  canvas quizzes accommodations set --course-id 123 --user-id 456 --extra-time 30
+  canvas quizzes accommodations set --course-id 123 --quiz-id 789 --user-id 456,457 --extra-attempts 1`,
+}
+
+// addQuizEngineFlag registers the --engine flag shared by quiz commands
+func addQuizEngineFlag(cmd *cobra.Command, engine *string) {
+	cmd.Flags().StringVar(engine, "engine", options.QuizEngineClassic, "Quiz engine: classic, new (New Quizzes)")
+}
+
+// rejectClassicQuizFlags returns an error if any of the given classic-only
+// flags was set on a command running against New Quizzes
+func rejectClassicQuizFlags(cmd *cobra.Command, engine string, flags ...string) error {
+	if engine != options.QuizEngineNew {
+		return nil
+	}
+
+	for _, flag := range flags {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("--%s is not supported with --engine new", flag)
+		}
+	}
+
+	return nil
+}
+
+func newQuizzesAccommodationsSetCmd() *cobra.Command {
+	opts := &options.QuizzesAccommodationsSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set New Quizzes accommodations for students",
+		Long: `Set New Quizzes accommodations for one or more students.
+
+Extra time is given in minutes. Extra attempts can only be granted for a
+single quiz.
+
+Examples:
+  canvas quizzes accommodations set --course-id 123 --user-id 456 --extra-time 30
+  canvas quizzes accommodations set --course-id 123 --user-id 456 --reduce-choices
+  canvas quizzes accommodations set --course-id 123 --quiz-id 789 --user-id 456 --extra-attempts 2`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ExtraTimeSet = cmd.Flags().Changed("extra-time")
+			opts.ExtraAttemptsSet = cmd.Flags().Changed("extra-attempts")
+			opts.ReduceChoicesSet = cmd.Flags().Changed("reduce-choices")
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runQuizzesAccommodationsSet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().Int64Var(&opts.QuizID, "quiz-id", 0, "Limit to this New Quiz (assignment ID)")
+	cmd.Flags().Int64SliceVar(&opts.UserIDs, "user-id", nil, "Student user IDs (comma-separated, required)")
+	cmd.Flags().IntVar(&opts.ExtraTime, "extra-time", 0, "Extra time in minutes")
+	cmd.Flags().IntVar(&opts.ExtraAttempts, "extra-attempts", 0, "Extra attempts (requires --quiz-id)")
+	cmd.Flags().BoolVar(&opts.ReduceChoices, "reduce-choices", false, "Reduce the number of choices in choice questions")
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("user-id")
+
+	return cmd
+}
+
+func runNewQuizzesList(ctx context.Context, client *api.Client, opts *options.QuizzesListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.list", map[string]interface{}{
+		"course_id":   opts.CourseID,
+		"search_term": opts.SearchTerm,
+	})
+
+	service := api.NewQuizEngineService(client)
+
+	quizzes, err := service.List(ctx, opts.CourseID)
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.list", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+		})
+		return fmt.Errorf("failed to list quizzes: %w", err)
+	}
+
+	// The quiz engine has no search parameter, so match titles locally
+	if opts.SearchTerm != "" {
+		term := strings.ToLower(opts.SearchTerm)
+		matched := make([]api.NewQuiz, 0, len(quizzes))
+		for _, quiz := range quizzes {
+			if strings.Contains(strings.ToLower(quiz.Title), term) {
+				matched = append(matched, quiz)
+			}
+		}
+		quizzes = matched
+	}
+
+	if err := formatEmptyOrOutput(quizzes, "No quizzes found"); err != nil {
+		return fmt.Errorf("failed to print results: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.new.list", len(quizzes))
+	return nil
+}
+
+func runNewQuizzesGet(ctx context.Context, client *api.Client, opts *options.QuizzesGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.get", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"quiz_id":   opts.QuizID,
+	})
+
+	service := api.NewQuizEngineService(client)
+
+	quiz, err := service.Get(ctx, opts.CourseID, opts.QuizID)
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.get", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+		})
+		return fmt.Errorf("failed to get quiz: %w", err)
+	}
+
+	if err := formatOutput(quiz, nil); err != nil {
+		return fmt.Errorf("failed to print results: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.new.get", 1)
+	return nil
+}
+
+func runNewQuizzesCreate(ctx context.Context, client *api.Client, opts *options.QuizzesCreateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.create", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"title":     opts.Title,
+	})
+
+	params := &api.NewQuizParams{
+		Title:             opts.Title,
+		Instructions:      opts.Description,
+		AssignmentGroupID: opts.AssignmentGroupID,
+		DueAt:             opts.DueAt,
+		LockAt:            opts.LockAt,
+		UnlockAt:          opts.UnlockAt,
+	}
+
+	settings := &api.NewQuizSettings{}
+	enabled, disabled := true, false
+	if opts.TimeLimit > 0 {
+		seconds := opts.TimeLimit * 60
+		settings.HasTimeLimit = &enabled
+		settings.SessionTimeLimitInSeconds = &seconds
+	}
+	if opts.ShuffleAnswers {
+		settings.ShuffleAnswers = &enabled
+	}
+	if opts.OneQuestionAtATime {
+		oneAtATime := "question"
+		settings.OneAtATimeType = &oneAtATime
+	}
+	if opts.CantGoBack {
+		settings.AllowBacktracking = &disabled
+	}
+	if *settings != (api.NewQuizSettings{}) {
+		params.Settings = settings
+	}
+
+	service := api.NewQuizEngineService(client)
+
+	quiz, err := service.Create(ctx, opts.CourseID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.create", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"title":     opts.Title,
+		})
+		return fmt.Errorf("failed to create quiz: %w", err)
+	}
+
+	fmt.Printf("Quiz created successfully (ID: %s)\n", quiz.ID)
+	if err := formatOutput(quiz, nil); err != nil {
+		return fmt.Errorf("failed to print results: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.new.create", 1)
+	return nil
+}
+
+func runNewQuizzesUpdate(ctx context.Context, client *api.Client, opts *options.QuizzesUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.update", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"quiz_id":   opts.QuizID,
+	})
+
+	params := &api.NewQuizParams{}
+	if opts.TitleSet {
+		params.Title = opts.Title
+	}
+	if opts.DescriptionSet {
+		params.Instructions = opts.Description
+	}
+	if opts.AssignmentGroupIDSet {
+		params.AssignmentGroupID = opts.AssignmentGroupID
+	}
+	if opts.DueAtSet {
+		params.DueAt = opts.DueAt
+	}
+	if opts.LockAtSet {
+		params.LockAt = opts.LockAt
+	}
+	if opts.UnlockAtSet {
+		params.UnlockAt = opts.UnlockAt
+	}
+
+	settings := &api.NewQuizSettings{}
+	if opts.TimeLimitSet {
+		hasTimeLimit, seconds := opts.TimeLimit > 0, opts.TimeLimit*60
+		settings.HasTimeLimit = &hasTimeLimit
+		settings.SessionTimeLimitInSeconds = &seconds
+	}
+	if opts.ShuffleAnswersSet {
+		settings.ShuffleAnswers = &opts.ShuffleAnswers
+	}
+	if opts.OneQuestionAtATimeSet {
+		oneAtATime := "none"
+		if opts.OneQuestionAtATime {
+			oneAtATime = "question"
+		}
+		settings.OneAtATimeType = &oneAtATime
+	}
+	if opts.CantGoBackSet {
+		allowBacktracking := !opts.CantGoBack
+		settings.AllowBacktracking = &allowBacktracking
+	}
+	if *settings != (api.NewQuizSettings{}) {
+		params.Settings = settings
+	}
+
+	service := api.NewQuizEngineService(client)
+
+	quiz, err := service.Update(ctx, opts.CourseID, opts.QuizID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.update", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+		})
+		return fmt.Errorf("failed to update quiz: %w", err)
+	}
+
+	fmt.Printf("Quiz updated successfully (ID: %s)\n", quiz.ID)
+	if err := formatOutput(quiz, nil); err != nil {
+		return fmt.Errorf("failed to print results: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.new.update", 1)
+	return nil
+}
+
+func runNewQuizzesDelete(ctx context.Context, client *api.Client, opts *options.QuizzesDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.delete", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"quiz_id":   opts.QuizID,
+	})
+
+	if !opts.Force {
+		fmt.Printf("WARNING: This will delete quiz %d.\n", opts.QuizID)
+		fmt.Print("Type 'yes' to confirm: ")
+		var confirm string
+		fmt.Scanln(&confirm)
+		if confirm != "yes" {
+			fmt.Println("Delete cancelled")
+			return nil
+		}
+	}
+
+	service := api.NewQuizEngineService(client)
+
+	if err := service.Delete(ctx, opts.CourseID, opts.QuizID); err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.delete", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+		})
+		return fmt.Errorf("failed to delete quiz: %w", err)
+	}
+
+	fmt.Printf("Quiz %d deleted\n", opts.QuizID)
+
+	logger.LogCommandComplete(ctx, "quizzes.new.delete", 1)
+	return nil
+}
+
+func runNewQuizItemsList(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.items.list", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"quiz_id":   opts.QuizID,
+	})
+
+	service := api.NewQuizEngineService(client)
+
+	items, err := service.ListItems(ctx, opts.CourseID, opts.QuizID)
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.items.list", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+		})
+		return fmt.Errorf("failed to list questions: %w", err)
+	}
+
+	if len(items) == 0 {
+		if err := formatEmptyOrOutput(items, "No questions found"); err != nil {
+			return fmt.Errorf("failed to print results: %w", err)
+		}
+		logger.LogCommandComplete(ctx, "quizzes.new.items.list", 0)
+		return nil
+	}
+
+	if err := formatOutput(items, func() { printNewQuizItems(items) }); err != nil {
+		return fmt.Errorf("failed to print results: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.new.items.list", len(items))
+	return nil
+}
+
+// printNewQuizItems prints one line per item, since the question title and
+// type are nested in the item entry
+func printNewQuizItems(items []api.NewQuizItem) {
+	for _, item := range items {
+		interaction, title := item.EntryType, ""
+		if item.Entry != nil {
+			interaction, title = item.Entry.InteractionTypeSlug, item.Entry.Title
+		}
+		fmt.Printf("%3d. [%s] %s (%g pts, ID: %s)\n", item.Position, interaction, title, item.PointsPossible, item.ID)
+	}
+}
+
+func runNewQuizItemsGet(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.items.get", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"quiz_id":   opts.QuizID,
+		"item_id":   opts.QuestionID,
+	})
+
+	service := api.NewQuizEngineService(client)
+
+	item, err := service.GetItem(ctx, opts.CourseID, opts.QuizID, strconv.FormatInt(opts.QuestionID, 10))
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.items.get", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+			"item_id":   opts.QuestionID,
+		})
+		return fmt.Errorf("failed to get question: %w", err)
+	}
+
+	if err := formatOutput(item, nil); err != nil {
+		return fmt.Errorf("failed to print results: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.new.items.get", 1)
+	return nil
+}
+
+func runNewQuizItemsCreate(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsCreateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.items.create", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"quiz_id":       opts.QuizID,
+		"question_type": opts.QuestionType,
+	})
+
+	entry, err := newQuizItemEntry(opts)
+	if err != nil {
+		return err
+	}
+
+	params := &api.NewQuizItemParams{
+		Position: opts.Position,
+		Entry:    entry,
+	}
+	if opts.PointsPossible > 0 {
+		params.PointsPossible = &opts.PointsPossible
+	}
+
+	service := api.NewQuizEngineService(client)
+
+	item, err := service.CreateItem(ctx, opts.CourseID, opts.QuizID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.items.create", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+		})
+		return fmt.Errorf("failed to create question: %w", err)
+	}
+
+	fmt.Printf("Question created successfully (ID: %s)\n", item.ID)
+	if err := formatOutput(item, nil); err != nil {
+		return fmt.Errorf("failed to print results: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.new.items.create", 1)
+	return nil
+}
+
+// newQuizItemEntry builds the New Quizzes question described by the create
+// flags. --correct is a 1-based choice number for choice questions, true or
+// false for true-false questions, and the answer for numeric questions.
+func newQuizItemEntry(opts *options.QuizzesQuestionsCreateOptions) (*api.NewQuizItemEntry, error) {
+	switch opts.QuestionType {
+	case api.NewQuizInteractionChoice:
+		correct, err := strconv.Atoi(opts.Correct)
+		if err != nil {
+			return nil, fmt.Errorf("--correct must be the number of the correct choice: %s", opts.Correct)
+		}
+		return api.NewChoiceEntry(opts.QuestionName, opts.QuestionText, opts.Choices, correct-1)
+	case api.NewQuizInteractionTrueFalse:
+		return api.NewTrueFalseEntry(opts.QuestionName, opts.QuestionText, opts.Correct == "true"), nil
+	case api.NewQuizInteractionEssay:
+		return api.NewEssayEntry(opts.QuestionName, opts.QuestionText), nil
+	case api.NewQuizInteractionNumeric:
+		answer, err := strconv.ParseFloat(opts.Correct, 64)
+		if err != nil {
+			return nil, fmt.Errorf("--correct must be a number for numeric questions: %s", opts.Correct)
+		}
+		return api.NewNumericEntry(opts.QuestionName, opts.QuestionText, answer, opts.Margin), nil
+	}
+
+	return nil, fmt.Errorf("unsupported question type: %s", opts.QuestionType)
+}
+
+func runNewQuizItemsDelete(ctx context.Context, client *api.Client, opts *options.QuizzesQuestionsDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.new.items.delete", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"quiz_id":   opts.QuizID,
+		"item_id":   opts.QuestionID,
+	})
+
+	if !opts.Force {
+		fmt.Printf("WARNING: This will delete question %d from quiz %d.\n", opts.QuestionID, opts.QuizID)
+		fmt.Print("Type 'yes' to confirm: ")
+		var confirm string
+		fmt.Scanln(&confirm)
+		if confirm != "yes" {
+			fmt.Println("Delete cancelled")
+			return nil
+		}
+	}
+
+	service := api.NewQuizEngineService(client)
+
+	if err := service.DeleteItem(ctx, opts.CourseID, opts.QuizID, strconv.FormatInt(opts.QuestionID, 10)); err != nil {
+		logger.LogCommandError(ctx, "quizzes.new.items.delete", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+			"item_id":   opts.QuestionID,
+		})
+		return fmt.Errorf("failed to delete question: %w", err)
+	}
+
+	fmt.Printf("Question %d deleted\n", opts.QuestionID)
+
+	logger.LogCommandComplete(ctx, "quizzes.new.items.delete", 1)
+	return nil
+}
+
+func runQuizzesAccommodationsSet(ctx context.Context, client *api.Client, opts *options.QuizzesAccommodationsSetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+
+	logger.LogCommandStart(ctx, "quizzes.accommodations.set", map[string]interface{}{
+		"course_id": opts.CourseID,
+		"quiz_id":   opts.QuizID,
+		"user_ids":  opts.UserIDs,
+	})
+
+	accommodations := make([]api.NewQuizAccommodation, len(opts.UserIDs))
+	for i, userID := range opts.UserIDs {
+		accommodations[i] = api.NewQuizAccommodation{UserID: userID}
+		if opts.ExtraTimeSet {
+			accommodations[i].ExtraTime = &opts.ExtraTime
+		}
+		if opts.ExtraAttemptsSet {
+			accommodations[i].ExtraAttempts = &opts.ExtraAttempts
+		}
+		if opts.ReduceChoicesSet {
+			accommodations[i].ReduceChoicesEnabled = &opts.ReduceChoices
+		}
+	}
+
+	service := api.NewQuizEngineService(client)
+
+	var result *api.NewQuizAccommodationResult
+	var err error
+	if opts.QuizID > 0 {
+		result, err = service.SetQuizAccommodations(ctx, opts.CourseID, opts.QuizID, accommodations)
+	} else {
+		result, err = service.SetCourseAccommodations(ctx, opts.CourseID, accommodations)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "quizzes.accommodations.set", err, map[string]interface{}{
+			"course_id": opts.CourseID,
+			"quiz_id":   opts.QuizID,
+		})
+		return fmt.Errorf("failed to set accommodations: %w", err)
+	}
+
+	fmt.Printf("Accommodations set for %d of %d students\n", len(result.Successful), len(opts.UserIDs))
+	if len(result.Failed) > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, failed := range result.Failed {
+			fmt.Printf("  - user %d: %s\n", failed.UserID, failed.Error)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, "quizzes.accommodations.set", len(result.Successful))
+
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("accommodations failed for %d students", len(result.Failed))
+	}
+
+	return nil
+}
@@ -364,3 +364,133 @@ func TestQuizzesSubmissionsGetCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestQuizzesNewEngineCmds(t *testing.T) {
+	t.Run("list new quizzes", func(t *testing.T) {
+		cmdtest.RunCommandTest(t, newQuizzesListCmd(), cmdtest.CommandTestCase{
+			Name: "list new quizzes",
+			Args: []string{"--course-id", "1", "--engine", "new", "--search", "unit"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/quiz/v1/courses/1/quizzes": cmdtest.NewMockResponse(`[
+					{"id": "10", "title": "Unit 1 Check", "points_possible": 5},
+					{"id": "11", "title": "Final Exam", "points_possible": 50}
+				]`),
+			},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "Unit 1 Check") {
+					t.Error("Expected 'Unit 1 Check' in output")
+				}
+				if strings.Contains(output, "Final Exam") {
+					t.Error("Expected 'Final Exam' to be filtered out")
+				}
+			},
+		})
+	})
+
+	t.Run("invalid engine", func(t *testing.T) {
+		cmdtest.RunCommandTest(t, newQuizzesListCmd(), cmdtest.CommandTestCase{
+			Name:        "invalid engine",
+			Args:        []string{"--course-id", "1", "--engine", "legacy"},
+			ExpectError: true,
+		})
+	})
+
+	t.Run("create rejects classic-only flags", func(t *testing.T) {
+		cmdtest.RunCommandTest(t, newQuizzesCreateCmd(), cmdtest.CommandTestCase{
+			Name:        "create rejects classic-only flags",
+			Args:        []string{"--course-id", "1", "--title", "Check", "--engine", "new", "--access-code", "secret"},
+			ExpectError: true,
+		})
+	})
+
+	t.Run("create choice question", func(t *testing.T) {
+		cmdtest.RunCommandTest(t, newQuizzesQuestionsCreateCmd(), cmdtest.CommandTestCase{
+			Name: "create choice question",
+			Args: []string{"--course-id", "1", "--quiz-id", "10", "--engine", "new",
+				"--text", "What is 2+2?", "--choice", "3", "--choice", "4", "--correct", "2"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/quiz/v1/courses/1/quizzes/10/items": cmdtest.NewMockResponse(`{
+					"id": "99", "position": 1, "points_possible": 1, "entry_type": "Item",
+					"entry": {"interaction_type_slug": "choice", "item_body": "What is 2+2?"}
+				}`),
+			},
+			ExpectOutput: "Question created successfully (ID: 99)",
+		})
+	})
+
+	t.Run("choice question out of range", func(t *testing.T) {
+		cmdtest.RunCommandTest(t, newQuizzesQuestionsCreateCmd(), cmdtest.CommandTestCase{
+			Name: "choice question out of range",
+			Args: []string{"--course-id", "1", "--quiz-id", "10", "--engine", "new",
+				"--text", "What is 2+2?", "--choice", "3", "--choice", "4", "--correct", "3"},
+			ExpectError: true,
+		})
+	})
+
+	t.Run("choices require new engine", func(t *testing.T) {
+		cmdtest.RunCommandTest(t, newQuizzesQuestionsCreateCmd(), cmdtest.CommandTestCase{
+			Name:        "choices require new engine",
+			Args:        []string{"--course-id", "1", "--quiz-id", "10", "--text", "What is 2+2?", "--choice", "4"},
+			ExpectError: true,
+		})
+	})
+
+	t.Run("list items", func(t *testing.T) {
+		cmdtest.RunCommandTest(t, newQuizzesQuestionsListCmd(), cmdtest.CommandTestCase{
+			Name: "list items",
+			Args: []string{"--course-id", "1", "--quiz-id", "10", "--engine", "new"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/quiz/v1/courses/1/quizzes/10/items": cmdtest.NewMockResponse(`[
+					{"id": "99", "position": 1, "points_possible": 2, "entry_type": "Item",
+					 "entry": {"title": "Sum", "interaction_type_slug": "choice"}}
+				]`),
+			},
+			ExpectOutput: "[choice] Sum (2 pts, ID: 99)",
+		})
+	})
+}
+
+func TestQuizzesAccommodationsSetCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "set course accommodations",
+			Args: []string{"--course-id", "1", "--user-id", "7,8", "--extra-time", "30"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/quiz/v1/courses/1/accommodations": cmdtest.NewMockResponse(`{
+					"message": "done",
+					"successful": [{"user_id": 7}, {"user_id": 8}],
+					"failed": []
+				}`),
+			},
+			ExpectOutput: "Accommodations set for 2 of 2 students",
+		},
+		{
+			Name: "partial failure",
+			Args: []string{"--course-id", "1", "--quiz-id", "10", "--user-id", "7,8", "--extra-attempts", "1"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/quiz/v1/courses/1/quizzes/10/accommodations": cmdtest.NewMockResponse(`{
+					"successful": [{"user_id": 7}],
+					"failed": [{"user_id": 8, "error": "not enrolled"}]
+				}`),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "extra attempts require quiz",
+			Args:        []string{"--course-id", "1", "--user-id", "7", "--extra-attempts", "1"},
+			ExpectError: true,
+		},
+		{
+			Name:        "no accommodation given",
+			Args:        []string{"--course-id", "1", "--user-id", "7"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newQuizzesAccommodationsSetCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
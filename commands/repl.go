@@ -8,6 +8,10 @@ import (
 	"github.com/jjuanrivvera/canvas-cli/internal/repl"
 )
 
+// staleWhileRevalidate serves expired cached responses immediately and
+// refreshes them in the background (enabled with repl --stale-while-revalidate)
+var staleWhileRevalidate bool
+
 var replCmd = &cobra.Command{
 	Use:   "repl",
 	Short: "Start interactive REPL mode",
@@ -26,10 +30,16 @@ Special REPL commands:
   session clear     - Clear session state
   exit/quit         - Exit the REPL
 
+With --stale-while-revalidate, cached responses that have expired are shown
+immediately and refreshed in the background, so repeated lookups stay fast.
+
 Examples:
   # Start the REPL
   canvas repl
 
+  # Serve stale cached data instantly while refreshing it
+  canvas repl --stale-while-revalidate
+
   # In the REPL:
   canvas> courses list
   canvas> session set course_id 12345
@@ -41,6 +51,7 @@ Examples:
 
 func init() {
 	rootCmd.AddCommand(replCmd)
+	replCmd.Flags().BoolVar(&staleWhileRevalidate, "stale-while-revalidate", false, "Serve expired cached responses immediately and refresh them in the background")
 }
 
 func runRepl(cmd *cobra.Command, args []string) error {
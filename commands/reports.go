@@ -0,0 +1,526 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// reportsCmd represents the reports command group
+var reportsCmd = &cobra.Command{
+	Use:   "reports",
+	Short: "Run and download account reports",
+	Long: `Run Canvas account reports and download their results.
+
+Account reports (provisioning, grade export, last user access, unpublished
+courses, ...) run asynchronously. 'canvas reports run' starts a report and,
+with --wait, polls until it finishes and downloads the file.
+
+If --account-id is not specified, uses the default account ID from config.
+
+Examples:
+  canvas reports list
+  canvas reports run provisioning_csv --param users=true --term "Fall 2026" --wait --out users.csv
+  canvas reports run last_user_access_csv --wait --out access.csv
+  canvas reports history grade_export_csv`,
+}
+
+func init() {
+	rootCmd.AddCommand(reportsCmd)
+	reportsCmd.AddCommand(newReportsListCmd())
+	reportsCmd.AddCommand(newReportsRunCmd())
+	reportsCmd.AddCommand(newReportsGetCmd())
+	reportsCmd.AddCommand(newReportsHistoryCmd())
+	reportsCmd.AddCommand(newReportsDownloadCmd())
+	reportsCmd.AddCommand(newReportsDeleteCmd())
+}
+
+func newReportsListCmd() *cobra.Command {
+	opts := &options.ReportsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List available reports",
+		Long: `List the reports that can be run on an account, with their parameters.
+
+Examples:
+  canvas reports list
+  canvas reports list --account-id 1 -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accountID, err := resolveAccountID(opts.AccountID, "reports list")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runReportsList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+
+	return cmd
+}
+
+func newReportsRunCmd() *cobra.Command {
+	opts := &options.ReportsRunOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run <report-type>",
+		Short: "Start an account report",
+		Long: `Start an account report, optionally waiting for it and downloading the file.
+
+Report parameters are passed with --param key=value; 'canvas reports list'
+shows the parameters each report accepts. The values true and false are sent
+as booleans. --term sets enrollment_term_id from a term ID, SIS term ID or
+name. --out downloads the finished report and implies --wait.
+
+Examples:
+  canvas reports run provisioning_csv --param users=true --param courses=true
+  canvas reports run grade_export_csv --term "Fall 2026" --wait --out grades.csv
+  canvas reports run unpublished_courses_csv --wait --timeout 1h --out unpublished.csv`,
+		Args: ExactArgsWithUsage(1, "report-type"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ReportType = args[0]
+
+			accountID, err := resolveAccountID(opts.AccountID, "reports run")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if opts.OutputPath != "" {
+				opts.Wait = true
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runReportsRun(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringArrayVar(&opts.Params, "param", nil, "Report parameter as key=value (repeatable)")
+	cmd.Flags().StringVar(&opts.Term, "term", "", "Enrollment term (ID, SIS term ID or name)")
+	cmd.Flags().StringVar(&opts.OutputPath, "out", "", "Download the finished report to this path (implies --wait)")
+	addWaitFlags(cmd, &opts.WaitOptions)
+
+	return cmd
+}
+
+func newReportsGetCmd() *cobra.Command {
+	opts := &options.ReportsGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <report-type> <report-id>",
+		Short: "Get the status of a report run",
+		Long: `Get the status of a report run.
+
+Examples:
+  canvas reports get provisioning_csv 42`,
+		Args: ExactArgsWithUsage(2, "report-type", "report-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseReportArgs(args, opts); err != nil {
+				return err
+			}
+
+			accountID, err := resolveAccountID(opts.AccountID, "reports get")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runReportsGet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+
+	return cmd
+}
+
+func newReportsHistoryCmd() *cobra.Command {
+	opts := &options.ReportsHistoryOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "history <report-type>",
+		Short: "List previous runs of a report",
+		Long: `List previous runs of a report, most recent first.
+
+Examples:
+  canvas reports history provisioning_csv`,
+		Args: ExactArgsWithUsage(1, "report-type"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ReportType = args[0]
+
+			accountID, err := resolveAccountID(opts.AccountID, "reports history")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runReportsHistory(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+
+	return cmd
+}
+
+func newReportsDownloadCmd() *cobra.Command {
+	opts := &options.ReportsDownloadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "download <report-type> <report-id>",
+		Short: "Download the file of a finished report",
+		Long: `Download the file of a finished report run.
+
+Examples:
+  canvas reports download provisioning_csv 42 --out users.csv`,
+		Args: ExactArgsWithUsage(2, "report-type", "report-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseReportArgs(args, &opts.ReportsGetOptions); err != nil {
+				return err
+			}
+
+			accountID, err := resolveAccountID(opts.AccountID, "reports download")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runReportsDownload(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.OutputPath, "out", "", "Destination path (required)")
+	cmd.MarkFlagRequired("out")
+
+	return cmd
+}
+
+func newReportsDeleteCmd() *cobra.Command {
+	opts := &options.ReportsDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <report-type> <report-id>",
+		Short: "Delete a report run",
+		Long: `Delete a report run and its file.
+
+Examples:
+  canvas reports delete provisioning_csv 42
+  canvas reports delete provisioning_csv 42 --force`,
+		Args: ExactArgsWithUsage(2, "report-type", "report-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseReportArgs(args, &opts.ReportsGetOptions); err != nil {
+				return err
+			}
+
+			accountID, err := resolveAccountID(opts.AccountID, "reports delete")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runReportsDelete(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runReportsList(ctx context.Context, client *api.Client, opts *options.ReportsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "reports.list", map[string]interface{}{
+		"account_id": opts.AccountID,
+	})
+
+	service := api.NewAccountReportsService(client)
+
+	reports, err := service.ListAvailable(ctx, opts.AccountID)
+	if err != nil {
+		logger.LogCommandError(ctx, "reports.list", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+		})
+		return fmt.Errorf("failed to list reports: %w", err)
+	}
+
+	printVerbose("Found %d reports for account %d:\n\n", len(reports), opts.AccountID)
+
+	logger.LogCommandComplete(ctx, "reports.list", len(reports))
+	return formatEmptyOrOutput(reports, "No reports available")
+}
+
+func runReportsRun(ctx context.Context, client *api.Client, opts *options.ReportsRunOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "reports.run", map[string]interface{}{
+		"account_id":  opts.AccountID,
+		"report_type": opts.ReportType,
+		"wait":        opts.Wait,
+	})
+
+	params := parseReportParams(opts.Params)
+	if opts.Term != "" {
+		termID, err := resolveTermID(ctx, client, opts.AccountID, opts.Term)
+		if err != nil {
+			logger.LogCommandError(ctx, "reports.run", err, map[string]interface{}{
+				"term": opts.Term,
+			})
+			return err
+		}
+		params["enrollment_term_id"] = termID
+	}
+
+	service := api.NewAccountReportsService(client)
+
+	report, err := service.Start(ctx, opts.AccountID, opts.ReportType, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "reports.run", err, map[string]interface{}{
+			"account_id":  opts.AccountID,
+			"report_type": opts.ReportType,
+		})
+		return fmt.Errorf("failed to start report: %w", err)
+	}
+
+	if !opts.Wait {
+		logger.LogCommandComplete(ctx, "reports.run", 1)
+		return formatSuccessOutput(report, fmt.Sprintf("Report %s started (ID: %d)", report.Report, report.ID))
+	}
+
+	printVerbose("Report %s started (ID: %d)\n", report.Report, report.ID)
+
+	if _, err := waitForJob(ctx, opts.WaitOptions, func(ctx context.Context) (*api.JobProgress, error) {
+		current, err := service.Get(ctx, opts.AccountID, opts.ReportType, report.ID)
+		if err != nil {
+			return nil, err
+		}
+		report = current
+		return current.ToProgress(), nil
+	}); err != nil {
+		logger.LogCommandError(ctx, "reports.run", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"report_id":  report.ID,
+		})
+		return fmt.Errorf("report %d did not complete: %w", report.ID, err)
+	}
+
+	if opts.OutputPath == "" {
+		logger.LogCommandComplete(ctx, "reports.run", 1)
+		return formatSuccessOutput(report, fmt.Sprintf("Report %s completed (ID: %d)", report.Report, report.ID))
+	}
+
+	if err := service.Download(ctx, report, opts.OutputPath); err != nil {
+		logger.LogCommandError(ctx, "reports.run", err, map[string]interface{}{
+			"report_id": report.ID,
+			"out":       opts.OutputPath,
+		})
+		return fmt.Errorf("failed to download report: %w", err)
+	}
+
+	fmt.Printf("Report %s saved to %s\n", report.Report, opts.OutputPath)
+	logger.LogCommandComplete(ctx, "reports.run", 1)
+	return nil
+}
+
+func runReportsGet(ctx context.Context, client *api.Client, opts *options.ReportsGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "reports.get", map[string]interface{}{
+		"account_id":  opts.AccountID,
+		"report_type": opts.ReportType,
+		"report_id":   opts.ReportID,
+	})
+
+	service := api.NewAccountReportsService(client)
+
+	report, err := service.Get(ctx, opts.AccountID, opts.ReportType, opts.ReportID)
+	if err != nil {
+		logger.LogCommandError(ctx, "reports.get", err, map[string]interface{}{
+			"report_id": opts.ReportID,
+		})
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "reports.get", 1)
+	return formatOutput(report, nil)
+}
+
+func runReportsHistory(ctx context.Context, client *api.Client, opts *options.ReportsHistoryOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "reports.history", map[string]interface{}{
+		"account_id":  opts.AccountID,
+		"report_type": opts.ReportType,
+	})
+
+	service := api.NewAccountReportsService(client)
+
+	reports, err := service.List(ctx, opts.AccountID, opts.ReportType)
+	if err != nil {
+		logger.LogCommandError(ctx, "reports.history", err, map[string]interface{}{
+			"report_type": opts.ReportType,
+		})
+		return fmt.Errorf("failed to list report runs: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "reports.history", len(reports))
+	return formatEmptyOrOutput(reports, fmt.Sprintf("No runs of %s found", opts.ReportType))
+}
+
+func runReportsDownload(ctx context.Context, client *api.Client, opts *options.ReportsDownloadOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "reports.download", map[string]interface{}{
+		"report_type": opts.ReportType,
+		"report_id":   opts.ReportID,
+		"out":         opts.OutputPath,
+	})
+
+	service := api.NewAccountReportsService(client)
+
+	report, err := service.Get(ctx, opts.AccountID, opts.ReportType, opts.ReportID)
+	if err != nil {
+		logger.LogCommandError(ctx, "reports.download", err, map[string]interface{}{
+			"report_id": opts.ReportID,
+		})
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if err := service.Download(ctx, report, opts.OutputPath); err != nil {
+		logger.LogCommandError(ctx, "reports.download", err, map[string]interface{}{
+			"report_id": opts.ReportID,
+		})
+		return fmt.Errorf("failed to download report: %w", err)
+	}
+
+	fmt.Printf("Report %s saved to %s\n", report.Report, opts.OutputPath)
+	logger.LogCommandComplete(ctx, "reports.download", 1)
+	return nil
+}
+
+func runReportsDelete(ctx context.Context, client *api.Client, opts *options.ReportsDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "reports.delete", map[string]interface{}{
+		"report_type": opts.ReportType,
+		"report_id":   opts.ReportID,
+		"force":       opts.Force,
+	})
+
+	confirmed, err := confirmDelete("report", opts.ReportID, opts.Force)
+	if err != nil {
+		logger.LogCommandError(ctx, "reports.delete", err, map[string]interface{}{})
+		return err
+	}
+	if !confirmed {
+		logger.LogCommandComplete(ctx, "reports.delete", 0)
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	service := api.NewAccountReportsService(client)
+
+	if _, err := service.Delete(ctx, opts.AccountID, opts.ReportType, opts.ReportID); err != nil {
+		logger.LogCommandError(ctx, "reports.delete", err, map[string]interface{}{
+			"report_id": opts.ReportID,
+		})
+		return fmt.Errorf("failed to delete report: %w", err)
+	}
+
+	fmt.Printf("Report %d deleted\n", opts.ReportID)
+	logger.LogCommandComplete(ctx, "reports.delete", 1)
+	return nil
+}
+
+// parseReportArgs fills the report type and ID from positional arguments
+func parseReportArgs(args []string, opts *options.ReportsGetOptions) error {
+	reportID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid report ID: %s", args[1])
+	}
+
+	opts.ReportType = args[0]
+	opts.ReportID = reportID
+	return nil
+}
+
+// parseReportParams converts key=value flags to report parameters. The values
+// true and false are sent as booleans, which is what Canvas expects for flags
+// such as users or include_deleted.
+func parseReportParams(values []string) map[string]interface{} {
+	params := make(map[string]interface{}, len(values))
+	for _, value := range values {
+		key, val, _ := strings.Cut(value, "=")
+		key = strings.TrimSpace(key)
+
+		switch val {
+		case "true":
+			params[key] = true
+		case "false":
+			params[key] = false
+		default:
+			params[key] = val
+		}
+	}
+	return params
+}
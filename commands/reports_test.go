@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestReportsRunCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "start report",
+			Args: []string{"provisioning_csv", "--account-id", "1", "--param", "users=true"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1/reports/provisioning_csv": cmdtest.NewMockResponse(`{"id":42,"report":"provisioning_csv","status":"created"}`),
+			},
+			ExpectOutput: "Report provisioning_csv started (ID: 42)",
+		},
+		{
+			Name: "wait for report",
+			Args: []string{"provisioning_csv", "--account-id", "1", "--wait"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1/reports/provisioning_csv":    cmdtest.NewMockResponse(`{"id":42,"report":"provisioning_csv","status":"created"}`),
+				"/api/v1/accounts/1/reports/provisioning_csv/42": cmdtest.NewMockResponse(`{"id":42,"report":"provisioning_csv","status":"complete","progress":100}`),
+			},
+			ExpectOutput: "Report provisioning_csv completed (ID: 42)",
+		},
+		{
+			Name: "report fails",
+			Args: []string{"provisioning_csv", "--account-id", "1", "--wait"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1/reports/provisioning_csv":    cmdtest.NewMockResponse(`{"id":42,"report":"provisioning_csv","status":"created"}`),
+				"/api/v1/accounts/1/reports/provisioning_csv/42": cmdtest.NewMockResponse(`{"id":42,"report":"provisioning_csv","status":"error"}`),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid param",
+			Args:        []string{"provisioning_csv", "--account-id", "1", "--param", "users"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newReportsRunCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestParseReportParams(t *testing.T) {
+	params := parseReportParams([]string{"users=true", "include_deleted=false", "start_at=2026-01-01"})
+
+	if params["users"] != true {
+		t.Errorf("Expected boolean users, got %#v", params["users"])
+	}
+	if params["include_deleted"] != false {
+		t.Errorf("Expected boolean include_deleted, got %#v", params["include_deleted"])
+	}
+	if params["start_at"] != "2026-01-01" {
+		t.Errorf("Expected string start_at, got %#v", params["start_at"])
+	}
+}
@@ -18,11 +18,14 @@ var (
 	instanceURL  string
 	outputFormat string
 	verbose      bool
-	noCache      bool  // Disable caching for API requests
-	asUserID     int64 // Masquerading: act as another user
-	globalLimit  int   // Global limit for list operations
-	dryRun       bool  // Print curl commands instead of executing
-	showToken    bool  // Show actual token in dry-run output
+	noCache      bool   // Disable caching for API requests
+	asUserID     int64  // Masquerading: act as another user
+	globalLimit  int    // Global limit for list operations
+	dryRun       bool   // Print curl commands instead of executing
+	showToken    bool   // Show actual token in dry-run output
+	recordDir    string // Record HTTP traffic to a cassette in this directory
+	replayDir    string // Replay HTTP traffic from a cassette in this directory
+	traceHAR     string // Write HTTP traffic to a HAR archive at this path
 	version      string
 	commit       string
 	buildDate    string
@@ -51,12 +54,17 @@ Examples:
   canvas submissions bulk-grade --course-id 123 --csv grades.csv # Bulk grade from CSV`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := checkStreamingSupport(cmd); err != nil {
+			return err
+		}
+
 		// Initialize and run auto-updater asynchronously
 		initAutoUpdater()
 		if autoUpdater != nil {
 			autoUpdater.RunUpdateCheckAsync(context.Background())
 		}
+		return nil
 	},
 	PersistentPostRun: func(cmd *cobra.Command, args []string) {
 		// Print any update notifications after command completes
@@ -92,7 +100,7 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.canvas-cli/config.yaml)")
 	rootCmd.PersistentFlags().StringVar(&instanceURL, "instance", "", "Canvas instance URL (overrides config)")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table, json, yaml, csv")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table, json, ndjson, yaml, csv")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
 	rootCmd.PersistentFlags().Int64Var(&asUserID, "as-user", 0, "Masquerade as another user (admin feature, requires permission)")
 
@@ -100,6 +108,10 @@ func init() {
 	rootCmd.PersistentFlags().IntVar(&globalLimit, "limit", 0, "Limit number of results for list operations (0 = unlimited)")
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print curl commands instead of executing requests")
 	rootCmd.PersistentFlags().BoolVar(&showToken, "show-token", false, "Show actual token in dry-run output (default: redacted)")
+	rootCmd.PersistentFlags().StringVar(&recordDir, "record", "", "Record all HTTP requests/responses to a cassette in this directory (tokens redacted)")
+	rootCmd.PersistentFlags().StringVar(&replayDir, "replay", "", "Replay HTTP responses from a cassette in this directory instead of the network")
+	rootCmd.PersistentFlags().StringVar(&traceHAR, "trace-har", "", "Write all HTTP traffic to a HAR 1.2 file for debugging (tokens redacted)")
+	rootCmd.MarkFlagsMutuallyExclusive("record", "replay")
 
 	// Output filtering flags
 	rootCmd.PersistentFlags().StringVar(&filterText, "filter", "", "Filter results by text (case-insensitive substring match)")
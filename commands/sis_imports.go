@@ -128,7 +128,8 @@ The file should follow the Canvas SIS import format:
 
 Examples:
   canvas sis-imports create --account-id 1 --file users.csv
-  canvas sis-imports create --account-id 1 --file data.zip --batch-mode --batch-mode-term-id 123`,
+  canvas sis-imports create --account-id 1 --file data.zip --batch-mode --batch-mode-term-id 123
+  canvas sis-imports create --account-id 1 --file users.csv --wait --timeout 1h`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Track which fields were set
 			opts.BatchModeSet = cmd.Flags().Changed("batch-mode")
@@ -164,6 +165,7 @@ Examples:
 	cmd.Flags().StringVar(&opts.DiffingID, "diffing-data-set-identifier", "", "Diffing dataset identifier")
 	cmd.Flags().BoolVar(&opts.DiffingRemaster, "diffing-remaster-data-set", false, "Remaster diffing dataset")
 	cmd.Flags().Float64Var(&opts.ChangeThreshold, "change-threshold", 0, "Skip if changes below threshold (0.0-1.0)")
+	addWaitFlags(cmd, &opts.WaitOptions)
 	cmd.MarkFlagRequired("account-id")
 	cmd.MarkFlagRequired("file")
 
@@ -219,7 +221,8 @@ This will restore the workflow_state for all items that changed
 their workflow_state during the import.
 
 Examples:
-  canvas sis-imports restore 123 --account-id 1`,
+  canvas sis-imports restore 123 --account-id 1
+  canvas sis-imports restore 123 --account-id 1 --wait`,
 		Args: ExactArgsWithUsage(1, "import-id"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			importID, err := strconv.ParseInt(args[0], 10, 64)
@@ -243,6 +246,7 @@ Examples:
 
 	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (required)")
 	cmd.Flags().BoolVar(&opts.BatchMode, "batch-mode", false, "Use batch mode for restore")
+	addWaitFlags(cmd, &opts.WaitOptions)
 	cmd.MarkFlagRequired("account-id")
 
 	return cmd
@@ -403,6 +407,25 @@ func runSISCreate(ctx context.Context, client *api.Client, opts *options.SISImpo
 
 	fmt.Printf("SIS import created successfully (ID: %d)\n", sisImport.ID)
 	fmt.Printf("Workflow state: %s\n", sisImport.WorkflowState)
+
+	if opts.Wait {
+		if _, err := waitForJob(ctx, opts.WaitOptions, func(ctx context.Context) (*api.JobProgress, error) {
+			current, err := service.Get(ctx, opts.AccountID, sisImport.ID)
+			if err != nil {
+				return nil, err
+			}
+			return current.ToProgress(), nil
+		}); err != nil {
+			logger.LogCommandError(ctx, "sis_imports.create", err, map[string]interface{}{
+				"account_id": opts.AccountID,
+				"import_id":  sisImport.ID,
+			})
+			return fmt.Errorf("SIS import %d did not complete: %w", sisImport.ID, err)
+		}
+
+		fmt.Println("SIS import completed")
+	}
+
 	logger.LogCommandComplete(ctx, "sis_imports.create", 1)
 	return nil
 }
@@ -452,6 +475,22 @@ func runSISRestore(ctx context.Context, client *api.Client, opts *options.SISImp
 
 	fmt.Printf("Restore initiated (Progress ID: %d)\n", progress.ID)
 	fmt.Printf("Workflow state: %s\n", progress.WorkflowState)
+
+	if opts.Wait {
+		progressService := api.NewProgressService(client)
+		if _, err := waitForJob(ctx, opts.WaitOptions, func(ctx context.Context) (*api.JobProgress, error) {
+			return progressService.Get(ctx, progress.ID)
+		}); err != nil {
+			logger.LogCommandError(ctx, "sis_imports.restore", err, map[string]interface{}{
+				"account_id":  opts.AccountID,
+				"progress_id": progress.ID,
+			})
+			return fmt.Errorf("restore did not complete: %w", err)
+		}
+
+		fmt.Println("Restore completed")
+	}
+
 	logger.LogCommandComplete(ctx, "sis_imports.restore", 1)
 	return nil
 }
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"fmt"
+	"iter"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/internal/output"
+)
+
+// streamingAnnotation marks list commands that stream NDJSON output page by
+// page. Other list commands reject -o ndjson instead of buffering silently.
+const streamingAnnotation = "streams_ndjson"
+
+// streamingAnnotations returns the annotations for a command that streams
+// NDJSON output
+func streamingAnnotations() map[string]string {
+	return map[string]string{streamingAnnotation: "true"}
+}
+
+// checkStreamingSupport rejects NDJSON output for list commands that would
+// have to collect every page before writing anything
+func checkStreamingSupport(cmd *cobra.Command) error {
+	if output.FormatType(outputFormat) != output.FormatNDJSON || cmd.Name() != "list" {
+		return nil
+	}
+	if _, ok := cmd.Annotations[streamingAnnotation]; ok {
+		return nil
+	}
+
+	return fmt.Errorf("%s does not support ndjson output; use -o json instead", cmd.CommandPath())
+}
+
+// isStreamingOutput reports whether list results should be streamed to stdout
+// as they are fetched instead of being collected first. Filtering and sorting
+// need the whole collection, so they fall back to buffered output.
+func isStreamingOutput() bool {
+	return output.FormatType(outputFormat) == output.FormatNDJSON && !hasFilteringOptions()
+}
+
+// streamNDJSON writes each item from seq to stdout as a JSON line as soon as
+// it is decoded. It returns the number of items written.
+func streamNDJSON[T any](seq iter.Seq2[T, error]) (int, error) {
+	writer := output.NewNDJSONWriter(os.Stdout)
+
+	count := 0
+	for item, err := range seq {
+		if err != nil {
+			return count, err
+		}
+		if err := writer.Write(item); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// filterSeq yields the items from seq for which keep returns true. Errors are
+// always passed through.
+func filterSeq[T any](seq iter.Seq2[T, error], keep func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for item, err := range seq {
+			if err == nil && !keep(item) {
+				continue
+			}
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestCheckStreamingSupport(t *testing.T) {
+	oldFormat := outputFormat
+	defer func() { outputFormat = oldFormat }()
+
+	parent := &cobra.Command{Use: "pages"}
+	buffered := &cobra.Command{Use: "list"}
+	parent.AddCommand(buffered)
+
+	tests := []struct {
+		name    string
+		format  string
+		cmd     *cobra.Command
+		wantErr bool
+	}{
+		{name: "streaming list", format: "ndjson", cmd: newCoursesListCmd()},
+		{name: "buffered list", format: "ndjson", cmd: buffered, wantErr: true},
+		{name: "buffered list as json", format: "json", cmd: buffered},
+		{name: "non-list command", format: "ndjson", cmd: newCoursesGetCmd()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputFormat = tt.format
+
+			err := checkStreamingSupport(tt.cmd)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkStreamingSupport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
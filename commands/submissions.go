@@ -39,8 +39,9 @@ func newSubmissionsListCmd() *cobra.Command {
 	opts := &options.SubmissionsListOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "list",
-		Short: "List submissions for an assignment",
+		Use:         "list",
+		Short:       "List submissions for an assignment",
+		Annotations: streamingAnnotations(),
 		Long: `List all submissions for a Canvas assignment.
 
 You can filter submissions by workflow state and graded since date.
@@ -321,6 +322,22 @@ func runSubmissionsList(ctx context.Context, client *api.Client, opts *options.S
 		Include:       opts.Include,
 	}
 
+	// Stream submissions page by page for NDJSON output
+	if isStreamingOutput() {
+		count, err := streamNDJSON(submissionsService.ListIter(ctx, opts.CourseID, opts.AssignmentID, apiOpts))
+		if err != nil {
+			logger.LogCommandError(ctx, "submissions.list", err, map[string]interface{}{
+				"course_id":     opts.CourseID,
+				"assignment_id": opts.AssignmentID,
+				"written":       count,
+			})
+			return fmt.Errorf("failed to list submissions: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "submissions.list", count)
+		return nil
+	}
+
 	// List submissions
 	submissions, err := submissionsService.List(ctx, opts.CourseID, opts.AssignmentID, apiOpts)
 	if err != nil {
@@ -0,0 +1,365 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// submissionsProvisionalCmd represents the submissions provisional command group
+var submissionsProvisionalCmd = &cobra.Command{
+	Use:   "provisional",
+	Short: "Manage provisional grades of moderated assignments",
+	Long: `Manage the provisional grades of moderated assignments.
+
+In moderated grading, several graders each give a provisional grade. The
+moderator selects one of them per student, then publishes the selected
+grades to the gradebook. Publishing can only be done once per assignment.
+
+Examples:
+  canvas submissions provisional list --course-id 123 --assignment-id 456
+  canvas submissions provisional select 789 --course-id 123 --assignment-id 456
+  canvas submissions provisional publish --course-id 123 --assignment-id 456`,
+}
+
+func init() {
+	submissionsCmd.AddCommand(submissionsProvisionalCmd)
+	submissionsProvisionalCmd.AddCommand(newSubmissionsProvisionalListCmd())
+	submissionsProvisionalCmd.AddCommand(newSubmissionsProvisionalStatusCmd())
+	submissionsProvisionalCmd.AddCommand(newSubmissionsProvisionalSelectCmd())
+	submissionsProvisionalCmd.AddCommand(newSubmissionsProvisionalPublishCmd())
+	submissionsProvisionalCmd.AddCommand(newSubmissionsProvisionalStudentsCmd())
+}
+
+func newSubmissionsProvisionalListCmd() *cobra.Command {
+	opts := &options.SubmissionsProvisionalListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List provisional grades of an assignment",
+		Long: `List the provisional grades given by each grader of a moderated assignment.
+
+Examples:
+  canvas submissions provisional list --course-id 123 --assignment-id 456
+  canvas submissions provisional list --course-id 123 --assignment-id 456 -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+			return runSubmissionsProvisionalList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID (required)")
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("assignment-id")
+
+	return cmd
+}
+
+func newSubmissionsProvisionalStatusCmd() *cobra.Command {
+	opts := &options.SubmissionsProvisionalStatusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Check whether a student needs a provisional grade",
+		Long: `Check whether a student's submission still needs a provisional grade.
+
+Examples:
+  canvas submissions provisional status --course-id 123 --assignment-id 456 --user-id 789`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+			return runSubmissionsProvisionalStatus(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID (required)")
+	cmd.Flags().Int64Var(&opts.UserID, "user-id", 0, "Student user ID (required)")
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("assignment-id")
+	cmd.MarkFlagRequired("user-id")
+
+	return cmd
+}
+
+func newSubmissionsProvisionalSelectCmd() *cobra.Command {
+	opts := &options.SubmissionsProvisionalSelectOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "select <provisional-grade-id>",
+		Short: "Select a provisional grade as the final grade",
+		Long: `Select the provisional grade that becomes the student's grade when the
+assignment's grades are published. Use 'list' to find provisional grade IDs.
+
+Examples:
+  canvas submissions provisional select 789 --course-id 123 --assignment-id 456`,
+		Args: ExactArgsWithUsage(1, "provisional-grade-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gradeID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid provisional grade ID: %s", args[0])
+			}
+			opts.ProvisionalGradeID = gradeID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+			return runSubmissionsProvisionalSelect(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID (required)")
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("assignment-id")
+
+	return cmd
+}
+
+func newSubmissionsProvisionalPublishCmd() *cobra.Command {
+	opts := &options.SubmissionsProvisionalPublishOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "publish",
+		Short: "Publish the selected provisional grades",
+		Long: `Publish the selected provisional grades of a moderated assignment to the
+gradebook. This can only be done once and cannot be undone.
+
+Published grades are still subject to the assignment's post policy; use
+'canvas grades post' to release them to students.
+
+Examples:
+  canvas submissions provisional publish --course-id 123 --assignment-id 456
+  canvas submissions provisional publish --course-id 123 --assignment-id 456 --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+			return runSubmissionsProvisionalPublish(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID (required)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("assignment-id")
+
+	return cmd
+}
+
+func newSubmissionsProvisionalStudentsCmd() *cobra.Command {
+	opts := &options.SubmissionsProvisionalStudentsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "students",
+		Short: "List or extend the moderation set",
+		Long: `List the students selected for moderation, or add students to the
+moderation set with --add.
+
+Examples:
+  canvas submissions provisional students --course-id 123 --assignment-id 456
+  canvas submissions provisional students --course-id 123 --assignment-id 456 --add 789,790`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+			return runSubmissionsProvisionalStudents(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.CourseID, "course-id", 0, "Course ID (required)")
+	cmd.Flags().Int64Var(&opts.AssignmentID, "assignment-id", 0, "Assignment ID (required)")
+	cmd.Flags().Int64SliceVar(&opts.Add, "add", nil, "Student IDs to add to the moderation set (comma-separated)")
+	cmd.MarkFlagRequired("course-id")
+	cmd.MarkFlagRequired("assignment-id")
+
+	return cmd
+}
+
+func runSubmissionsProvisionalList(ctx context.Context, client *api.Client, opts *options.SubmissionsProvisionalListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "submissions.provisional.list", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"assignment_id": opts.AssignmentID,
+	})
+
+	service := api.NewModerationService(client)
+
+	grades, err := service.ListProvisionalGrades(ctx, opts.CourseID, opts.AssignmentID)
+	if err != nil {
+		logger.LogCommandError(ctx, "submissions.provisional.list", err, map[string]interface{}{
+			"course_id":     opts.CourseID,
+			"assignment_id": opts.AssignmentID,
+		})
+		return fmt.Errorf("failed to list provisional grades: %w", err)
+	}
+
+	printVerbose("Found %d provisional grades:\n\n", len(grades))
+	logger.LogCommandComplete(ctx, "submissions.provisional.list", len(grades))
+	return formatEmptyOrOutput(grades, "No provisional grades found")
+}
+
+func runSubmissionsProvisionalStatus(ctx context.Context, client *api.Client, opts *options.SubmissionsProvisionalStatusOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "submissions.provisional.status", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"assignment_id": opts.AssignmentID,
+		"user_id":       opts.UserID,
+	})
+
+	service := api.NewModerationService(client)
+
+	status, err := service.GetProvisionalGradeStatus(ctx, opts.CourseID, opts.AssignmentID, opts.UserID)
+	if err != nil {
+		logger.LogCommandError(ctx, "submissions.provisional.status", err, map[string]interface{}{
+			"course_id":     opts.CourseID,
+			"assignment_id": opts.AssignmentID,
+			"user_id":       opts.UserID,
+		})
+		return fmt.Errorf("failed to get provisional grade status: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "submissions.provisional.status", 1)
+
+	message := fmt.Sprintf("Student %d does not need a provisional grade", opts.UserID)
+	if status.NeedsProvisionalGrade {
+		message = fmt.Sprintf("Student %d needs a provisional grade", opts.UserID)
+	}
+	return formatSuccessOutput(status, message)
+}
+
+func runSubmissionsProvisionalSelect(ctx context.Context, client *api.Client, opts *options.SubmissionsProvisionalSelectOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "submissions.provisional.select", map[string]interface{}{
+		"course_id":            opts.CourseID,
+		"assignment_id":        opts.AssignmentID,
+		"provisional_grade_id": opts.ProvisionalGradeID,
+	})
+
+	service := api.NewModerationService(client)
+
+	selection, err := service.SelectProvisionalGrade(ctx, opts.CourseID, opts.AssignmentID, opts.ProvisionalGradeID)
+	if err != nil {
+		logger.LogCommandError(ctx, "submissions.provisional.select", err, map[string]interface{}{
+			"course_id":            opts.CourseID,
+			"assignment_id":        opts.AssignmentID,
+			"provisional_grade_id": opts.ProvisionalGradeID,
+		})
+		return fmt.Errorf("failed to select provisional grade: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "submissions.provisional.select", 1)
+	return formatSuccessOutput(selection, fmt.Sprintf("Provisional grade %d selected for student %d",
+		selection.SelectedProvisionalGradeID, selection.StudentID))
+}
+
+func runSubmissionsProvisionalPublish(ctx context.Context, client *api.Client, opts *options.SubmissionsProvisionalPublishOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "submissions.provisional.publish", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"assignment_id": opts.AssignmentID,
+		"force":         opts.Force,
+	})
+
+	if !opts.Force {
+		fmt.Printf("Publishing grades of assignment %d cannot be undone. Continue? [y/N]: ", opts.AssignmentID)
+		reader := bufio.NewReader(os.Stdin)
+		response, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Println("Publish cancelled")
+			logger.LogCommandComplete(ctx, "submissions.provisional.publish", 0)
+			return nil
+		}
+	}
+
+	service := api.NewModerationService(client)
+
+	if err := service.PublishProvisionalGrades(ctx, opts.CourseID, opts.AssignmentID); err != nil {
+		logger.LogCommandError(ctx, "submissions.provisional.publish", err, map[string]interface{}{
+			"course_id":     opts.CourseID,
+			"assignment_id": opts.AssignmentID,
+		})
+		return fmt.Errorf("failed to publish provisional grades: %w", err)
+	}
+
+	fmt.Printf("Provisional grades of assignment %d published\n", opts.AssignmentID)
+	logger.LogCommandComplete(ctx, "submissions.provisional.publish", 1)
+	return nil
+}
+
+func runSubmissionsProvisionalStudents(ctx context.Context, client *api.Client, opts *options.SubmissionsProvisionalStudentsOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "submissions.provisional.students", map[string]interface{}{
+		"course_id":     opts.CourseID,
+		"assignment_id": opts.AssignmentID,
+		"add":           opts.Add,
+	})
+
+	service := api.NewModerationService(client)
+
+	if len(opts.Add) > 0 {
+		added, err := service.AddModeratedStudents(ctx, opts.CourseID, opts.AssignmentID, opts.Add)
+		if err != nil {
+			logger.LogCommandError(ctx, "submissions.provisional.students", err, map[string]interface{}{
+				"course_id":     opts.CourseID,
+				"assignment_id": opts.AssignmentID,
+			})
+			return fmt.Errorf("failed to add students to moderation set: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "submissions.provisional.students", len(added))
+		return formatSuccessOutput(added, fmt.Sprintf("Added %d students to the moderation set", len(added)))
+	}
+
+	students, err := service.ListModeratedStudents(ctx, opts.CourseID, opts.AssignmentID)
+	if err != nil {
+		logger.LogCommandError(ctx, "submissions.provisional.students", err, map[string]interface{}{
+			"course_id":     opts.CourseID,
+			"assignment_id": opts.AssignmentID,
+		})
+		return fmt.Errorf("failed to list moderated students: %w", err)
+	}
+
+	printVerbose("Found %d students selected for moderation:\n\n", len(students))
+	logger.LogCommandComplete(ctx, "submissions.provisional.students", len(students))
+	return formatEmptyOrOutput(students, "No students selected for moderation")
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestSubmissionsProvisionalListCmd(t *testing.T) {
+	tc := cmdtest.CommandTestCase{
+		Name: "list provisional grades",
+		Args: []string{"--course-id", "123", "--assignment-id", "456"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/v1/courses/123/assignments/456/submissions": cmdtest.NewMockResponse(`[{"id":1,"user_id":100,"provisional_grades":[{"provisional_grade_id":501,"scorer_id":7,"score":8,"grade":"8"}]}]`),
+		},
+		ValidateOutput: func(t *testing.T, output string) {
+			if !strings.Contains(output, "501") || !strings.Contains(output, "100") {
+				t.Errorf("Expected provisional grade 501 for student 100, got: %s", output)
+			}
+		},
+	}
+
+	cmd := newSubmissionsProvisionalListCmd()
+	cmdtest.RunCommandTest(t, cmd, tc)
+}
+
+func TestSubmissionsProvisionalSelectCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "select provisional grade",
+			Args: []string{"501", "--course-id", "123", "--assignment-id", "456"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/courses/123/assignments/456/provisional_grades/501/select": cmdtest.NewMockResponse(`{"assignment_id":456,"student_id":100,"selected_provisional_grade_id":501}`),
+			},
+			ExpectOutput: "Provisional grade 501 selected for student 100",
+		},
+		{
+			Name:        "invalid provisional grade ID",
+			Args:        []string{"abc", "--course-id", "123", "--assignment-id", "456"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newSubmissionsProvisionalSelectCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestSubmissionsProvisionalPublishCmd(t *testing.T) {
+	tc := cmdtest.CommandTestCase{
+		Name: "publish with force",
+		Args: []string{"--course-id", "123", "--assignment-id", "456", "--force"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/v1/courses/123/assignments/456/provisional_grades/publish": cmdtest.NewMockResponse(`{"message":"OK"}`),
+		},
+		ExpectOutput: "Provisional grades of assignment 456 published",
+	}
+
+	cmd := newSubmissionsProvisionalPublishCmd()
+	cmdtest.RunCommandTest(t, cmd, tc)
+}
+
+func TestSubmissionsProvisionalStudentsCmd(t *testing.T) {
+	tc := cmdtest.CommandTestCase{
+		Name: "add students to moderation set",
+		Args: []string{"--course-id", "123", "--assignment-id", "456", "--add", "100,101"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/v1/courses/123/assignments/456/moderated_students": cmdtest.NewMockResponse(`[{"id":100,"name":"Ada"},{"id":101,"name":"Grace"}]`),
+		},
+		ExpectOutput: "Added 2 students to the moderation set",
+	}
+
+	cmd := newSubmissionsProvisionalStudentsCmd()
+	cmdtest.RunCommandTest(t, cmd, tc)
+}
@@ -0,0 +1,505 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// termsCmd represents the terms command group
+var termsCmd = &cobra.Command{
+	Use:   "terms",
+	Short: "Manage enrollment terms",
+	Long: `Manage Canvas enrollment terms.
+
+Enrollment terms belong to the root account and set the default dates for the
+courses in them. Dates can be overridden per enrollment type, for example to
+give teachers access before students.
+
+If --account-id is not specified, uses the default account ID from config.
+
+Examples:
+  canvas terms list
+  canvas terms get "Fall 2026"
+  canvas terms create --name "Fall 2026" --start 2026-08-24 --end 2026-12-18
+  canvas terms update 12 --override TeacherEnrollment=2026-08-10,2027-01-08`,
+}
+
+func init() {
+	rootCmd.AddCommand(termsCmd)
+	termsCmd.AddCommand(newTermsListCmd())
+	termsCmd.AddCommand(newTermsGetCmd())
+	termsCmd.AddCommand(newTermsCreateCmd())
+	termsCmd.AddCommand(newTermsUpdateCmd())
+	termsCmd.AddCommand(newTermsDeleteCmd())
+}
+
+func newTermsListCmd() *cobra.Command {
+	opts := &options.TermsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List enrollment terms",
+		Long: `List the enrollment terms of an account.
+
+Examples:
+  canvas terms list
+  canvas terms list --account-id 1 --state all
+  canvas terms list --name 2026 --include overrides,course_count`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accountID, err := resolveAccountID(opts.AccountID, "terms list")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runTermsList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.WorkflowState, "state", "", "Filter by state: active, deleted, all")
+	cmd.Flags().StringSliceVar(&opts.Include, "include", []string{}, "Additional data to include (overrides, course_count)")
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Filter by partial term name")
+
+	return cmd
+}
+
+func newTermsGetCmd() *cobra.Command {
+	opts := &options.TermsGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <term>",
+		Short: "Get an enrollment term",
+		Long: `Get an enrollment term by ID, SIS term ID or exact name.
+
+Examples:
+  canvas terms get 12
+  canvas terms get 2026FA
+  canvas terms get "Fall 2026"`,
+		Args: ExactArgsWithUsage(1, "term"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Term = args[0]
+
+			accountID, err := resolveAccountID(opts.AccountID, "terms get")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runTermsGet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+
+	return cmd
+}
+
+func newTermsCreateCmd() *cobra.Command {
+	opts := &options.TermsCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an enrollment term",
+		Long: `Create a new enrollment term.
+
+Use --override to set dates for one enrollment type, as
+EnrollmentType=start,end (either date may be empty). Valid types are
+StudentEnrollment, TeacherEnrollment, TaEnrollment and DesignerEnrollment.
+
+Examples:
+  canvas terms create --name "Fall 2026" --start 2026-08-24 --end 2026-12-18
+  canvas terms create --name "Fall 2026" --sis-term-id 2026FA \
+    --override TeacherEnrollment=2026-08-10,2027-01-08`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accountID, err := resolveAccountID(opts.AccountID, "terms create")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runTermsCreate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Term name (required)")
+	cmd.Flags().StringVar(&opts.StartAt, "start", "", "Start date (YYYY-MM-DD or ISO 8601)")
+	cmd.Flags().StringVar(&opts.EndAt, "end", "", "End date (YYYY-MM-DD or ISO 8601)")
+	cmd.Flags().StringVar(&opts.SISTermID, "sis-term-id", "", "SIS term ID")
+	cmd.Flags().StringArrayVar(&opts.Overrides, "override", nil, "Dates for an enrollment type: EnrollmentType=start,end (repeatable)")
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func newTermsUpdateCmd() *cobra.Command {
+	opts := &options.TermsUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <term-id>",
+		Short: "Update an enrollment term",
+		Long: `Update an existing enrollment term.
+
+Examples:
+  canvas terms update 12 --name "Fall 2026 (Main)"
+  canvas terms update 12 --end 2026-12-20
+  canvas terms update 12 --override StudentEnrollment=2026-08-24,2026-12-22`,
+		Args: ExactArgsWithUsage(1, "term-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			termID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid term ID: %s", args[0])
+			}
+			opts.TermID = termID
+
+			opts.NameSet = cmd.Flags().Changed("name")
+			opts.StartAtSet = cmd.Flags().Changed("start")
+			opts.EndAtSet = cmd.Flags().Changed("end")
+			opts.SISTermIDSet = cmd.Flags().Changed("sis-term-id")
+
+			accountID, err := resolveAccountID(opts.AccountID, "terms update")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runTermsUpdate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Term name")
+	cmd.Flags().StringVar(&opts.StartAt, "start", "", "Start date (YYYY-MM-DD or ISO 8601)")
+	cmd.Flags().StringVar(&opts.EndAt, "end", "", "End date (YYYY-MM-DD or ISO 8601)")
+	cmd.Flags().StringVar(&opts.SISTermID, "sis-term-id", "", "SIS term ID")
+	cmd.Flags().StringArrayVar(&opts.Overrides, "override", nil, "Dates for an enrollment type: EnrollmentType=start,end (repeatable)")
+
+	return cmd
+}
+
+func newTermsDeleteCmd() *cobra.Command {
+	opts := &options.TermsDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <term-id>",
+		Short: "Delete an enrollment term",
+		Long: `Delete an enrollment term.
+
+Examples:
+  canvas terms delete 12
+  canvas terms delete 12 --force`,
+		Args: ExactArgsWithUsage(1, "term-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			termID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid term ID: %s", args[0])
+			}
+			opts.TermID = termID
+
+			accountID, err := resolveAccountID(opts.AccountID, "terms delete")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runTermsDelete(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runTermsList(ctx context.Context, client *api.Client, opts *options.TermsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "terms.list", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"state":      opts.WorkflowState,
+	})
+
+	apiOpts := &api.ListTermsOptions{
+		Include:  opts.Include,
+		TermName: opts.Name,
+	}
+	if opts.WorkflowState != "" {
+		apiOpts.WorkflowState = []string{opts.WorkflowState}
+	}
+
+	service := api.NewTermsService(client)
+
+	terms, err := service.List(ctx, opts.AccountID, apiOpts)
+	if err != nil {
+		logger.LogCommandError(ctx, "terms.list", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+		})
+		return fmt.Errorf("failed to list terms: %w", err)
+	}
+
+	printVerbose("Found %d terms in account %d:\n\n", len(terms), opts.AccountID)
+
+	logger.LogCommandComplete(ctx, "terms.list", len(terms))
+	return formatEmptyOrOutput(terms, "No terms found")
+}
+
+func runTermsGet(ctx context.Context, client *api.Client, opts *options.TermsGetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "terms.get", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"term":       opts.Term,
+	})
+
+	service := api.NewTermsService(client)
+
+	term, err := service.Resolve(ctx, opts.AccountID, opts.Term)
+	if err != nil {
+		logger.LogCommandError(ctx, "terms.get", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"term":       opts.Term,
+		})
+		return fmt.Errorf("failed to get term: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "terms.get", 1)
+	return formatOutput(term, nil)
+}
+
+func runTermsCreate(ctx context.Context, client *api.Client, opts *options.TermsCreateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "terms.create", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"name":       opts.Name,
+	})
+
+	overrides, err := parseTermOverrides(opts.Overrides)
+	if err != nil {
+		return err
+	}
+
+	params := &api.CreateTermParams{
+		Name:      opts.Name,
+		StartAt:   opts.StartAt,
+		EndAt:     opts.EndAt,
+		SISTermID: opts.SISTermID,
+		Overrides: overrides,
+	}
+
+	service := api.NewTermsService(client)
+
+	term, err := service.Create(ctx, opts.AccountID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "terms.create", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"name":       opts.Name,
+		})
+		return fmt.Errorf("failed to create term: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "terms.create", 1)
+	return formatSuccessOutput(term, fmt.Sprintf("Term created successfully (ID: %d)", term.ID))
+}
+
+func runTermsUpdate(ctx context.Context, client *api.Client, opts *options.TermsUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "terms.update", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"term_id":    opts.TermID,
+	})
+
+	overrides, err := parseTermOverrides(opts.Overrides)
+	if err != nil {
+		return err
+	}
+
+	params := &api.UpdateTermParams{Overrides: overrides}
+	if opts.NameSet {
+		params.Name = &opts.Name
+	}
+	if opts.StartAtSet {
+		params.StartAt = &opts.StartAt
+	}
+	if opts.EndAtSet {
+		params.EndAt = &opts.EndAt
+	}
+	if opts.SISTermIDSet {
+		params.SISTermID = &opts.SISTermID
+	}
+
+	service := api.NewTermsService(client)
+
+	term, err := service.Update(ctx, opts.AccountID, opts.TermID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "terms.update", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"term_id":    opts.TermID,
+		})
+		return fmt.Errorf("failed to update term: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "terms.update", 1)
+	return formatSuccessOutput(term, fmt.Sprintf("Term updated successfully (ID: %d)", term.ID))
+}
+
+func runTermsDelete(ctx context.Context, client *api.Client, opts *options.TermsDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "terms.delete", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"term_id":    opts.TermID,
+		"force":      opts.Force,
+	})
+
+	confirmed, err := confirmDelete("term", opts.TermID, opts.Force)
+	if err != nil {
+		logger.LogCommandError(ctx, "terms.delete", err, map[string]interface{}{})
+		return err
+	}
+	if !confirmed {
+		logger.LogCommandComplete(ctx, "terms.delete", 0)
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	service := api.NewTermsService(client)
+
+	term, err := service.Delete(ctx, opts.AccountID, opts.TermID)
+	if err != nil {
+		logger.LogCommandError(ctx, "terms.delete", err, map[string]interface{}{
+			"account_id": opts.AccountID,
+			"term_id":    opts.TermID,
+		})
+		return fmt.Errorf("failed to delete term: %w", err)
+	}
+
+	fmt.Printf("Term %d deleted\n", term.ID)
+	logger.LogCommandComplete(ctx, "terms.delete", 1)
+	return nil
+}
+
+// parseTermOverrides converts EnrollmentType=start,end flags to override params
+func parseTermOverrides(values []string) (map[string]api.TermOverrideParams, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	overrides := make(map[string]api.TermOverrideParams, len(values))
+	for _, value := range values {
+		enrollmentType, dates, _ := strings.Cut(value, "=")
+		if !slices.Contains(api.TermEnrollmentTypes, enrollmentType) {
+			return nil, fmt.Errorf("invalid enrollment type in override: %s (valid: %s)", enrollmentType, strings.Join(api.TermEnrollmentTypes, ", "))
+		}
+
+		startAt, endAt, _ := strings.Cut(dates, ",")
+		overrides[enrollmentType] = api.TermOverrideParams{
+			StartAt: strings.TrimSpace(startAt),
+			EndAt:   strings.TrimSpace(endAt),
+		}
+	}
+
+	return overrides, nil
+}
+
+// resolveTermID resolves a --term selector (ID, SIS term ID or name) to a term
+// ID. Terms live on the root account, so a sub-account is mapped to its root.
+func resolveTermID(ctx context.Context, client *api.Client, accountID int64, selector string) (int64, error) {
+	rootAccountID := accountID
+
+	account, err := api.NewAccountsService(client).Get(ctx, accountID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get account %d: %w", accountID, err)
+	}
+	if account.RootAccountID != 0 {
+		rootAccountID = account.RootAccountID
+	}
+
+	term, err := api.NewTermsService(client).Resolve(ctx, rootAccountID, selector)
+	if err != nil {
+		return 0, err
+	}
+
+	printVerbose("Using term %q (ID: %d)\n", term.Name, term.ID)
+	return term.ID, nil
+}
+
+// filterCoursesByTerm keeps the courses whose term matches a --term selector.
+// Used for enrolled courses, where the term is included with each course and
+// no admin access to the account's terms is needed.
+func filterCoursesByTerm(courses []api.Course, selector string) []api.Course {
+	filtered := []api.Course{}
+	for _, course := range courses {
+		if courseInTerm(course, selector) {
+			filtered = append(filtered, course)
+		}
+	}
+
+	return filtered
+}
+
+// courseInTerm reports whether the term included with a course matches a
+// --term selector
+func courseInTerm(course api.Course, selector string) bool {
+	term := course.Term
+	if term == nil {
+		return false
+	}
+
+	selector = strings.TrimSpace(selector)
+	return strconv.FormatInt(term.ID, 10) == selector ||
+		(term.SISTermID != "" && term.SISTermID == selector) ||
+		strings.EqualFold(term.Name, selector)
+}
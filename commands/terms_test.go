@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+func TestTermsListCmd(t *testing.T) {
+	oldFormat := outputFormat
+	outputFormat = "json"
+	defer func() { outputFormat = oldFormat }()
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "list terms",
+			Args: []string{"--account-id", "1"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1/terms": cmdtest.NewMockResponse(`{"enrollment_terms":[{"id":2,"name":"Fall 2026"}]}`),
+			},
+			ExpectOutput: "Fall 2026",
+		},
+		{
+			Name:        "invalid state",
+			Args:        []string{"--account-id", "1", "--state", "archived"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newTermsListCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestTermsCreateCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "create with override",
+			Args: []string{"--account-id", "1", "--name", "Fall 2026", "--override", "TeacherEnrollment=2026-08-10,2027-01-08"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1/terms": cmdtest.NewMockResponse(`{"id":5,"name":"Fall 2026"}`),
+			},
+			ExpectOutput: "ID: 5",
+		},
+		{
+			Name:        "unknown enrollment type",
+			Args:        []string{"--account-id", "1", "--name", "Fall 2026", "--override", "GuestEnrollment=2026-08-10,"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newTermsCreateCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestAccountsCoursesCmd_Term(t *testing.T) {
+	oldFormat := outputFormat
+	outputFormat = "json"
+	defer func() { outputFormat = oldFormat }()
+
+	tc := cmdtest.CommandTestCase{
+		Name: "filter by term name",
+		Args: []string{"2", "--term", "Fall 2026"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/v1/accounts/2":         cmdtest.NewMockResponse(`{"id":2,"name":"Science","root_account_id":1}`),
+			"/api/v1/accounts/1/terms":   cmdtest.NewMockResponse(`{"enrollment_terms":[{"id":7,"name":"Fall 2026"}]}`),
+			"/api/v1/accounts/2/courses": cmdtest.NewMockResponse(`[{"id":10,"name":"Biology","enrollment_term_id":7}]`),
+		},
+		ExpectOutput: "Biology",
+	}
+
+	cmdtest.RunCommandTest(t, newAccountsCoursesCmd(), tc)
+}
+
+func TestFilterCoursesByTerm(t *testing.T) {
+	courses := []api.Course{
+		{ID: 1, Term: &api.Term{ID: 7, Name: "Fall 2026", SISTermID: "2026FA"}},
+		{ID: 2, Term: &api.Term{ID: 8, Name: "Spring 2027"}},
+		{ID: 3},
+	}
+
+	for _, selector := range []string{"7", "2026FA", "fall 2026"} {
+		filtered := filterCoursesByTerm(courses, selector)
+		if len(filtered) != 1 || filtered[0].ID != 1 {
+			t.Errorf("Selector %q: expected course 1, got %+v", selector, filtered)
+		}
+	}
+
+	if filtered := filterCoursesByTerm(courses, "Winter"); len(filtered) != 0 {
+		t.Errorf("Expected no courses for unknown term, got %d", len(filtered))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"os"
 	"strconv"
 
@@ -42,8 +43,9 @@ func newUsersListCmd() *cobra.Command {
 	opts := &options.UsersListOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "list",
-		Short: "List users in an account or course",
+		Use:         "list",
+		Short:       "List users in an account or course",
+		Annotations: streamingAnnotations(),
 		Long: `List users in a Canvas account or course.
 
 Specify --account-id or --course-id, or uses default account if configured.
@@ -64,7 +66,8 @@ Examples:
   canvas users list --account-id 1 --limit 100
   canvas users list --course-id 123
   canvas users list --search "john"
-  canvas users list --include email,enrollments`,
+  canvas users list --include email,enrollments
+  canvas users list --account-id 1 -o ndjson     # Stream users as they arrive`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.Validate(); err != nil {
 				return err
@@ -311,6 +314,30 @@ func runUsersList(ctx context.Context, client *api.Client, opts *options.UsersLi
 		Include:         opts.Include,
 	}
 
+	// Stream users page by page for NDJSON output
+	if isStreamingOutput() {
+		var seq iter.Seq2[api.User, error]
+		contextName := fmt.Sprintf("account %d", accountID)
+		if accountID > 0 {
+			seq = usersService.ListIter(ctx, accountID, listOpts)
+		} else {
+			seq = usersService.ListCourseUsersIter(ctx, opts.CourseID, listOpts)
+			contextName = fmt.Sprintf("course %d", opts.CourseID)
+		}
+
+		count, err := streamNDJSON(seq)
+		if err != nil {
+			logger.LogCommandError(ctx, "users.list", err, map[string]interface{}{
+				"context": contextName,
+				"written": count,
+			})
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+
+		logger.LogCommandComplete(ctx, "users.list", count)
+		return nil
+	}
+
 	// List users based on context
 	var users []api.User
 	var contextName string
@@ -0,0 +1,719 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+	"github.com/jjuanrivvera/canvas-cli/internal/batch"
+)
+
+// usersChannelsCmd represents the users channels command group
+var usersChannelsCmd = &cobra.Command{
+	Use:   "channels",
+	Short: "Manage communication channels",
+	Long: `Manage the communication channels (email addresses, phone numbers,
+push endpoints) that Canvas sends a user's notifications to.
+
+The user ID defaults to the current user.
+
+Examples:
+  canvas users channels list
+  canvas users channels list 123
+  canvas users channels create 123 --address jane@example.edu --skip-confirmation
+  canvas users channels delete 123 456`,
+}
+
+// usersNotificationsCmd represents the users notifications command group
+var usersNotificationsCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "Manage notification preferences",
+	Long: `View and update how often each notification is sent to a user's channel.
+
+Preferences are set per channel; by default the user's primary email channel
+is used. Updating another user's preferences masquerades as that user and
+requires the "Act as users" permission.
+
+Frequencies: immediately, daily, weekly, never
+
+Examples:
+  canvas users notifications list
+  canvas users notifications list 123 --category due_date
+  canvas users notifications set 123 --category announcement --frequency immediately
+  canvas users notifications apply --template prefs.yaml --users-csv users.csv`,
+}
+
+func init() {
+	usersCmd.AddCommand(usersChannelsCmd)
+	usersChannelsCmd.AddCommand(newUsersChannelsListCmd())
+	usersChannelsCmd.AddCommand(newUsersChannelsCreateCmd())
+	usersChannelsCmd.AddCommand(newUsersChannelsDeleteCmd())
+
+	usersCmd.AddCommand(usersNotificationsCmd)
+	usersNotificationsCmd.AddCommand(newUsersNotificationsListCmd())
+	usersNotificationsCmd.AddCommand(newUsersNotificationsSetCmd())
+	usersNotificationsCmd.AddCommand(newUsersNotificationsApplyCmd())
+}
+
+func newUsersChannelsListCmd() *cobra.Command {
+	opts := &options.UsersChannelsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [user-id]",
+		Short: "List a user's communication channels",
+		Long: `List a user's communication channels.
+
+Examples:
+  canvas users channels list
+  canvas users channels list 123`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := parseOptionalUserID(args)
+			if err != nil {
+				return err
+			}
+			opts.UserID = userID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersChannelsList(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newUsersChannelsCreateCmd() *cobra.Command {
+	opts := &options.UsersChannelsCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create [user-id]",
+		Short: "Add a communication channel",
+		Long: `Add a communication channel to a user.
+
+Canvas sends a confirmation message to new channels unless
+--skip-confirmation is set, which requires account admin rights.
+
+Examples:
+  canvas users channels create --address me@example.edu
+  canvas users channels create 123 --address jane@example.edu --skip-confirmation
+  canvas users channels create 123 --address 5551234567 --type sms`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := parseOptionalUserID(args)
+			if err != nil {
+				return err
+			}
+			opts.UserID = userID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersChannelsCreate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Address, "address", "", "Channel address, e.g. an email address (required)")
+	cmd.Flags().StringVar(&opts.Type, "type", "email", "Channel type: email, sms, push")
+	cmd.Flags().BoolVar(&opts.SkipConfirmation, "skip-confirmation", false, "Activate the channel without a confirmation message (admin)")
+	cmd.MarkFlagRequired("address")
+
+	return cmd
+}
+
+func newUsersChannelsDeleteCmd() *cobra.Command {
+	opts := &options.UsersChannelsDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <user-id> <channel-id>",
+		Short: "Delete a communication channel",
+		Long: `Delete a communication channel from a user.
+
+Examples:
+  canvas users channels delete 123 456
+  canvas users channels delete 123 456 --force`,
+		Args: ExactArgsWithUsage(2, "user-id", "channel-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.UserID = userID
+
+			channelID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid channel ID: %s", args[1])
+			}
+			opts.ChannelID = channelID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersChannelsDelete(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newUsersNotificationsListCmd() *cobra.Command {
+	opts := &options.UsersNotificationsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [user-id]",
+		Short: "List notification preferences",
+		Long: `List the notification preferences of a user's channel.
+
+Examples:
+  canvas users notifications list
+  canvas users notifications list 123 --channel-id 456
+  canvas users notifications list 123 --category grading`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := parseOptionalUserID(args)
+			if err != nil {
+				return err
+			}
+			opts.UserID = userID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersNotificationsList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.ChannelID, "channel-id", 0, "Communication channel ID (default: primary channel of --channel-type)")
+	cmd.Flags().StringVar(&opts.ChannelType, "channel-type", "email", "Channel type used when --channel-id is not set")
+	cmd.Flags().StringVar(&opts.Category, "category", "", "Only show one category")
+
+	return cmd
+}
+
+func newUsersNotificationsSetCmd() *cobra.Command {
+	opts := &options.UsersNotificationsSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set [user-id]",
+		Short: "Update a notification preference",
+		Long: `Update the frequency of one notification, or of every notification in a
+category, on a user's channel.
+
+Examples:
+  canvas users notifications set --category due_date --frequency daily
+  canvas users notifications set 123 --notification new_announcement --frequency immediately
+  canvas users notifications set 123 --channel-id 456 --category grading --frequency never`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := parseOptionalUserID(args)
+			if err != nil {
+				return err
+			}
+			opts.UserID = userID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := checkAsUserConflict([]int64{opts.UserID}); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersNotificationsSet(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.ChannelID, "channel-id", 0, "Communication channel ID (default: primary channel of --channel-type)")
+	cmd.Flags().StringVar(&opts.ChannelType, "channel-type", "email", "Channel type used when --channel-id is not set")
+	cmd.Flags().StringVar(&opts.Category, "category", "", "Notification category, e.g. due_date, announcement, grading")
+	cmd.Flags().StringVar(&opts.Notification, "notification", "", "Single notification, e.g. new_announcement")
+	cmd.Flags().StringVar(&opts.Frequency, "frequency", "", "Frequency: immediately, daily, weekly, never (required)")
+	cmd.MarkFlagRequired("frequency")
+
+	return cmd
+}
+
+func newUsersNotificationsApplyCmd() *cobra.Command {
+	opts := &options.UsersNotificationsApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a preference template to many users",
+		Long: `Apply one notification preference template to a list of users.
+
+The template is a YAML (or JSON) file mapping categories and individual
+notifications to frequencies. channel_type picks which of each user's
+channels is updated (default: email):
+
+  channel_type: email
+  categories:
+    due_date: daily
+    announcement: immediately
+    grading: immediately
+  notifications:
+    new_discussion_entry: never
+
+Users come from --users or from the user_id column of --users-csv. Each
+user is updated by masquerading as them, which requires the "Act as users"
+permission. Failures are reported per user and do not stop the run.
+
+Examples:
+  canvas users notifications apply --template prefs.yaml --users 101,102,103
+  canvas users notifications apply --template prefs.yaml --users-csv users.csv --concurrency 10
+  canvas users notifications apply --template prefs.yaml --users-csv users.csv --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersNotificationsApply(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.TemplatePath, "template", "", "Preference template file (required)")
+	cmd.Flags().Int64SliceVar(&opts.UserIDs, "users", nil, "User IDs (comma-separated)")
+	cmd.Flags().StringVar(&opts.UsersCSV, "users-csv", "", "CSV file with a user_id column")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of users updated in parallel")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be applied without making changes")
+	cmd.MarkFlagRequired("template")
+
+	return cmd
+}
+
+func runUsersChannelsList(ctx context.Context, client *api.Client, opts *options.UsersChannelsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.channels.list", map[string]interface{}{
+		"user_id": opts.UserID,
+	})
+
+	service := api.NewCommunicationChannelsService(client)
+
+	channels, err := service.List(ctx, opts.UserID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.channels.list", err, map[string]interface{}{
+			"user_id": opts.UserID,
+		})
+		return fmt.Errorf("failed to list communication channels: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.channels.list", len(channels))
+	return formatEmptyOrOutput(channels, "No communication channels found")
+}
+
+func runUsersChannelsCreate(ctx context.Context, client *api.Client, opts *options.UsersChannelsCreateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.channels.create", map[string]interface{}{
+		"user_id": opts.UserID,
+		"type":    opts.Type,
+	})
+
+	service := api.NewCommunicationChannelsService(client)
+
+	channel, err := service.Create(ctx, opts.UserID, &api.CreateChannelParams{
+		Address:          opts.Address,
+		Type:             opts.Type,
+		SkipConfirmation: opts.SkipConfirmation,
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "users.channels.create", err, map[string]interface{}{
+			"user_id": opts.UserID,
+		})
+		return fmt.Errorf("failed to create communication channel: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.channels.create", 1)
+	return formatSuccessOutput(channel, fmt.Sprintf("Communication channel created (ID: %d, state: %s)", channel.ID, channel.WorkflowState))
+}
+
+func runUsersChannelsDelete(ctx context.Context, client *api.Client, opts *options.UsersChannelsDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.channels.delete", map[string]interface{}{
+		"user_id":    opts.UserID,
+		"channel_id": opts.ChannelID,
+		"force":      opts.Force,
+	})
+
+	confirmed, err := confirmDelete("communication channel", opts.ChannelID, opts.Force)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.channels.delete", err, map[string]interface{}{})
+		return err
+	}
+	if !confirmed {
+		logger.LogCommandComplete(ctx, "users.channels.delete", 0)
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	service := api.NewCommunicationChannelsService(client)
+
+	channel, err := service.Delete(ctx, opts.UserID, opts.ChannelID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.channels.delete", err, map[string]interface{}{
+			"user_id":    opts.UserID,
+			"channel_id": opts.ChannelID,
+		})
+		return fmt.Errorf("failed to delete communication channel: %w", err)
+	}
+
+	fmt.Printf("Communication channel %d (%s) deleted\n", channel.ID, channel.Address)
+	logger.LogCommandComplete(ctx, "users.channels.delete", 1)
+	return nil
+}
+
+func runUsersNotificationsList(ctx context.Context, client *api.Client, opts *options.UsersNotificationsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.notifications.list", map[string]interface{}{
+		"user_id":    opts.UserID,
+		"channel_id": opts.ChannelID,
+	})
+
+	service := api.NewCommunicationChannelsService(client)
+
+	channelID, err := resolveChannelID(ctx, service, opts.UserID, opts.ChannelID, opts.ChannelType)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.notifications.list", err, map[string]interface{}{
+			"user_id": opts.UserID,
+		})
+		return err
+	}
+
+	preferences, err := service.ListPreferences(ctx, opts.UserID, channelID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.notifications.list", err, map[string]interface{}{
+			"user_id":    opts.UserID,
+			"channel_id": channelID,
+		})
+		return fmt.Errorf("failed to list notification preferences: %w", err)
+	}
+
+	if opts.Category != "" {
+		filtered := []api.NotificationPreference{}
+		for _, preference := range preferences {
+			if preference.Category == opts.Category {
+				filtered = append(filtered, preference)
+			}
+		}
+		preferences = filtered
+	}
+
+	logger.LogCommandComplete(ctx, "users.notifications.list", len(preferences))
+	return formatEmptyOrOutput(preferences, "No notification preferences found")
+}
+
+func runUsersNotificationsSet(ctx context.Context, client *api.Client, opts *options.UsersNotificationsSetOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.notifications.set", map[string]interface{}{
+		"user_id":      opts.UserID,
+		"channel_id":   opts.ChannelID,
+		"category":     opts.Category,
+		"notification": opts.Notification,
+		"frequency":    opts.Frequency,
+	})
+
+	service := api.NewCommunicationChannelsService(client)
+
+	channelID, err := resolveChannelID(ctx, service, opts.UserID, opts.ChannelID, opts.ChannelType)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.notifications.set", err, map[string]interface{}{
+			"user_id": opts.UserID,
+		})
+		return err
+	}
+
+	var preferences []api.NotificationPreference
+	if opts.Category != "" {
+		preferences, err = service.UpdateCategory(ctx, opts.UserID, channelID, opts.Category, opts.Frequency)
+	} else {
+		preferences, err = service.UpdatePreferences(ctx, opts.UserID, channelID, map[string]string{
+			opts.Notification: opts.Frequency,
+		})
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "users.notifications.set", err, map[string]interface{}{
+			"user_id":    opts.UserID,
+			"channel_id": channelID,
+		})
+		return fmt.Errorf("failed to update notification preferences: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.notifications.set", len(preferences))
+	return formatSuccessOutput(preferences, fmt.Sprintf("Updated %d notification preferences", len(preferences)))
+}
+
+// notificationTemplate is a set of preferences applied to many users
+type notificationTemplate struct {
+	ChannelType   string            `yaml:"channel_type"`
+	Categories    map[string]string `yaml:"categories"`
+	Notifications map[string]string `yaml:"notifications"`
+}
+
+func runUsersNotificationsApply(ctx context.Context, client *api.Client, opts *options.UsersNotificationsApplyOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.notifications.apply", map[string]interface{}{
+		"template":  opts.TemplatePath,
+		"users_csv": opts.UsersCSV,
+		"dry_run":   opts.DryRun,
+	})
+
+	template, err := loadNotificationTemplate(opts.TemplatePath)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.notifications.apply", err, map[string]interface{}{
+			"template": opts.TemplatePath,
+		})
+		return err
+	}
+
+	userIDs := slices.Clone(opts.UserIDs)
+	if opts.UsersCSV != "" {
+		csvIDs, err := readUserIDsCSV(opts.UsersCSV)
+		if err != nil {
+			logger.LogCommandError(ctx, "users.notifications.apply", err, map[string]interface{}{
+				"users_csv": opts.UsersCSV,
+			})
+			return err
+		}
+		userIDs = append(userIDs, csvIDs...)
+	}
+
+	if err := checkAsUserConflict(userIDs); err != nil {
+		logger.LogCommandError(ctx, "users.notifications.apply", err, nil)
+		return err
+	}
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+		fmt.Printf("\nThe following preferences would be applied to the %s channel of %d users:\n", template.ChannelType, len(userIDs))
+		for _, category := range slices.Sorted(maps.Keys(template.Categories)) {
+			fmt.Printf("  category %s: %s\n", category, template.Categories[category])
+		}
+		for _, notification := range slices.Sorted(maps.Keys(template.Notifications)) {
+			fmt.Printf("  notification %s: %s\n", notification, template.Notifications[notification])
+		}
+		logger.LogCommandComplete(ctx, "users.notifications.apply", 0)
+		return nil
+	}
+
+	service := api.NewCommunicationChannelsService(client)
+
+	items := make([]interface{}, len(userIDs))
+	for i, userID := range userIDs {
+		items[i] = userID
+	}
+
+	processor := batch.New(opts.Concurrency, false, batch.NewConsoleProgress(time.Second))
+	summary, err := processor.Process(ctx, items, func(ctx context.Context, item interface{}) error {
+		userID := item.(int64)
+		if err := applyNotificationTemplate(ctx, service, userID, template); err != nil {
+			return fmt.Errorf("user %d: %w", userID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "users.notifications.apply", err, map[string]interface{}{})
+		return err
+	}
+
+	fmt.Printf("\nApplied template to %d of %d users\n", summary.Succeeded, summary.Total)
+	if summary.Failed > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range summary.Errors() {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, "users.notifications.apply", summary.Succeeded)
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("notification template failed for %d users", summary.Failed)
+	}
+
+	return nil
+}
+
+// applyNotificationTemplate updates one user's channel to match the template
+func applyNotificationTemplate(ctx context.Context, service *api.CommunicationChannelsService, userID int64, template *notificationTemplate) error {
+	channelID, err := resolveChannelID(ctx, service, userID, 0, template.ChannelType)
+	if err != nil {
+		return err
+	}
+
+	for _, category := range slices.Sorted(maps.Keys(template.Categories)) {
+		if _, err := service.UpdateCategory(ctx, userID, channelID, category, template.Categories[category]); err != nil {
+			return fmt.Errorf("category %s: %w", category, err)
+		}
+	}
+
+	if len(template.Notifications) > 0 {
+		if _, err := service.UpdatePreferences(ctx, userID, channelID, template.Notifications); err != nil {
+			return fmt.Errorf("notifications: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// loadNotificationTemplate reads and validates a preference template
+func loadNotificationTemplate(path string) (*notificationTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template: %w", err)
+	}
+
+	var template notificationTemplate
+	if err := yaml.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	if template.ChannelType == "" {
+		template.ChannelType = "email"
+	}
+
+	if len(template.Categories) == 0 && len(template.Notifications) == 0 {
+		return nil, fmt.Errorf("template %s has no categories or notifications", path)
+	}
+
+	for name, frequency := range template.Categories {
+		if !slices.Contains(api.NotificationFrequencies, frequency) {
+			return nil, fmt.Errorf("invalid frequency %q for category %s (valid: %s)", frequency, name, strings.Join(api.NotificationFrequencies, ", "))
+		}
+	}
+	for name, frequency := range template.Notifications {
+		if !slices.Contains(api.NotificationFrequencies, frequency) {
+			return nil, fmt.Errorf("invalid frequency %q for notification %s (valid: %s)", frequency, name, strings.Join(api.NotificationFrequencies, ", "))
+		}
+	}
+
+	return &template, nil
+}
+
+// readUserIDsCSV reads the user_id column of a CSV file
+func readUserIDsCSV(path string) ([]int64, error) {
+	records, err := batch.ReadCSV(path)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(records))
+	for i, record := range records {
+		value, ok := record["user_id"]
+		if !ok {
+			return nil, fmt.Errorf("%s has no user_id column", path)
+		}
+
+		userID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s row %d: invalid user_id %q", path, i+2, value)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
+
+// resolveChannelID returns channelID when set, otherwise the user's primary
+// channel of the given type (the active one with the lowest position)
+func resolveChannelID(ctx context.Context, service *api.CommunicationChannelsService, userID, channelID int64, channelType string) (int64, error) {
+	if channelID > 0 {
+		return channelID, nil
+	}
+
+	channels, err := service.List(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list communication channels: %w", err)
+	}
+
+	var primary *api.CommunicationChannel
+	for i := range channels {
+		channel := &channels[i]
+		if channel.Type != channelType || channel.WorkflowState == "retired" {
+			continue
+		}
+		if primary == nil || channel.Position < primary.Position {
+			primary = channel
+		}
+	}
+
+	if primary == nil {
+		return 0, fmt.Errorf("no %s communication channel found", channelType)
+	}
+
+	printVerbose("Using %s channel %d (%s)\n", primary.Type, primary.ID, primary.Address)
+	return primary.ID, nil
+}
+
+// checkAsUserConflict rejects preference updates for users other than the
+// --as-user target. Other users' preferences are updated by masquerading as
+// them, which cannot be combined with masquerading as someone else.
+func checkAsUserConflict(userIDs []int64) error {
+	if asUserID <= 0 {
+		return nil
+	}
+
+	for _, userID := range userIDs {
+		if userID > 0 && userID != asUserID {
+			return fmt.Errorf("cannot update user %d while masquerading with --as-user %d; omit --as-user or target user %d only", userID, asUserID, asUserID)
+		}
+	}
+
+	return nil
+}
+
+// parseOptionalUserID parses an optional user ID argument; 0 means the current user
+func parseOptionalUserID(args []string) (int64, error) {
+	if len(args) == 0 {
+		return 0, nil
+	}
+
+	userID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %s", args[0])
+	}
+	return userID, nil
+}
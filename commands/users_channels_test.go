@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestUsersNotificationsApplyCmd(t *testing.T) {
+	dir := t.TempDir()
+	templatePath := filepath.Join(dir, "prefs.yaml")
+	if err := os.WriteFile(templatePath, []byte("categories:\n  due_date: daily\nnotifications:\n  new_announcement: immediately\n"), 0600); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	usersCSV := filepath.Join(dir, "users.csv")
+	if err := os.WriteFile(usersCSV, []byte("user_id,name\n102,Second\n"), 0600); err != nil {
+		t.Fatalf("Failed to write users CSV: %v", err)
+	}
+
+	channels := `[{"id":21,"type":"sms","position":1,"workflow_state":"active"},{"id":11,"type":"email","position":2,"workflow_state":"active"}]`
+	preferences := `{"notification_preferences":[]}`
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "apply to users",
+			Args: []string{"--template", templatePath, "--users", "101", "--users-csv", usersCSV},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/users/101/communication_channels":                      cmdtest.NewMockResponse(channels),
+				"/api/v1/users/102/communication_channels":                      cmdtest.NewMockResponse(channels),
+				"/communication_channels/11/notification_preference_categories": cmdtest.NewMockResponse(preferences),
+				"/communication_channels/11/notification_preferences":           cmdtest.NewMockResponse(preferences),
+			},
+			ExpectOutput: "Applied template to 2 of 2 users",
+		},
+		{
+			Name: "user without email channel",
+			Args: []string{"--template", templatePath, "--users", "103"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/users/103/communication_channels": cmdtest.NewMockResponse(`[{"id":21,"type":"sms","workflow_state":"active"}]`),
+			},
+			ExpectError: true,
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "user 103: no email communication channel found") {
+					t.Errorf("Expected per-user error, got: %s", output)
+				}
+			},
+		},
+		{
+			Name:         "dry run",
+			Args:         []string{"--template", templatePath, "--users", "101,102", "--dry-run"},
+			ExpectOutput: "category due_date: daily",
+		},
+		{
+			Name:        "no users",
+			Args:        []string{"--template", templatePath},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newUsersNotificationsApplyCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestUsersNotificationsSetCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "set category on primary email channel",
+			Args: []string{"101", "--category", "grading", "--frequency", "never"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/users/101/communication_channels":                              cmdtest.NewMockResponse(`[{"id":11,"type":"email","position":1,"workflow_state":"active"}]`),
+				"/communication_channels/11/notification_preference_categories/grading": cmdtest.NewMockResponse(`{"notification_preferences":[{"notification":"grade_changed","category":"grading","frequency":"never"}]}`),
+			},
+			ExpectOutput: "Updated 1 notification preferences",
+		},
+		{
+			Name:        "invalid frequency",
+			Args:        []string{"--category", "grading", "--frequency", "hourly"},
+			ExpectError: true,
+		},
+		{
+			Name:        "category and notification",
+			Args:        []string{"--category", "grading", "--notification", "grade_changed", "--frequency", "daily"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newUsersNotificationsSetCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestLoadNotificationTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prefs.json")
+	if err := os.WriteFile(path, []byte(`{"channel_type":"sms","categories":{"due_date":"sometimes"}}`), 0600); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	if _, err := loadNotificationTemplate(path); err == nil || !strings.Contains(err.Error(), "invalid frequency") {
+		t.Errorf("Expected invalid frequency error, got %v", err)
+	}
+}
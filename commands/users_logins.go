@@ -0,0 +1,623 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+	"github.com/jjuanrivvera/canvas-cli/internal/batch"
+)
+
+// usersLoginsCmd represents the users logins command group
+var usersLoginsCmd = &cobra.Command{
+	Use:   "logins",
+	Short: "Manage user logins",
+	Long: `Manage the logins (pseudonyms) users sign in with, including their
+unique IDs, SIS user IDs and integration IDs.
+
+If --account-id is not specified, uses the default account ID from config.
+
+Examples:
+  canvas users logins list 123
+  canvas users logins create 123 --unique-id jdoe@example.edu --sis-user-id S123
+  canvas users logins update 456 --sis-user-id S124
+  canvas users logins bulk-update --csv logins.csv --dry-run`,
+}
+
+func init() {
+	usersCmd.AddCommand(usersLoginsCmd)
+	usersLoginsCmd.AddCommand(newUsersLoginsListCmd())
+	usersLoginsCmd.AddCommand(newUsersLoginsCreateCmd())
+	usersLoginsCmd.AddCommand(newUsersLoginsUpdateCmd())
+	usersLoginsCmd.AddCommand(newUsersLoginsDeleteCmd())
+	usersLoginsCmd.AddCommand(newUsersLoginsBulkUpdateCmd())
+}
+
+func newUsersLoginsListCmd() *cobra.Command {
+	opts := &options.UsersLoginsListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [user-id]",
+		Short: "List logins of a user or account",
+		Long: `List the logins of a user, or of every user in an account when no user ID
+is given.
+
+Examples:
+  canvas users logins list 123
+  canvas users logins list --account-id 1 --limit 100`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := parseOptionalUserID(args)
+			if err != nil {
+				return err
+			}
+			opts.UserID = userID
+
+			if opts.UserID == 0 {
+				accountID, err := resolveAccountID(opts.AccountID, "users logins list")
+				if err != nil {
+					return err
+				}
+				opts.AccountID = accountID
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersLoginsList(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (when no user ID is given)")
+
+	return cmd
+}
+
+func newUsersLoginsCreateCmd() *cobra.Command {
+	opts := &options.UsersLoginsCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create <user-id>",
+		Short: "Add a login to a user",
+		Long: `Add a login to an existing user.
+
+Examples:
+  canvas users logins create 123 --unique-id jdoe@example.edu
+  canvas users logins create 123 --unique-id jdoe --auth-provider saml --sis-user-id S123`,
+		Args: ExactArgsWithUsage(1, "user-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.UserID = userID
+
+			accountID, err := resolveAccountID(opts.AccountID, "users logins create")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersLoginsCreate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.UniqueID, "unique-id", "", "Unique ID (username) for the login (required)")
+	cmd.Flags().StringVar(&opts.Password, "password", "", "Password")
+	cmd.Flags().StringVar(&opts.SISUserID, "sis-user-id", "", "SIS user ID")
+	cmd.Flags().StringVar(&opts.IntegrationID, "integration-id", "", "Integration ID")
+	cmd.Flags().StringVar(&opts.AuthProviderID, "auth-provider", "", "Authentication provider ID or type (e.g. saml, cas)")
+	cmd.MarkFlagRequired("unique-id")
+
+	return cmd
+}
+
+func newUsersLoginsUpdateCmd() *cobra.Command {
+	opts := &options.UsersLoginsUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <login-id>",
+		Short: "Update a login",
+		Long: `Update a login's unique ID, password, SIS user ID or integration ID.
+
+Pass an empty value to clear the SIS user ID or integration ID.
+
+Examples:
+  canvas users logins update 456 --unique-id jane.doe@example.edu
+  canvas users logins update 456 --sis-user-id S124 --integration-id ""
+  canvas users logins update 456 --state suspended`,
+		Args: ExactArgsWithUsage(1, "login-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loginID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid login ID: %s", args[0])
+			}
+			opts.LoginID = loginID
+
+			opts.UniqueIDSet = cmd.Flags().Changed("unique-id")
+			opts.PasswordSet = cmd.Flags().Changed("password")
+			opts.OldPasswordSet = cmd.Flags().Changed("old-password")
+			opts.SISUserIDSet = cmd.Flags().Changed("sis-user-id")
+			opts.IntegrationIDSet = cmd.Flags().Changed("integration-id")
+			opts.AuthProviderIDSet = cmd.Flags().Changed("auth-provider")
+			opts.StateSet = cmd.Flags().Changed("state")
+
+			accountID, err := resolveAccountID(opts.AccountID, "users logins update")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersLoginsUpdate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID (uses default if configured)")
+	cmd.Flags().StringVar(&opts.UniqueID, "unique-id", "", "New unique ID (username)")
+	cmd.Flags().StringVar(&opts.Password, "password", "", "New password")
+	cmd.Flags().StringVar(&opts.OldPassword, "old-password", "", "Current password (required to change your own password)")
+	cmd.Flags().StringVar(&opts.SISUserID, "sis-user-id", "", "SIS user ID")
+	cmd.Flags().StringVar(&opts.IntegrationID, "integration-id", "", "Integration ID")
+	cmd.Flags().StringVar(&opts.AuthProviderID, "auth-provider", "", "Authentication provider ID or type")
+	cmd.Flags().StringVar(&opts.State, "state", "", "Login state: active, suspended")
+
+	return cmd
+}
+
+func newUsersLoginsDeleteCmd() *cobra.Command {
+	opts := &options.UsersLoginsDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <user-id> <login-id>",
+		Short: "Delete a login",
+		Long: `Delete a login from a user.
+
+Examples:
+  canvas users logins delete 123 456
+  canvas users logins delete 123 456 --force`,
+		Args: ExactArgsWithUsage(2, "user-id", "login-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.UserID = userID
+
+			loginID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid login ID: %s", args[1])
+			}
+			opts.LoginID = loginID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersLoginsDelete(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newUsersLoginsBulkUpdateCmd() *cobra.Command {
+	opts := &options.UsersLoginsBulkUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "bulk-update",
+		Short: "Update logins from a CSV file",
+		Long: `Update many logins from a CSV file.
+
+The CSV must have a login_id column and may have any of these columns:
+unique_id, sis_user_id, integration_id, password, authentication_provider_id,
+workflow_state and account_id (defaults to --account-id). Empty cells leave
+the field unchanged; a "-" in sis_user_id or integration_id clears it.
+Passwords are used exactly as written. Every row is validated before any
+login is updated.
+
+CSV Format:
+  login_id,sis_user_id,integration_id
+  456,S124,
+  457,S125,INT-9
+  458,S126,-
+
+Examples:
+  canvas users logins bulk-update --csv logins.csv --dry-run
+  canvas users logins bulk-update --csv logins.csv --account-id 1 --concurrency 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accountID, err := resolveAccountID(opts.AccountID, "users logins bulk-update")
+			if err != nil {
+				return err
+			}
+			opts.AccountID = accountID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersLoginsBulkUpdate(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.AccountID, "account-id", 0, "Account ID for rows without account_id (uses default if configured)")
+	cmd.Flags().StringVar(&opts.CSV, "csv", "", "CSV file with login updates (required)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of logins updated in parallel")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be updated without making changes")
+	cmd.MarkFlagRequired("csv")
+
+	return cmd
+}
+
+func runUsersLoginsList(ctx context.Context, client *api.Client, opts *options.UsersLoginsListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.logins.list", map[string]interface{}{
+		"user_id":    opts.UserID,
+		"account_id": opts.AccountID,
+	})
+
+	service := api.NewLoginsService(client)
+
+	var logins []api.Login
+	var err error
+
+	if opts.UserID > 0 {
+		logins, err = service.ListUser(ctx, opts.UserID)
+	} else {
+		logins, err = service.ListAccount(ctx, opts.AccountID)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "users.logins.list", err, map[string]interface{}{
+			"user_id":    opts.UserID,
+			"account_id": opts.AccountID,
+		})
+		return fmt.Errorf("failed to list logins: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.logins.list", len(logins))
+	return formatEmptyOrOutput(logins, "No logins found")
+}
+
+func runUsersLoginsCreate(ctx context.Context, client *api.Client, opts *options.UsersLoginsCreateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.logins.create", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"user_id":    opts.UserID,
+		"unique_id":  opts.UniqueID,
+	})
+
+	service := api.NewLoginsService(client)
+
+	login, err := service.Create(ctx, opts.AccountID, &api.CreateLoginParams{
+		UserID:                   opts.UserID,
+		UniqueID:                 opts.UniqueID,
+		Password:                 opts.Password,
+		SISUserID:                opts.SISUserID,
+		IntegrationID:            opts.IntegrationID,
+		AuthenticationProviderID: opts.AuthProviderID,
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "users.logins.create", err, map[string]interface{}{
+			"user_id": opts.UserID,
+		})
+		return fmt.Errorf("failed to create login: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.logins.create", 1)
+	return formatSuccessOutput(login, fmt.Sprintf("Login created successfully (ID: %d)", login.ID))
+}
+
+func runUsersLoginsUpdate(ctx context.Context, client *api.Client, opts *options.UsersLoginsUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.logins.update", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"login_id":   opts.LoginID,
+	})
+
+	params := &api.UpdateLoginParams{}
+	if opts.UniqueIDSet {
+		params.UniqueID = &opts.UniqueID
+	}
+	if opts.PasswordSet {
+		params.Password = &opts.Password
+	}
+	if opts.OldPasswordSet {
+		params.OldPassword = &opts.OldPassword
+	}
+	if opts.SISUserIDSet {
+		params.SISUserID = &opts.SISUserID
+	}
+	if opts.IntegrationIDSet {
+		params.IntegrationID = &opts.IntegrationID
+	}
+	if opts.AuthProviderIDSet {
+		params.AuthenticationProviderID = &opts.AuthProviderID
+	}
+	if opts.StateSet {
+		params.WorkflowState = &opts.State
+	}
+
+	service := api.NewLoginsService(client)
+
+	login, err := service.Update(ctx, opts.AccountID, opts.LoginID, params)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.logins.update", err, map[string]interface{}{
+			"login_id": opts.LoginID,
+		})
+		return fmt.Errorf("failed to update login: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.logins.update", 1)
+	return formatSuccessOutput(login, fmt.Sprintf("Login updated successfully (ID: %d)", login.ID))
+}
+
+func runUsersLoginsDelete(ctx context.Context, client *api.Client, opts *options.UsersLoginsDeleteOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.logins.delete", map[string]interface{}{
+		"user_id":  opts.UserID,
+		"login_id": opts.LoginID,
+		"force":    opts.Force,
+	})
+
+	confirmed, err := confirmDelete("login", opts.LoginID, opts.Force)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.logins.delete", err, map[string]interface{}{})
+		return err
+	}
+	if !confirmed {
+		logger.LogCommandComplete(ctx, "users.logins.delete", 0)
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	service := api.NewLoginsService(client)
+
+	login, err := service.Delete(ctx, opts.UserID, opts.LoginID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.logins.delete", err, map[string]interface{}{
+			"user_id":  opts.UserID,
+			"login_id": opts.LoginID,
+		})
+		return fmt.Errorf("failed to delete login: %w", err)
+	}
+
+	fmt.Printf("Login %d (%s) deleted\n", login.ID, login.UniqueID)
+	logger.LogCommandComplete(ctx, "users.logins.delete", 1)
+	return nil
+}
+
+// loginUpdate is one validated row of a bulk login update CSV
+type loginUpdate struct {
+	Row       int
+	AccountID int64
+	LoginID   int64
+	Params    *api.UpdateLoginParams
+}
+
+func runUsersLoginsBulkUpdate(ctx context.Context, client *api.Client, opts *options.UsersLoginsBulkUpdateOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.logins.bulk_update", map[string]interface{}{
+		"account_id": opts.AccountID,
+		"csv_file":   opts.CSV,
+		"dry_run":    opts.DryRun,
+	})
+
+	updates, err := readLoginUpdatesCSV(opts.CSV, opts.AccountID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.logins.bulk_update", err, map[string]interface{}{
+			"csv_file": opts.CSV,
+		})
+		return err
+	}
+
+	if len(updates) == 0 {
+		return fmt.Errorf("no login updates found in CSV file")
+	}
+
+	printVerbose("Found %d login updates in CSV file\n\n", len(updates))
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+		fmt.Println()
+		fmt.Println("The following logins would be updated:")
+		for _, update := range updates {
+			fmt.Printf("%d. Login %d (account %d): %s\n", update.Row-1, update.LoginID, update.AccountID, describeLoginUpdate(update.Params))
+		}
+		logger.LogCommandComplete(ctx, "users.logins.bulk_update", 0)
+		return nil
+	}
+
+	service := api.NewLoginsService(client)
+
+	items := make([]interface{}, len(updates))
+	for i, update := range updates {
+		items[i] = update
+	}
+
+	processor := batch.New(opts.Concurrency, false, batch.NewConsoleProgress(time.Second))
+	summary, err := processor.Process(ctx, items, func(ctx context.Context, item interface{}) error {
+		update := item.(loginUpdate)
+		if _, err := service.Update(ctx, update.AccountID, update.LoginID, update.Params); err != nil {
+			return fmt.Errorf("row %d (login %d): %w", update.Row, update.LoginID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "users.logins.bulk_update", err, map[string]interface{}{})
+		return err
+	}
+
+	fmt.Printf("\nUpdated %d of %d logins\n", summary.Succeeded, summary.Total)
+	if summary.Failed > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range summary.Errors() {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, "users.logins.bulk_update", summary.Succeeded)
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("bulk login update completed with %d errors", summary.Failed)
+	}
+
+	return nil
+}
+
+// readLoginUpdatesCSV reads and validates every row of a login update CSV
+func readLoginUpdatesCSV(path string, defaultAccountID int64) ([]loginUpdate, error) {
+	records, err := batch.ReadCSV(path)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]loginUpdate, 0, len(records))
+	for i, record := range records {
+		row := i + 2 // Account for the header row
+
+		loginID, err := strconv.ParseInt(strings.TrimSpace(record["login_id"]), 10, 64)
+		if err != nil || loginID <= 0 {
+			return nil, fmt.Errorf("row %d: invalid login_id %q", row, record["login_id"])
+		}
+
+		accountID := defaultAccountID
+		if value := strings.TrimSpace(record["account_id"]); value != "" {
+			accountID, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid account_id %q", row, value)
+			}
+		}
+
+		params := &api.UpdateLoginParams{
+			UniqueID:                 csvField(record, "unique_id"),
+			Password:                 csvRawField(record, "password"),
+			SISUserID:                csvClearableField(record, "sis_user_id"),
+			IntegrationID:            csvClearableField(record, "integration_id"),
+			AuthenticationProviderID: csvField(record, "authentication_provider_id"),
+			WorkflowState:            csvField(record, "workflow_state"),
+		}
+
+		if describeLoginUpdate(params) == "" {
+			return nil, fmt.Errorf("row %d: no fields to update for login %d", row, loginID)
+		}
+		if params.WorkflowState != nil && *params.WorkflowState != "active" && *params.WorkflowState != "suspended" {
+			return nil, fmt.Errorf("row %d: invalid workflow_state %q (valid: active, suspended)", row, *params.WorkflowState)
+		}
+
+		updates = append(updates, loginUpdate{
+			Row:       row,
+			AccountID: accountID,
+			LoginID:   loginID,
+			Params:    params,
+		})
+	}
+
+	return updates, nil
+}
+
+// csvClearValue in a clearable CSV column clears the field
+const csvClearValue = "-"
+
+// csvField returns a pointer to a non-empty CSV value, or nil to leave the field unchanged
+func csvField(record batch.ExportRecord, column string) *string {
+	value := strings.TrimSpace(record[column])
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// csvClearableField is csvField for a field that can be cleared with csvClearValue
+func csvClearableField(record batch.ExportRecord, column string) *string {
+	value := csvField(record, column)
+	if value != nil && *value == csvClearValue {
+		cleared := ""
+		return &cleared
+	}
+	return value
+}
+
+// csvRawField is csvField without trimming, for values such as passwords
+// where surrounding spaces are significant
+func csvRawField(record batch.ExportRecord, column string) *string {
+	value := record[column]
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// describeLoginUpdate summarizes the fields an update changes, hiding passwords
+func describeLoginUpdate(params *api.UpdateLoginParams) string {
+	var changes []string
+	if params.UniqueID != nil {
+		changes = append(changes, "unique_id="+*params.UniqueID)
+	}
+	if params.SISUserID != nil {
+		changes = append(changes, "sis_user_id="+describeClearable(*params.SISUserID))
+	}
+	if params.IntegrationID != nil {
+		changes = append(changes, "integration_id="+describeClearable(*params.IntegrationID))
+	}
+	if params.AuthenticationProviderID != nil {
+		changes = append(changes, "authentication_provider_id="+*params.AuthenticationProviderID)
+	}
+	if params.WorkflowState != nil {
+		changes = append(changes, "workflow_state="+*params.WorkflowState)
+	}
+	if params.Password != nil {
+		changes = append(changes, "password=********")
+	}
+	return strings.Join(changes, ", ")
+}
+
+// describeClearable shows an empty value, which clears the field, as (cleared)
+func describeClearable(value string) string {
+	if value == "" {
+		return "(cleared)"
+	}
+	return value
+}
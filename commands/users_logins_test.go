@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestUsersLoginsBulkUpdateCmd(t *testing.T) {
+	dir := t.TempDir()
+	writeCSV := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write CSV: %v", err)
+		}
+		return path
+	}
+
+	valid := writeCSV("logins.csv", "login_id,sis_user_id,password\n456,S124,\n457,S125,secret\n")
+	noLoginID := writeCSV("missing.csv", "login_id,sis_user_id\n,S124\n")
+	noChanges := writeCSV("empty.csv", "login_id,sis_user_id\n456,\n")
+	clears := writeCSV("clear.csv", "login_id,sis_user_id,integration_id,password\n456,-,,\" spaced \"\n")
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "update logins",
+			Args: []string{"--csv", valid, "--account-id", "1"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1/logins/456": cmdtest.NewMockResponse(`{"id":456,"sis_user_id":"S124"}`),
+				"/api/v1/accounts/1/logins/457": cmdtest.NewMockResponse(`{"id":457,"sis_user_id":"S125"}`),
+			},
+			ExpectOutput: "Updated 2 of 2 logins",
+		},
+		{
+			Name: "dry run masks passwords",
+			Args: []string{"--csv", valid, "--account-id", "1", "--dry-run"},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "Login 457 (account 1): sis_user_id=S125, password=********") {
+					t.Errorf("Expected planned update, got: %s", output)
+				}
+				if strings.Contains(output, "secret") {
+					t.Errorf("Expected password to be masked, got: %s", output)
+				}
+			},
+		},
+		{
+			Name: "clear sis_user_id",
+			Args: []string{"--csv", clears, "--account-id", "1", "--dry-run"},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "Login 456 (account 1): sis_user_id=(cleared), password=********") {
+					t.Errorf("Expected sis_user_id to be cleared, got: %s", output)
+				}
+			},
+		},
+		{
+			Name:        "missing login_id",
+			Args:        []string{"--csv", noLoginID, "--account-id", "1"},
+			ExpectError: true,
+		},
+		{
+			Name:        "row without changes",
+			Args:        []string{"--csv", noChanges, "--account-id", "1"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newUsersLoginsBulkUpdateCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestReadLoginUpdatesCSV_ClearsAndKeepsPasswords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logins.csv")
+	content := "login_id,sis_user_id,integration_id,password\n456,-, INT-9 ,\" spaced \"\n"
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+
+	updates, err := readLoginUpdatesCSV(path, 1)
+	if err != nil {
+		t.Fatalf("readLoginUpdatesCSV failed: %v", err)
+	}
+
+	params := updates[0].Params
+	if params.SISUserID == nil || *params.SISUserID != "" {
+		t.Errorf("Expected sis_user_id to be cleared, got %v", params.SISUserID)
+	}
+	if params.IntegrationID == nil || *params.IntegrationID != "INT-9" {
+		t.Errorf("Expected trimmed integration_id, got %v", params.IntegrationID)
+	}
+	if params.Password == nil || *params.Password != " spaced " {
+		t.Errorf("Expected password to be kept as written, got %v", params.Password)
+	}
+}
+
+func TestUsersLoginsUpdateCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "update SIS user ID",
+			Args: []string{"456", "--account-id", "1", "--sis-user-id", "S124"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/accounts/1/logins/456": cmdtest.NewMockResponse(`{"id":456,"unique_id":"jdoe","sis_user_id":"S124"}`),
+			},
+			ExpectOutput: "Login updated successfully (ID: 456)",
+		},
+		{
+			Name:        "no fields",
+			Args:        []string{"456", "--account-id", "1"},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid state",
+			Args:        []string{"456", "--account-id", "1", "--state", "deleted"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newUsersLoginsUpdateCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
@@ -0,0 +1,527 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/logging"
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+	"github.com/jjuanrivvera/canvas-cli/internal/batch"
+)
+
+// usersObserveesCmd represents the users observees command group
+var usersObserveesCmd = &cobra.Command{
+	Use:     "observees",
+	Aliases: []string{"observers"},
+	Short:   "Manage observer pairings",
+	Long: `Manage the links between observers (usually parents) and the students they
+observe.
+
+Examples:
+  canvas users observees list 123
+  canvas users observees observers 456
+  canvas users observees add 123 --student-id 456
+  canvas users observees add 123 --pairing-code Ab3xYz
+  canvas users observees link --csv parents.csv --dry-run`,
+}
+
+func init() {
+	usersCmd.AddCommand(usersObserveesCmd)
+	usersObserveesCmd.AddCommand(newUsersObserveesListCmd())
+	usersObserveesCmd.AddCommand(newUsersObserversListCmd())
+	usersObserveesCmd.AddCommand(newUsersObserveesAddCmd())
+	usersObserveesCmd.AddCommand(newUsersObserveesRemoveCmd())
+	usersObserveesCmd.AddCommand(newUsersObserveesPairingCodeCmd())
+	usersObserveesCmd.AddCommand(newUsersObserveesLinkCmd())
+}
+
+func newUsersObserveesListCmd() *cobra.Command {
+	opts := &options.UsersObserveesListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <observer-id>",
+		Short: "List the students a user observes",
+		Long: `List the students a user observes.
+
+Examples:
+  canvas users observees list 123`,
+		Args: ExactArgsWithUsage(1, "observer-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.UserID = userID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersObserveesList(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newUsersObserversListCmd() *cobra.Command {
+	opts := &options.UsersObserversListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "observers <student-id>",
+		Short: "List the observers of a student",
+		Long: `List the users observing a student.
+
+Examples:
+  canvas users observees observers 456`,
+		Args: ExactArgsWithUsage(1, "student-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			studentID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.StudentID = studentID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersObserversList(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newUsersObserveesAddCmd() *cobra.Command {
+	opts := &options.UsersObserveesAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <observer-id>",
+		Short: "Link an observer to a student",
+		Long: `Link an observer to a student, by student ID or with a pairing code the
+student generated.
+
+Examples:
+  canvas users observees add 123 --student-id 456
+  canvas users observees add 123 --pairing-code Ab3xYz`,
+		Args: ExactArgsWithUsage(1, "observer-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			observerID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.ObserverID = observerID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersObserveesAdd(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.StudentID, "student-id", 0, "Student to observe")
+	cmd.Flags().StringVar(&opts.PairingCode, "pairing-code", "", "Pairing code generated by the student")
+
+	return cmd
+}
+
+func newUsersObserveesRemoveCmd() *cobra.Command {
+	opts := &options.UsersObserveesRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <observer-id>",
+		Short: "Unlink an observer from a student",
+		Long: `Unlink an observer from a student.
+
+Examples:
+  canvas users observees remove 123 --student-id 456
+  canvas users observees remove 123 --student-id 456 --root-account-id 1 --force`,
+		Args: ExactArgsWithUsage(1, "observer-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			observerID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.ObserverID = observerID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersObserveesRemove(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.StudentID, "student-id", 0, "Student to stop observing (required)")
+	cmd.Flags().Int64Var(&opts.RootAccountID, "root-account-id", 0, "Only remove the link in this root account")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	cmd.MarkFlagRequired("student-id")
+
+	return cmd
+}
+
+func newUsersObserveesPairingCodeCmd() *cobra.Command {
+	opts := &options.UsersObserveesPairingCodeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "pairing-code <student-id>",
+		Short: "Generate a pairing code for a student",
+		Long: `Generate a code an observer can use to link to a student. Codes expire
+after a few days.
+
+Examples:
+  canvas users observees pairing-code 456`,
+		Args: ExactArgsWithUsage(1, "student-id"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			studentID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user ID: %s", args[0])
+			}
+			opts.StudentID = studentID
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersObserveesPairingCode(cmd.Context(), client, opts)
+		},
+	}
+
+	return cmd
+}
+
+func newUsersObserveesLinkCmd() *cobra.Command {
+	opts := &options.UsersObserveesLinkOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "link",
+		Short: "Link observers to students from a CSV file",
+		Long: `Link many observers to students from a CSV file. Pairs that are already
+linked are left as they are, so a full SIS export can be replayed to repair
+missing links. Every row is validated before any link is made.
+
+IDs are Canvas user IDs or SIS-style IDs such as sis_user_id:S123, so links
+can be loaded straight from an SIS feed.
+
+CSV Format:
+  observer_id,student_id
+  123,456
+  sis_user_id:P100,sis_user_id:S200
+
+Examples:
+  canvas users observees link --csv parents.csv --dry-run
+  canvas users observees link --csv parents.csv --concurrency 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			client, err := getAPIClient()
+			if err != nil {
+				return err
+			}
+
+			return runUsersObserveesLink(cmd.Context(), client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.CSV, "csv", "", "CSV file with observer_id and student_id columns (required)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of links made in parallel")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be linked without making changes")
+	cmd.MarkFlagRequired("csv")
+
+	return cmd
+}
+
+func runUsersObserveesList(ctx context.Context, client *api.Client, opts *options.UsersObserveesListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.observees.list", map[string]interface{}{
+		"user_id": opts.UserID,
+	})
+
+	service := api.NewObserveesService(client)
+
+	users, err := service.ListObservees(ctx, opts.UserID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.observees.list", err, map[string]interface{}{
+			"user_id": opts.UserID,
+		})
+		return fmt.Errorf("failed to list observees: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.observees.list", len(users))
+	return formatEmptyOrOutput(users, fmt.Sprintf("User %d observes no students", opts.UserID))
+}
+
+func runUsersObserversList(ctx context.Context, client *api.Client, opts *options.UsersObserversListOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.observees.observers", map[string]interface{}{
+		"student_id": opts.StudentID,
+	})
+
+	service := api.NewObserveesService(client)
+
+	users, err := service.ListObservers(ctx, opts.StudentID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.observees.observers", err, map[string]interface{}{
+			"student_id": opts.StudentID,
+		})
+		return fmt.Errorf("failed to list observers: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.observees.observers", len(users))
+	return formatEmptyOrOutput(users, fmt.Sprintf("Student %d has no observers", opts.StudentID))
+}
+
+func runUsersObserveesAdd(ctx context.Context, client *api.Client, opts *options.UsersObserveesAddOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.observees.add", map[string]interface{}{
+		"observer_id":  opts.ObserverID,
+		"student_id":   opts.StudentID,
+		"pairing_code": opts.PairingCode != "",
+	})
+
+	service := api.NewObserveesService(client)
+
+	var student *api.User
+	var err error
+	if opts.PairingCode != "" {
+		student, err = service.AddObserveeWithPairingCode(ctx, opts.ObserverID, opts.PairingCode)
+	} else {
+		student, err = service.AddObservee(ctx, opts.ObserverID, opts.StudentID)
+	}
+	if err != nil {
+		logger.LogCommandError(ctx, "users.observees.add", err, map[string]interface{}{
+			"observer_id": opts.ObserverID,
+			"student_id":  opts.StudentID,
+		})
+		return fmt.Errorf("failed to add observee: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.observees.add", 1)
+	return formatSuccessOutput(student, fmt.Sprintf("User %d now observes %s (ID: %d)", opts.ObserverID, student.Name, student.ID))
+}
+
+func runUsersObserveesRemove(ctx context.Context, client *api.Client, opts *options.UsersObserveesRemoveOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.observees.remove", map[string]interface{}{
+		"observer_id": opts.ObserverID,
+		"student_id":  opts.StudentID,
+		"force":       opts.Force,
+	})
+
+	confirmed, err := confirmDelete("the link from observer", fmt.Sprintf("%d to student %d", opts.ObserverID, opts.StudentID), opts.Force)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.observees.remove", err, map[string]interface{}{})
+		return err
+	}
+	if !confirmed {
+		logger.LogCommandComplete(ctx, "users.observees.remove", 0)
+		fmt.Println("Remove cancelled")
+		return nil
+	}
+
+	service := api.NewObserveesService(client)
+
+	if _, err := service.RemoveObservee(ctx, opts.ObserverID, opts.StudentID, opts.RootAccountID); err != nil {
+		logger.LogCommandError(ctx, "users.observees.remove", err, map[string]interface{}{
+			"observer_id": opts.ObserverID,
+			"student_id":  opts.StudentID,
+		})
+		return fmt.Errorf("failed to remove observee: %w", err)
+	}
+
+	fmt.Printf("User %d no longer observes student %d\n", opts.ObserverID, opts.StudentID)
+	logger.LogCommandComplete(ctx, "users.observees.remove", 1)
+	return nil
+}
+
+func runUsersObserveesPairingCode(ctx context.Context, client *api.Client, opts *options.UsersObserveesPairingCodeOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.observees.pairing_code", map[string]interface{}{
+		"student_id": opts.StudentID,
+	})
+
+	service := api.NewObserveesService(client)
+
+	code, err := service.CreatePairingCode(ctx, opts.StudentID)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.observees.pairing_code", err, map[string]interface{}{
+			"student_id": opts.StudentID,
+		})
+		return fmt.Errorf("failed to create pairing code: %w", err)
+	}
+
+	logger.LogCommandComplete(ctx, "users.observees.pairing_code", 1)
+	return formatSuccessOutput(code, fmt.Sprintf("Pairing code for student %d: %s", opts.StudentID, code.Code))
+}
+
+func runUsersObserveesLink(ctx context.Context, client *api.Client, opts *options.UsersObserveesLinkOptions) error {
+	logger := logging.NewCommandLogger(verbose)
+	logger.LogCommandStart(ctx, "users.observees.link", map[string]interface{}{
+		"csv_file": opts.CSV,
+		"dry_run":  opts.DryRun,
+	})
+
+	links, err := readObserveeLinksCSV(opts.CSV)
+	if err != nil {
+		logger.LogCommandError(ctx, "users.observees.link", err, map[string]interface{}{
+			"csv_file": opts.CSV,
+		})
+		return err
+	}
+
+	if len(links) == 0 {
+		return fmt.Errorf("no observer links found in CSV file")
+	}
+
+	printVerbose("Found %d observer links in CSV file\n\n", len(links))
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN - No changes will be applied")
+		fmt.Println()
+		fmt.Println("The following links would be made:")
+		for _, link := range links {
+			fmt.Printf("%d. Observer %s -> student %s\n", link.Row-1, link.ObserverID, link.StudentID)
+		}
+		logger.LogCommandComplete(ctx, "users.observees.link", 0)
+		return nil
+	}
+
+	service := api.NewObserveesService(client)
+
+	items := make([]interface{}, len(links))
+	for i, link := range links {
+		items[i] = link
+	}
+
+	processor := batch.New(opts.Concurrency, false, batch.NewConsoleProgress(time.Second))
+	summary, err := processor.Process(ctx, items, func(ctx context.Context, item interface{}) error {
+		link := item.(observeeLink)
+		if _, err := service.AddObserveeByRef(ctx, link.ObserverID, link.StudentID); err != nil {
+			return fmt.Errorf("row %d (observer %s, student %s): %w", link.Row, link.ObserverID, link.StudentID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.LogCommandError(ctx, "users.observees.link", err, map[string]interface{}{})
+		return err
+	}
+
+	fmt.Printf("\nLinked %d of %d observer pairs\n", summary.Succeeded, summary.Total)
+	if summary.Failed > 0 {
+		fmt.Printf("\nErrors:\n")
+		for _, err := range summary.Errors() {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	logger.LogCommandComplete(ctx, "users.observees.link", summary.Succeeded)
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("observer linking completed with %d errors", summary.Failed)
+	}
+
+	return nil
+}
+
+// observeeLink is one validated row of an observer link CSV. IDs are Canvas
+// user IDs or SIS-style IDs, passed to the API as they are.
+type observeeLink struct {
+	Row        int
+	ObserverID string
+	StudentID  string
+}
+
+// sisUserIDPrefixes are the SIS-style prefixes accepted in place of a Canvas user ID
+var sisUserIDPrefixes = []string{"sis_user_id:", "sis_login_id:", "sis_integration_id:"}
+
+// parseUserRef validates a Canvas user ID or SIS-style user ID
+func parseUserRef(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+
+	for _, prefix := range sisUserIDPrefixes {
+		if strings.HasPrefix(value, prefix) {
+			return value, len(value) > len(prefix)
+		}
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	return value, err == nil && id > 0
+}
+
+// readObserveeLinksCSV reads and validates every row of an observer link CSV,
+// skipping repeated pairs
+func readObserveeLinksCSV(path string) ([]observeeLink, error) {
+	records, err := batch.ReadCSV(path)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]observeeLink, 0, len(records))
+	seen := make(map[[2]string]bool, len(records))
+	for i, record := range records {
+		row := i + 2 // Account for the header row
+
+		observerID, ok := parseUserRef(record["observer_id"])
+		if !ok {
+			return nil, fmt.Errorf("row %d: invalid observer_id %q", row, record["observer_id"])
+		}
+
+		studentID, ok := parseUserRef(record["student_id"])
+		if !ok {
+			return nil, fmt.Errorf("row %d: invalid student_id %q", row, record["student_id"])
+		}
+
+		if observerID == studentID {
+			return nil, fmt.Errorf("row %d: user %s cannot observe themselves", row, observerID)
+		}
+
+		pair := [2]string{observerID, studentID}
+		if seen[pair] {
+			continue
+		}
+		seen[pair] = true
+
+		links = append(links, observeeLink{
+			Row:        row,
+			ObserverID: observerID,
+			StudentID:  studentID,
+		})
+	}
+
+	return links, nil
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdtest "github.com/jjuanrivvera/canvas-cli/commands/internal/testing"
+)
+
+func TestUsersObserveesLinkCmd(t *testing.T) {
+	dir := t.TempDir()
+	writeCSV := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write CSV: %v", err)
+		}
+		return path
+	}
+
+	valid := writeCSV("links.csv", "observer_id,student_id\n123,456\n123,457\n123,456\n")
+	badStudent := writeCSV("bad.csv", "observer_id,student_id\n123,abc\n")
+	self := writeCSV("self.csv", "observer_id,student_id\n123,123\n")
+	sisIDs := writeCSV("sis.csv", "observer_id,student_id\nsis_user_id:P100,sis_user_id:S200\n")
+	emptySIS := writeCSV("empty_sis.csv", "observer_id,student_id\n123,sis_user_id:\n")
+
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "link observers",
+			Args: []string{"--csv", valid},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/users/123/observees/456": cmdtest.NewMockResponse(`{"id":456,"name":"Student One"}`),
+				"/api/v1/users/123/observees/457": cmdtest.NewMockResponse(`{"id":457,"name":"Student Two"}`),
+			},
+			ExpectOutput: "Linked 2 of 2 observer pairs",
+		},
+		{
+			Name: "dry run",
+			Args: []string{"--csv", valid, "--dry-run"},
+			ValidateOutput: func(t *testing.T, output string) {
+				if !strings.Contains(output, "DRY RUN") || !strings.Contains(output, "Observer 123 -> student 457") {
+					t.Errorf("Unexpected dry run output: %s", output)
+				}
+			},
+		},
+		{
+			Name: "failed link",
+			Args: []string{"--csv", valid},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/users/123/observees/456": cmdtest.NewMockResponse(`{"id":456,"name":"Student One"}`),
+				"/api/v1/users/123/observees/457": {StatusCode: 404, Body: `{"errors":[{"message":"not found"}]}`},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "link by SIS user ID",
+			Args: []string{"--csv", sisIDs},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/users/sis_user_id:P100/observees/sis_user_id:S200": cmdtest.NewMockResponse(`{"id":456,"name":"Student One"}`),
+			},
+			ExpectOutput: "Linked 1 of 1 observer pairs",
+		},
+		{
+			Name:        "empty SIS user ID",
+			Args:        []string{"--csv", emptySIS},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid student ID",
+			Args:        []string{"--csv", badStudent},
+			ExpectError: true,
+		},
+		{
+			Name:        "self observation",
+			Args:        []string{"--csv", self},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newUsersObserveesLinkCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
+
+func TestUsersObserveesAddCmd(t *testing.T) {
+	tests := []cmdtest.CommandTestCase{
+		{
+			Name: "add by pairing code",
+			Args: []string{"123", "--pairing-code", "Ab3xYz"},
+			MockResponses: map[string]cmdtest.MockResponse{
+				"/api/v1/users/123/observees": cmdtest.NewMockResponse(`{"id":456,"name":"Student One"}`),
+			},
+			ExpectOutput: "User 123 now observes Student One (ID: 456)",
+		},
+		{
+			Name:        "student and pairing code are exclusive",
+			Args:        []string{"123", "--student-id", "456", "--pairing-code", "Ab3xYz"},
+			ExpectError: true,
+		},
+		{
+			Name:        "student or pairing code required",
+			Args:        []string{"123"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmd := newUsersObserveesAddCmd()
+			cmdtest.RunCommandTest(t, cmd, tc)
+		})
+	}
+}
@@ -56,6 +56,34 @@ func TestUsersListCmd(t *testing.T) {
 	}
 }
 
+func TestUsersListCmd_NDJSON(t *testing.T) {
+	oldFormat := outputFormat
+	outputFormat = "ndjson"
+	defer func() { outputFormat = oldFormat }()
+
+	tc := cmdtest.CommandTestCase{
+		Name: "stream users as ndjson",
+		Args: []string{"--course-id", "1"},
+		MockResponses: map[string]cmdtest.MockResponse{
+			"/api/v1/courses/1/users": cmdtest.NewMockResponse(`[
+				{"id": 1, "name": "John Doe"},
+				{"id": 2, "name": "Jane Doe"}
+			]`),
+		},
+		ValidateOutput: func(t *testing.T, output string) {
+			lines := strings.Split(strings.TrimSpace(output), "\n")
+			if len(lines) != 2 {
+				t.Fatalf("Expected 2 ndjson lines, got %d: %q", len(lines), output)
+			}
+			if !strings.HasPrefix(lines[0], `{"id":1,`) || !strings.Contains(lines[1], "Jane Doe") {
+				t.Errorf("Unexpected ndjson output: %q", output)
+			}
+		},
+	}
+
+	cmdtest.RunCommandTest(t, newUsersListCmd(), tc)
+}
+
 func TestUsersGetCmd(t *testing.T) {
 	tests := []cmdtest.CommandTestCase{
 		{
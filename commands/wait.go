@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/jjuanrivvera/canvas-cli/commands/internal/options"
+	"github.com/jjuanrivvera/canvas-cli/internal/api"
+)
+
+// defaultWaitTimeout is how long --wait blocks before giving up
+const defaultWaitTimeout = 30 * time.Minute
+
+// progressBarWidth is the number of cells in the rendered progress bar
+const progressBarWidth = 30
+
+// addWaitFlags registers the shared --wait and --timeout flags
+func addWaitFlags(cmd *cobra.Command, opts *options.WaitOptions) {
+	cmd.Flags().BoolVar(&opts.Wait, "wait", false, "Wait for the job to finish and exit non-zero if it fails")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", defaultWaitTimeout, "Maximum time to wait when --wait is set (e.g. 90s, 10m)")
+}
+
+// waitForJob polls an asynchronous job until it finishes, rendering a live
+// progress bar on stderr. Returns an error if the job fails or times out.
+func waitForJob(ctx context.Context, opts options.WaitOptions, fetch api.ProgressFetcher) (*api.JobProgress, error) {
+	// Polling must always observe fresh state, never a cached response
+	ctx = api.WithoutCache(ctx)
+
+	bar := newProgressBar(os.Stderr)
+	progress, err := api.PollProgress(ctx, &api.WaitOptions{
+		Timeout:  opts.Timeout,
+		OnUpdate: bar.Update,
+	}, fetch)
+	bar.Finish()
+
+	return progress, err
+}
+
+// progressBar renders job progress to a writer. On a terminal the bar is
+// redrawn in place; otherwise a line is written whenever the state changes.
+type progressBar struct {
+	w         io.Writer
+	tty       bool
+	lastState string
+	drawn     bool
+}
+
+// newProgressBar creates a progress bar that writes to w
+func newProgressBar(w *os.File) *progressBar {
+	return &progressBar{
+		w:   w,
+		tty: term.IsTerminal(int(w.Fd())),
+	}
+}
+
+// Update renders the latest job state
+func (b *progressBar) Update(p *api.JobProgress) {
+	status := p.WorkflowState
+	if p.Message != "" && p.Message != p.WorkflowState {
+		status += ": " + p.Message
+	}
+
+	if !b.tty {
+		if status != b.lastState {
+			fmt.Fprintf(b.w, "Job %d: %s (%.0f%%)\n", p.ID, status, p.Completion)
+			b.lastState = status
+		}
+		return
+	}
+
+	completion := p.Completion
+	if completion < 0 {
+		completion = 0
+	}
+	if completion > 100 {
+		completion = 100
+	}
+
+	filled := int(completion / 100 * progressBarWidth)
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+
+	fmt.Fprintf(b.w, "\r\033[K[%s] %3.0f%% %s", bar, completion, status)
+	b.drawn = true
+}
+
+// Finish terminates the in-place bar so later output starts on a new line
+func (b *progressBar) Finish() {
+	if b.tty && b.drawn {
+		fmt.Fprintln(b.w)
+	}
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// AccountReportsService handles account report API calls
+type AccountReportsService struct {
+	client *Client
+}
+
+// NewAccountReportsService creates a new account reports service
+func NewAccountReportsService(client *Client) *AccountReportsService {
+	return &AccountReportsService{client: client}
+}
+
+// ReportType describes a report that can be run on an account
+type ReportType struct {
+	Report     string                     `json:"report"`
+	Title      string                     `json:"title"`
+	Parameters map[string]ReportParameter `json:"parameters,omitempty"`
+	LastRun    *AccountReport             `json:"last_run,omitempty"`
+}
+
+// ReportParameter describes a parameter accepted by a report
+type ReportParameter struct {
+	Required    bool   `json:"required"`
+	Description string `json:"description"`
+}
+
+// AccountReport represents one run of an account report
+type AccountReport struct {
+	ID          int64                  `json:"id"`
+	Report      string                 `json:"report"`
+	FileURL     string                 `json:"file_url,omitempty"`
+	Attachment  *Attachment            `json:"attachment,omitempty"`
+	Status      string                 `json:"status"`
+	CreatedAt   string                 `json:"created_at,omitempty"`
+	StartedAt   string                 `json:"started_at,omitempty"`
+	EndedAt     string                 `json:"ended_at,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+	Progress    float64                `json:"progress"`
+	CurrentLine int64                  `json:"current_line,omitempty"`
+	Message     string                 `json:"message,omitempty"`
+}
+
+// ListAvailable retrieves the reports that can be run on an account
+func (s *AccountReportsService) ListAvailable(ctx context.Context, accountID int64) ([]ReportType, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/reports", accountID)
+
+	var reports []ReportType
+	if err := s.client.GetAllPages(ctx, path, &reports); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// List retrieves previous runs of a report, most recent first
+func (s *AccountReportsService) List(ctx context.Context, accountID int64, report string) ([]AccountReport, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/reports/%s", accountID, url.PathEscape(report))
+
+	var reports []AccountReport
+	if err := s.client.GetAllPages(ctx, path, &reports); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// Start starts a report. Parameters are report specific, e.g.
+// enrollment_term_id, users or include_deleted.
+func (s *AccountReportsService) Start(ctx context.Context, accountID int64, report string, parameters map[string]interface{}) (*AccountReport, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/reports/%s", accountID, url.PathEscape(report))
+
+	body := map[string]interface{}{}
+	if len(parameters) > 0 {
+		body["parameters"] = parameters
+	}
+
+	var result AccountReport
+	if err := s.client.PostJSON(ctx, path, body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves the status of a report run
+func (s *AccountReportsService) Get(ctx context.Context, accountID int64, report string, reportID int64) (*AccountReport, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/reports/%s/%d", accountID, url.PathEscape(report), reportID)
+
+	var result AccountReport
+	if err := s.client.GetJSON(ctx, path, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a report run and its file
+func (s *AccountReportsService) Delete(ctx context.Context, accountID int64, report string, reportID int64) (*AccountReport, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/reports/%s/%d", accountID, url.PathEscape(report), reportID)
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result AccountReport
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Download saves the file of a finished report to destPath
+func (s *AccountReportsService) Download(ctx context.Context, report *AccountReport, destPath string) error {
+	if report.Attachment == nil || report.Attachment.ID == 0 {
+		return fmt.Errorf("report %d has no file (status: %s)", report.ID, report.Status)
+	}
+
+	return NewFilesService(s.client).Download(ctx, report.Attachment.ID, destPath)
+}
+
+// ToProgress converts the report status into a JobProgress so it can be
+// polled with PollProgress like other asynchronous jobs
+func (r *AccountReport) ToProgress() *JobProgress {
+	state := ProgressStateRunning
+	switch r.Status {
+	case "complete":
+		state = ProgressStateCompleted
+	case "error", "aborted", "deleted":
+		state = ProgressStateFailed
+	case "created":
+		state = ProgressStateQueued
+	}
+
+	message := r.Status
+	if r.Message != "" && state == ProgressStateFailed {
+		message = r.Message
+	}
+
+	return &JobProgress{
+		ID:            r.ID,
+		ContextType:   "AccountReport",
+		Tag:           r.Report,
+		Completion:    r.Progress,
+		WorkflowState: state,
+		Message:       message,
+		CreatedAt:     r.CreatedAt,
+	}
+}
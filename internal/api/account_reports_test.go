@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAccountReportsService_Start(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/accounts/1/reports/provisioning_csv" {
+			t.Errorf("Expected POST /api/v1/accounts/1/reports/provisioning_csv, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			Parameters map[string]interface{} `json:"parameters"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		if body.Parameters["users"] != true {
+			t.Errorf("Expected users=true, got %v", body.Parameters["users"])
+		}
+		if body.Parameters["enrollment_term_id"] != float64(7) {
+			t.Errorf("Expected enrollment_term_id=7, got %v", body.Parameters["enrollment_term_id"])
+		}
+
+		w.Write([]byte(`{"id":42,"report":"provisioning_csv","status":"created","progress":0}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewAccountReportsService(client)
+	report, err := service.Start(context.Background(), 1, "provisioning_csv", map[string]interface{}{
+		"users":              true,
+		"enrollment_term_id": 7,
+	})
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	if report.ID != 42 || report.Status != "created" {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+}
+
+func TestAccountReportsService_Download(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/accounts":
+			handleVersionDetection(w)
+		case "/api/v1/files/9":
+			fmt.Fprintf(w, `{"id":9,"display_name":"provisioning.csv","url":"%s/files/9/download"}`, server.URL)
+		case "/files/9/download":
+			w.Write([]byte("canvas_user_id,user_id\n1,u1\n"))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewAccountReportsService(client)
+	dest := filepath.Join(t.TempDir(), "report.csv")
+
+	report := &AccountReport{ID: 42, Status: "complete", Attachment: &Attachment{ID: 9}}
+	if err := service.Download(context.Background(), report, dest); err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+
+	data, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	if string(data) != "canvas_user_id,user_id\n1,u1\n" {
+		t.Errorf("Unexpected report content: %q", data)
+	}
+
+	if err := service.Download(context.Background(), &AccountReport{ID: 43, Status: "error"}, dest); err == nil {
+		t.Error("Expected error for report without a file")
+	}
+}
+
+func TestAccountReport_ToProgress(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{"created", ProgressStateQueued},
+		{"running", ProgressStateRunning},
+		{"compiling", ProgressStateRunning},
+		{"complete", ProgressStateCompleted},
+		{"error", ProgressStateFailed},
+		{"aborted", ProgressStateFailed},
+	}
+
+	for _, tt := range tests {
+		report := &AccountReport{ID: 1, Status: tt.status}
+		if got := report.ToProgress().WorkflowState; got != tt.want {
+			t.Errorf("Status %q: expected %q, got %q", tt.status, tt.want, got)
+		}
+	}
+}
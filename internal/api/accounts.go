@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -128,8 +129,24 @@ type ListAccountCoursesOptions struct {
 // ListCourses returns courses for a given account
 // This requires admin permissions on the account
 func (s *AccountsService) ListCourses(ctx context.Context, accountID int64, opts *ListAccountCoursesOptions) ([]Course, error) {
+	path := accountCoursesPath(accountID, opts)
+
 	var courses []Course
+	err := s.client.GetAllPages(ctx, path, &courses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account courses: %w", err)
+	}
+
+	return courses, nil
+}
 
+// ListCoursesIter streams courses for a given account page by page
+func (s *AccountsService) ListCoursesIter(ctx context.Context, accountID int64, opts *ListAccountCoursesOptions) iter.Seq2[Course, error] {
+	return Pages[Course](s.client, ctx, accountCoursesPath(accountID, opts))
+}
+
+// accountCoursesPath builds the account courses list path with query parameters
+func accountCoursesPath(accountID int64, opts *ListAccountCoursesOptions) string {
 	path := fmt.Sprintf("/api/v1/accounts/%d/courses", accountID)
 	if opts != nil {
 		query := url.Values{}
@@ -183,12 +200,7 @@ func (s *AccountsService) ListCourses(ctx context.Context, accountID int64, opts
 		}
 	}
 
-	err := s.client.GetAllPages(ctx, path, &courses)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list account courses: %w", err)
-	}
-
-	return courses, nil
+	return path
 }
 
 // ListAccountUsersOptions holds options for listing users in an account
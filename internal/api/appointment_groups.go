@@ -0,0 +1,349 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AppointmentGroupsService handles appointment group (Scheduler) API calls
+type AppointmentGroupsService struct {
+	client *Client
+}
+
+// NewAppointmentGroupsService creates a new appointment groups service
+func NewAppointmentGroupsService(client *Client) *AppointmentGroupsService {
+	return &AppointmentGroupsService{client: client}
+}
+
+// AppointmentGroup represents a set of time slots participants can sign up for
+type AppointmentGroup struct {
+	ID                            int64           `json:"id"`
+	Title                         string          `json:"title"`
+	Description                   string          `json:"description,omitempty"`
+	StartAt                       *time.Time      `json:"start_at,omitempty"`
+	EndAt                         *time.Time      `json:"end_at,omitempty"`
+	LocationName                  string          `json:"location_name,omitempty"`
+	LocationAddress               string          `json:"location_address,omitempty"`
+	ContextCodes                  []string        `json:"context_codes,omitempty"`
+	SubContextCodes               []string        `json:"sub_context_codes,omitempty"`
+	WorkflowState                 string          `json:"workflow_state"`
+	RequiringAction               bool            `json:"requiring_action"`
+	AppointmentsCount             int             `json:"appointments_count"`
+	Appointments                  []CalendarEvent `json:"appointments,omitempty"`
+	ParticipantCount              int             `json:"participant_count,omitempty"`
+	ParticipantsPerAppointment    *int            `json:"participants_per_appointment,omitempty"`
+	MinAppointmentsPerParticipant *int            `json:"min_appointments_per_participant,omitempty"`
+	MaxAppointmentsPerParticipant *int            `json:"max_appointments_per_participant,omitempty"`
+	ParticipantVisibility         string          `json:"participant_visibility,omitempty"`
+	ParticipantType               string          `json:"participant_type,omitempty"`
+	URL                           string          `json:"url,omitempty"`
+	HTMLURL                       string          `json:"html_url,omitempty"`
+	CreatedAt                     time.Time       `json:"created_at"`
+	UpdatedAt                     time.Time       `json:"updated_at"`
+}
+
+// AppointmentSlot is a time slot of an appointment group
+type AppointmentSlot struct {
+	StartAt time.Time
+	EndAt   time.Time
+}
+
+// ListAppointmentGroupsOptions holds options for listing appointment groups
+type ListAppointmentGroupsOptions struct {
+	Scope        string   // reservable (default), manageable
+	ContextCodes []string // course_123
+	IncludePast  bool
+	Include      []string // appointments, child_events, participant_count, reserved_times, all_context_codes
+}
+
+// List retrieves the appointment groups the current user can reserve or manage
+func (s *AppointmentGroupsService) List(ctx context.Context, opts *ListAppointmentGroupsOptions) ([]AppointmentGroup, error) {
+	path := "/api/v1/appointment_groups"
+
+	if opts != nil {
+		query := url.Values{}
+
+		if opts.Scope != "" {
+			query.Add("scope", opts.Scope)
+		}
+
+		for _, code := range opts.ContextCodes {
+			query.Add("context_codes[]", code)
+		}
+
+		if opts.IncludePast {
+			query.Add("include_past_appointments", "true")
+		}
+
+		for _, inc := range opts.Include {
+			query.Add("include[]", inc)
+		}
+
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	var groups []AppointmentGroup
+	if err := s.client.GetAllPages(ctx, path, &groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// Get retrieves a single appointment group
+func (s *AppointmentGroupsService) Get(ctx context.Context, groupID int64, include []string) (*AppointmentGroup, error) {
+	path := fmt.Sprintf("/api/v1/appointment_groups/%d", groupID)
+
+	if len(include) > 0 {
+		query := url.Values{}
+		for _, inc := range include {
+			query.Add("include[]", inc)
+		}
+		path += "?" + query.Encode()
+	}
+
+	var group AppointmentGroup
+	if err := s.client.GetJSON(ctx, path, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// CreateAppointmentGroupParams holds parameters for creating an appointment group
+type CreateAppointmentGroupParams struct {
+	ContextCodes                  []string // Required: course_123
+	SubContextCodes               []string // course_section_456 or group_category_789
+	Title                         string
+	Description                   string
+	LocationName                  string
+	LocationAddress               string
+	Publish                       bool
+	ParticipantsPerAppointment    int
+	MinAppointmentsPerParticipant int
+	MaxAppointmentsPerParticipant int
+	ParticipantVisibility         string // private, protected
+	Slots                         []AppointmentSlot
+}
+
+// Create creates an appointment group with its time slots
+func (s *AppointmentGroupsService) Create(ctx context.Context, params *CreateAppointmentGroupParams) (*AppointmentGroup, error) {
+	groupData := map[string]interface{}{
+		"context_codes": params.ContextCodes,
+		"title":         params.Title,
+	}
+
+	if len(params.SubContextCodes) > 0 {
+		groupData["sub_context_codes"] = params.SubContextCodes
+	}
+
+	if params.Description != "" {
+		groupData["description"] = params.Description
+	}
+
+	if params.LocationName != "" {
+		groupData["location_name"] = params.LocationName
+	}
+
+	if params.LocationAddress != "" {
+		groupData["location_address"] = params.LocationAddress
+	}
+
+	if params.Publish {
+		groupData["publish"] = true
+	}
+
+	if params.ParticipantsPerAppointment > 0 {
+		groupData["participants_per_appointment"] = params.ParticipantsPerAppointment
+	}
+
+	if params.MinAppointmentsPerParticipant > 0 {
+		groupData["min_appointments_per_participant"] = params.MinAppointmentsPerParticipant
+	}
+
+	if params.MaxAppointmentsPerParticipant > 0 {
+		groupData["max_appointments_per_participant"] = params.MaxAppointmentsPerParticipant
+	}
+
+	if params.ParticipantVisibility != "" {
+		groupData["participant_visibility"] = params.ParticipantVisibility
+	}
+
+	if len(params.Slots) > 0 {
+		groupData["new_appointments"] = newAppointmentsBody(params.Slots)
+	}
+
+	body := map[string]interface{}{
+		"appointment_group": groupData,
+	}
+
+	var group AppointmentGroup
+	if err := s.client.PostJSON(ctx, "/api/v1/appointment_groups", body, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// UpdateAppointmentGroupParams holds parameters for updating an appointment group.
+// Nil fields are left unchanged; Slots are added to the existing ones.
+type UpdateAppointmentGroupParams struct {
+	Title                         *string
+	Description                   *string
+	LocationName                  *string
+	LocationAddress               *string
+	Publish                       bool
+	ParticipantsPerAppointment    *int
+	MaxAppointmentsPerParticipant *int
+	ParticipantVisibility         *string
+	Slots                         []AppointmentSlot
+}
+
+// Update updates an appointment group
+func (s *AppointmentGroupsService) Update(ctx context.Context, groupID int64, params *UpdateAppointmentGroupParams) (*AppointmentGroup, error) {
+	path := fmt.Sprintf("/api/v1/appointment_groups/%d", groupID)
+
+	groupData := make(map[string]interface{})
+
+	if params.Title != nil {
+		groupData["title"] = *params.Title
+	}
+
+	if params.Description != nil {
+		groupData["description"] = *params.Description
+	}
+
+	if params.LocationName != nil {
+		groupData["location_name"] = *params.LocationName
+	}
+
+	if params.LocationAddress != nil {
+		groupData["location_address"] = *params.LocationAddress
+	}
+
+	if params.Publish {
+		groupData["publish"] = true
+	}
+
+	if params.ParticipantsPerAppointment != nil {
+		groupData["participants_per_appointment"] = *params.ParticipantsPerAppointment
+	}
+
+	if params.MaxAppointmentsPerParticipant != nil {
+		groupData["max_appointments_per_participant"] = *params.MaxAppointmentsPerParticipant
+	}
+
+	if params.ParticipantVisibility != nil {
+		groupData["participant_visibility"] = *params.ParticipantVisibility
+	}
+
+	if len(params.Slots) > 0 {
+		groupData["new_appointments"] = newAppointmentsBody(params.Slots)
+	}
+
+	body := map[string]interface{}{
+		"appointment_group": groupData,
+	}
+
+	var group AppointmentGroup
+	if err := s.client.PutJSON(ctx, path, body, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// Delete deletes an appointment group, cancelling its reservations
+func (s *AppointmentGroupsService) Delete(ctx context.Context, groupID int64, cancelReason string) (*AppointmentGroup, error) {
+	path := fmt.Sprintf("/api/v1/appointment_groups/%d", groupID)
+
+	if cancelReason != "" {
+		path += "?cancel_reason=" + url.QueryEscape(cancelReason)
+	}
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var group AppointmentGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// ListUsers retrieves the users that can sign up for an appointment group
+func (s *AppointmentGroupsService) ListUsers(ctx context.Context, groupID int64, registrationStatus string) ([]User, error) {
+	path := fmt.Sprintf("/api/v1/appointment_groups/%d/users", groupID)
+
+	if registrationStatus != "" {
+		path += "?registration_status=" + url.QueryEscape(registrationStatus)
+	}
+
+	var users []User
+	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// ListGroups retrieves the student groups that can sign up for a group
+// appointment group
+func (s *AppointmentGroupsService) ListGroups(ctx context.Context, groupID int64, registrationStatus string) ([]Group, error) {
+	path := fmt.Sprintf("/api/v1/appointment_groups/%d/groups", groupID)
+
+	if registrationStatus != "" {
+		path += "?registration_status=" + url.QueryEscape(registrationStatus)
+	}
+
+	var groups []Group
+	if err := s.client.GetAllPages(ctx, path, &groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// NextAppointment retrieves the current user's next reserved appointment,
+// optionally limited to some appointment groups
+func (s *AppointmentGroupsService) NextAppointment(ctx context.Context, groupIDs []int64) ([]CalendarEvent, error) {
+	path := "/api/v1/appointment_groups/next_appointment"
+
+	if len(groupIDs) > 0 {
+		query := url.Values{}
+		for _, id := range groupIDs {
+			query.Add("appointment_group_ids[]", strconv.FormatInt(id, 10))
+		}
+		path += "?" + query.Encode()
+	}
+
+	var events []CalendarEvent
+	if err := s.client.GetJSON(ctx, path, &events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// newAppointmentsBody encodes time slots as Canvas expects them:
+// {"0": [start, end], "1": [start, end], ...}
+func newAppointmentsBody(slots []AppointmentSlot) map[string][]string {
+	appointments := make(map[string][]string, len(slots))
+	for i, slot := range slots {
+		appointments[strconv.Itoa(i)] = []string{
+			slot.StartAt.Format(time.RFC3339),
+			slot.EndAt.Format(time.RFC3339),
+		}
+	}
+	return appointments
+}
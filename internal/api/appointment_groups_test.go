@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAppointmentGroupsService_Create(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/appointment_groups" {
+			t.Errorf("Expected POST /api/v1/appointment_groups, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			AppointmentGroup struct {
+				ContextCodes    []string            `json:"context_codes"`
+				Title           string              `json:"title"`
+				Publish         bool                `json:"publish"`
+				NewAppointments map[string][]string `json:"new_appointments"`
+			} `json:"appointment_group"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		group := body.AppointmentGroup
+		if len(group.ContextCodes) != 1 || group.ContextCodes[0] != "course_123" || !group.Publish {
+			t.Errorf("Unexpected appointment group: %+v", group)
+		}
+		if got := group.NewAppointments["1"]; len(got) != 2 || got[0] != "2026-10-19T14:15:00Z" || got[1] != "2026-10-19T14:30:00Z" {
+			t.Errorf("Unexpected second slot: %v", got)
+		}
+
+		w.Write([]byte(`{"id":456,"title":"Office Hours","appointments_count":2}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	start := time.Date(2026, 10, 19, 14, 0, 0, 0, time.UTC)
+	service := NewAppointmentGroupsService(client)
+	group, err := service.Create(context.Background(), &CreateAppointmentGroupParams{
+		ContextCodes: []string{"course_123"},
+		Title:        "Office Hours",
+		Publish:      true,
+		Slots: []AppointmentSlot{
+			{StartAt: start, EndAt: start.Add(15 * time.Minute)},
+			{StartAt: start.Add(15 * time.Minute), EndAt: start.Add(30 * time.Minute)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if group.ID != 456 || group.AppointmentsCount != 2 {
+		t.Errorf("Unexpected group: %+v", group)
+	}
+}
+
+func TestAppointmentGroupsService_NextAppointment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/appointment_groups/next_appointment" {
+			t.Errorf("Expected next_appointment path, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query()["appointment_group_ids[]"]; len(got) != 2 || got[0] != "456" {
+			t.Errorf("Unexpected appointment group IDs: %v", got)
+		}
+
+		w.Write([]byte(`[{"id":789,"title":"Office Hours","appointment_group_id":456}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewAppointmentGroupsService(client)
+	events, err := service.NextAppointment(context.Background(), []int64{456, 457})
+	if err != nil {
+		t.Fatalf("NextAppointment failed: %v", err)
+	}
+
+	if len(events) != 1 || events[0].ID != 789 {
+		t.Errorf("Unexpected events: %+v", events)
+	}
+}
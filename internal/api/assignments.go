@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -54,6 +55,23 @@ func (s *AssignmentsService) Get(ctx context.Context, courseID, assignmentID int
 
 // List retrieves assignments for a course
 func (s *AssignmentsService) List(ctx context.Context, courseID int64, opts *ListAssignmentsOptions) ([]Assignment, error) {
+	path := courseAssignmentsPath(courseID, opts)
+
+	var assignments []Assignment
+	if err := s.client.GetAllPages(ctx, path, &assignments); err != nil {
+		return nil, err
+	}
+
+	return NormalizeAssignments(assignments), nil
+}
+
+// ListIter streams assignments for a course page by page
+func (s *AssignmentsService) ListIter(ctx context.Context, courseID int64, opts *ListAssignmentsOptions) iter.Seq2[Assignment, error] {
+	return normalizeSeq(Pages[Assignment](s.client, ctx, courseAssignmentsPath(courseID, opts)), NormalizeAssignment)
+}
+
+// courseAssignmentsPath builds the course assignments list path with query parameters
+func courseAssignmentsPath(courseID int64, opts *ListAssignmentsOptions) string {
 	path := fmt.Sprintf("/api/v1/courses/%d/assignments", courseID)
 
 	if opts != nil {
@@ -104,12 +122,7 @@ func (s *AssignmentsService) List(ctx context.Context, courseID int64, opts *Lis
 		}
 	}
 
-	var assignments []Assignment
-	if err := s.client.GetAllPages(ctx, path, &assignments); err != nil {
-		return nil, err
-	}
-
-	return NormalizeAssignments(assignments), nil
+	return path
 }
 
 // CreateAssignmentParams holds parameters for creating an assignment
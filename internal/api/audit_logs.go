@@ -0,0 +1,319 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// AuditLogsService handles grade change, course and authentication audit log API calls
+type AuditLogsService struct {
+	client *Client
+}
+
+// NewAuditLogsService creates a new audit logs service
+func NewAuditLogsService(client *Client) *AuditLogsService {
+	return &AuditLogsService{client: client}
+}
+
+// AuditLogOptions holds the date range shared by all audit log queries
+type AuditLogOptions struct {
+	StartTime string // ISO 8601 date or time, inclusive
+	EndTime   string // ISO 8601 date or time, exclusive
+	PerPage   int
+}
+
+// GradeChangeEvent is a grade change audit event. Linked objects are
+// flattened to IDs and names so events can be written as CSV rows.
+type GradeChangeEvent struct {
+	ID                    string   `json:"id"`
+	CreatedAt             string   `json:"created_at"`
+	EventType             string   `json:"event_type"`
+	CourseID              int64    `json:"course_id"`
+	CourseName            string   `json:"course_name,omitempty"`
+	AssignmentID          int64    `json:"assignment_id"`
+	AssignmentName        string   `json:"assignment_name,omitempty"`
+	StudentID             int64    `json:"student_id"`
+	StudentName           string   `json:"student_name,omitempty"`
+	GraderID              int64    `json:"grader_id"`
+	GraderName            string   `json:"grader_name,omitempty"`
+	GradeBefore           string   `json:"grade_before"`
+	GradeAfter            string   `json:"grade_after"`
+	GradeCurrent          string   `json:"grade_current,omitempty"`
+	PointsPossibleBefore  *float64 `json:"points_possible_before,omitempty"`
+	PointsPossibleAfter   *float64 `json:"points_possible_after,omitempty"`
+	PointsPossibleCurrent *float64 `json:"points_possible_current,omitempty"`
+	ExcusedBefore         bool     `json:"excused_before"`
+	ExcusedAfter          bool     `json:"excused_after"`
+	GradedAnonymously     bool     `json:"graded_anonymously"`
+	VersionNumber         int64    `json:"version_number,omitempty"`
+	RequestID             string   `json:"request_id,omitempty"`
+}
+
+// CourseEvent is a course audit event (created, updated, published, copied, ...)
+type CourseEvent struct {
+	ID          string                 `json:"id"`
+	CreatedAt   string                 `json:"created_at"`
+	EventType   string                 `json:"event_type"`
+	EventSource string                 `json:"event_source,omitempty"`
+	CourseID    int64                  `json:"course_id"`
+	CourseName  string                 `json:"course_name,omitempty"`
+	UserID      int64                  `json:"user_id"`
+	UserName    string                 `json:"user_name,omitempty"`
+	SISBatchID  int64                  `json:"sis_batch_id,omitempty"`
+	EventData   map[string]interface{} `json:"event_data,omitempty"`
+}
+
+// AuthenticationEvent is a login or logout audit event
+type AuthenticationEvent struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"created_at"`
+	EventType string `json:"event_type"`
+	LoginID   int64  `json:"login_id"`
+	UniqueID  string `json:"unique_id,omitempty"`
+	AccountID int64  `json:"account_id"`
+	UserID    int64  `json:"user_id"`
+	UserName  string `json:"user_name,omitempty"`
+}
+
+// auditPage is one page of an audit log response. Events reference the
+// objects in Linked by ID.
+type auditPage[T any] struct {
+	Events []T         `json:"events"`
+	Linked auditLinked `json:"linked"`
+}
+
+// auditLinked holds the objects referenced by a page of audit events
+type auditLinked struct {
+	Assignments []linkedObject `json:"assignments"`
+	Courses     []linkedObject `json:"courses"`
+	Users       []linkedObject `json:"users"`
+	Logins      []linkedObject `json:"logins"`
+}
+
+// linkedObject is the subset of a linked object needed to label events
+type linkedObject struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	UniqueID string `json:"unique_id"`
+}
+
+// findLinked returns the linked object with the given ID
+func findLinked(objects []linkedObject, id int64) linkedObject {
+	for _, object := range objects {
+		if object.ID == id {
+			return object
+		}
+	}
+	return linkedObject{}
+}
+
+// rawGradeChangeEvent is a grade change event as returned by Canvas
+type rawGradeChangeEvent struct {
+	GradeChangeEvent
+	Links struct {
+		Assignment int64 `json:"assignment"`
+		Course     int64 `json:"course"`
+		Student    int64 `json:"student"`
+		Grader     int64 `json:"grader"`
+	} `json:"links"`
+}
+
+// rawCourseEvent is a course event as returned by Canvas
+type rawCourseEvent struct {
+	CourseEvent
+	Links struct {
+		Course   int64 `json:"course"`
+		User     int64 `json:"user"`
+		SISBatch int64 `json:"sis_batch"`
+	} `json:"links"`
+}
+
+// rawAuthenticationEvent is an authentication event as returned by Canvas
+type rawAuthenticationEvent struct {
+	AuthenticationEvent
+	Links struct {
+		Login   int64 `json:"login"`
+		Account int64 `json:"account"`
+		User    int64 `json:"user"`
+	} `json:"links"`
+}
+
+// GradeChangeQuery combines grade change filters. At least one ID is required.
+type GradeChangeQuery struct {
+	CourseID     int64
+	AssignmentID int64
+	StudentID    int64
+	GraderID     int64
+}
+
+// ListGradeChangesByAssignment lists grade changes for an assignment
+func (s *AuditLogsService) ListGradeChangesByAssignment(ctx context.Context, assignmentID int64, opts *AuditLogOptions) ([]GradeChangeEvent, error) {
+	return s.listGradeChanges(ctx, fmt.Sprintf("/api/v1/audit/grade_change/assignments/%d", assignmentID), nil, opts)
+}
+
+// ListGradeChangesByCourse lists grade changes in a course
+func (s *AuditLogsService) ListGradeChangesByCourse(ctx context.Context, courseID int64, opts *AuditLogOptions) ([]GradeChangeEvent, error) {
+	return s.listGradeChanges(ctx, fmt.Sprintf("/api/v1/audit/grade_change/courses/%d", courseID), nil, opts)
+}
+
+// ListGradeChangesByStudent lists grade changes for a student
+func (s *AuditLogsService) ListGradeChangesByStudent(ctx context.Context, studentID int64, opts *AuditLogOptions) ([]GradeChangeEvent, error) {
+	return s.listGradeChanges(ctx, fmt.Sprintf("/api/v1/audit/grade_change/students/%d", studentID), nil, opts)
+}
+
+// ListGradeChangesByGrader lists grade changes made by a grader
+func (s *AuditLogsService) ListGradeChangesByGrader(ctx context.Context, graderID int64, opts *AuditLogOptions) ([]GradeChangeEvent, error) {
+	return s.listGradeChanges(ctx, fmt.Sprintf("/api/v1/audit/grade_change/graders/%d", graderID), nil, opts)
+}
+
+// QueryGradeChanges lists grade changes matching every filter in the query,
+// e.g. one student's grades on one assignment
+func (s *AuditLogsService) QueryGradeChanges(ctx context.Context, query *GradeChangeQuery, opts *AuditLogOptions) ([]GradeChangeEvent, error) {
+	filters := url.Values{}
+	if query.CourseID > 0 {
+		filters.Set("course_id", strconv.FormatInt(query.CourseID, 10))
+	}
+	if query.AssignmentID > 0 {
+		filters.Set("assignment_id", strconv.FormatInt(query.AssignmentID, 10))
+	}
+	if query.StudentID > 0 {
+		filters.Set("student_id", strconv.FormatInt(query.StudentID, 10))
+	}
+	if query.GraderID > 0 {
+		filters.Set("grader_id", strconv.FormatInt(query.GraderID, 10))
+	}
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("grade change query needs a course, assignment, student or grader ID")
+	}
+
+	return s.listGradeChanges(ctx, "/api/v1/audit/grade_change", filters, opts)
+}
+
+func (s *AuditLogsService) listGradeChanges(ctx context.Context, path string, filters url.Values, opts *AuditLogOptions) ([]GradeChangeEvent, error) {
+	return listAuditEvents(ctx, s.client, auditPath(path, filters, opts), func(raw rawGradeChangeEvent, linked *auditLinked) GradeChangeEvent {
+		event := raw.GradeChangeEvent
+		event.CourseID = raw.Links.Course
+		event.CourseName = findLinked(linked.Courses, raw.Links.Course).Name
+		event.AssignmentID = raw.Links.Assignment
+		event.AssignmentName = findLinked(linked.Assignments, raw.Links.Assignment).Name
+		event.StudentID = raw.Links.Student
+		event.StudentName = findLinked(linked.Users, raw.Links.Student).Name
+		event.GraderID = raw.Links.Grader
+		event.GraderName = findLinked(linked.Users, raw.Links.Grader).Name
+		return event
+	})
+}
+
+// ListCourseEvents lists audit events for a course
+func (s *AuditLogsService) ListCourseEvents(ctx context.Context, courseID int64, opts *AuditLogOptions) ([]CourseEvent, error) {
+	return s.listCourseEvents(ctx, fmt.Sprintf("/api/v1/audit/course/courses/%d", courseID), opts)
+}
+
+// ListAccountCourseEvents lists course audit events for every course in an account
+func (s *AuditLogsService) ListAccountCourseEvents(ctx context.Context, accountID int64, opts *AuditLogOptions) ([]CourseEvent, error) {
+	return s.listCourseEvents(ctx, fmt.Sprintf("/api/v1/audit/course/accounts/%d", accountID), opts)
+}
+
+func (s *AuditLogsService) listCourseEvents(ctx context.Context, path string, opts *AuditLogOptions) ([]CourseEvent, error) {
+	return listAuditEvents(ctx, s.client, auditPath(path, nil, opts), func(raw rawCourseEvent, linked *auditLinked) CourseEvent {
+		event := raw.CourseEvent
+		event.CourseID = raw.Links.Course
+		event.CourseName = findLinked(linked.Courses, raw.Links.Course).Name
+		event.UserID = raw.Links.User
+		event.UserName = findLinked(linked.Users, raw.Links.User).Name
+		event.SISBatchID = raw.Links.SISBatch
+		return event
+	})
+}
+
+// ListLoginAuthEvents lists authentication events for a login
+func (s *AuditLogsService) ListLoginAuthEvents(ctx context.Context, loginID int64, opts *AuditLogOptions) ([]AuthenticationEvent, error) {
+	return s.listAuthEvents(ctx, fmt.Sprintf("/api/v1/audit/authentication/logins/%d", loginID), opts)
+}
+
+// ListAccountAuthEvents lists authentication events for an account
+func (s *AuditLogsService) ListAccountAuthEvents(ctx context.Context, accountID int64, opts *AuditLogOptions) ([]AuthenticationEvent, error) {
+	return s.listAuthEvents(ctx, fmt.Sprintf("/api/v1/audit/authentication/accounts/%d", accountID), opts)
+}
+
+// ListUserAuthEvents lists authentication events for all of a user's logins
+func (s *AuditLogsService) ListUserAuthEvents(ctx context.Context, userID int64, opts *AuditLogOptions) ([]AuthenticationEvent, error) {
+	return s.listAuthEvents(ctx, fmt.Sprintf("/api/v1/audit/authentication/users/%d", userID), opts)
+}
+
+func (s *AuditLogsService) listAuthEvents(ctx context.Context, path string, opts *AuditLogOptions) ([]AuthenticationEvent, error) {
+	return listAuditEvents(ctx, s.client, auditPath(path, nil, opts), func(raw rawAuthenticationEvent, linked *auditLinked) AuthenticationEvent {
+		event := raw.AuthenticationEvent
+		event.LoginID = raw.Links.Login
+		event.UniqueID = findLinked(linked.Logins, raw.Links.Login).UniqueID
+		event.AccountID = raw.Links.Account
+		event.UserID = raw.Links.User
+		event.UserName = findLinked(linked.Users, raw.Links.User).Name
+		return event
+	})
+}
+
+// auditPath appends filters and the date range to an audit endpoint
+func auditPath(path string, filters url.Values, opts *AuditLogOptions) string {
+	query := url.Values{}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	if opts != nil {
+		if opts.StartTime != "" {
+			query.Set("start_time", opts.StartTime)
+		}
+		if opts.EndTime != "" {
+			query.Set("end_time", opts.EndTime)
+		}
+		if opts.PerPage > 0 {
+			query.Set("per_page", strconv.Itoa(opts.PerPage))
+		}
+	}
+
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return path
+}
+
+// listAuditEvents follows the Link header of an audit endpoint and converts
+// each raw event using the objects linked on its page. Audit events are
+// wrapped in an object, so GetAllPages cannot be used.
+func listAuditEvents[R any, E any](ctx context.Context, client *Client, path string, convert func(R, *auditLinked) E) ([]E, error) {
+	maxResults := client.GetMaxResults()
+
+	events := []E{}
+	for path != "" {
+		resp, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var page auditPage[R]
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode audit events: %w", err)
+		}
+
+		for _, raw := range page.Events {
+			events = append(events, convert(raw, &page.Linked))
+		}
+
+		if maxResults > 0 && len(events) >= maxResults {
+			return events[:maxResults], nil
+		}
+
+		path, err = nextPagePath(ParsePaginationLinks(resp))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
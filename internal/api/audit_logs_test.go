@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuditLogsService_ListGradeChangesByCourse(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/audit/grade_change/courses/10" {
+			t.Errorf("Expected path /api/v1/audit/grade_change/courses/10, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"events":[{"id":"e2","event_type":"grade_change","grade_before":"B","grade_after":"A","links":{"assignment":5,"course":10,"student":7,"grader":3}}],"linked":{}}`))
+			return
+		}
+
+		if got := r.URL.Query().Get("start_time"); got != "2026-09-01" {
+			t.Errorf("Expected start_time=2026-09-01, got %q", got)
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(`<%s/api/v1/audit/grade_change/courses/10?page=2>; rel="next"`, server.URL))
+		w.Write([]byte(`{
+			"events":[{"id":"e1","created_at":"2026-09-02T10:00:00Z","event_type":"grade_change","grade_before":"C","grade_after":"B","excused_after":false,"links":{"assignment":5,"course":10,"student":7,"grader":3}}],
+			"linked":{
+				"assignments":[{"id":5,"name":"Essay 1"}],
+				"courses":[{"id":10,"name":"Biology"}],
+				"users":[{"id":7,"name":"Student One"},{"id":3,"name":"Teacher One"}]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewAuditLogsService(client)
+	events, err := service.ListGradeChangesByCourse(context.Background(), 10, &AuditLogOptions{StartTime: "2026-09-01"})
+	if err != nil {
+		t.Fatalf("ListGradeChangesByCourse failed: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events across pages, got %d", len(events))
+	}
+
+	first := events[0]
+	if first.AssignmentID != 5 || first.AssignmentName != "Essay 1" {
+		t.Errorf("Expected linked assignment, got %d %q", first.AssignmentID, first.AssignmentName)
+	}
+	if first.StudentName != "Student One" || first.GraderName != "Teacher One" {
+		t.Errorf("Expected linked users, got %q and %q", first.StudentName, first.GraderName)
+	}
+	if first.CourseName != "Biology" {
+		t.Errorf("Expected course name 'Biology', got %q", first.CourseName)
+	}
+
+	if events[1].StudentID != 7 || events[1].StudentName != "" {
+		t.Errorf("Expected unlinked student ID only on second page, got %+v", events[1])
+	}
+}
+
+func TestAuditLogsService_QueryGradeChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/audit/grade_change" {
+			t.Errorf("Expected path /api/v1/audit/grade_change, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("course_id") != "10" || query.Get("student_id") != "7" {
+			t.Errorf("Expected course_id and student_id filters, got %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewAuditLogsService(client)
+	events, err := service.QueryGradeChanges(context.Background(), &GradeChangeQuery{CourseID: 10, StudentID: 7}, nil)
+	if err != nil {
+		t.Fatalf("QueryGradeChanges failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected no events, got %d", len(events))
+	}
+
+	if _, err := service.QueryGradeChanges(context.Background(), &GradeChangeQuery{}, nil); err == nil {
+		t.Error("Expected error for empty query")
+	}
+}
+
+func TestAuditLogsService_ListUserAuthEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/audit/authentication/users/7" {
+			t.Errorf("Expected path /api/v1/audit/authentication/users/7, got %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`{
+			"events":[{"id":"a1","event_type":"login","links":{"login":20,"account":1,"user":7}}],
+			"linked":{"logins":[{"id":20,"unique_id":"student1@example.edu"}],"users":[{"id":7,"name":"Student One"}]}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewAuditLogsService(client)
+	events, err := service.ListUserAuthEvents(context.Background(), 7, nil)
+	if err != nil {
+		t.Fatalf("ListUserAuthEvents failed: %v", err)
+	}
+
+	if len(events) != 1 || events[0].UniqueID != "student1@example.edu" || events[0].LoginID != 20 {
+		t.Errorf("Unexpected events: %+v", events)
+	}
+}
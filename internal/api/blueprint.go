@@ -303,3 +303,30 @@ func (s *BlueprintService) GetMigration(ctx context.Context, courseID int64, tem
 
 	return &migration, nil
 }
+
+// ToProgress converts the migration status into a JobProgress so it can be
+// polled with PollProgress like other asynchronous jobs
+func (m *BlueprintMigration) ToProgress() *JobProgress {
+	progress := &JobProgress{
+		ID:            m.ID,
+		ContextType:   "MasterCourses::MasterMigration",
+		Tag:           "blueprint_sync",
+		WorkflowState: ProgressStateRunning,
+		Message:       m.WorkflowState,
+		CreatedAt:     m.CreatedAt,
+	}
+
+	switch m.WorkflowState {
+	case "queued":
+		progress.WorkflowState = ProgressStateQueued
+	case "imports_queued":
+		progress.Completion = 50
+	case "completed":
+		progress.WorkflowState = ProgressStateCompleted
+		progress.Completion = 100
+	case "exports_failed", "imports_failed":
+		progress.WorkflowState = ProgressStateFailed
+	}
+
+	return progress
+}
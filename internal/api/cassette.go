@@ -0,0 +1,280 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jjuanrivvera/canvas-cli/internal/dryrun"
+)
+
+// CassetteFileName is the name of the cassette file inside a record/replay directory
+const CassetteFileName = "cassette.json"
+
+// Cassette holds recorded HTTP interactions for offline replay.
+// Request URLs are stored relative to the Canvas base URL so a cassette
+// recorded against one instance can be replayed against any other.
+type Cassette struct {
+	RecordedAt   time.Time     `json:"recorded_at"`
+	Interactions []Interaction `json:"interactions"`
+
+	mu   sync.Mutex
+	used map[int]bool
+}
+
+// Interaction is a single recorded request/response pair
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is the recorded request (credentials redacted)
+type CassetteRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// CassetteResponse is the recorded response
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// LoadCassette reads a cassette from the given file
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette to the given file
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// Add appends an interaction to the cassette
+func (c *Cassette) Add(interaction Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, interaction)
+}
+
+// Find returns the recorded interaction that matches a request.
+//
+// An exact method and URL match wins, then a match on the path without the
+// query string. Matches are consumed in recording order so repeated requests
+// (e.g. polling) replay the same sequence of responses; the last one is
+// reused once exhausted. Requests that were never recorded are an error
+// rather than being answered with a response for a different resource.
+func (c *Cassette) Find(method, requestURL string) (*Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.used == nil {
+		c.used = make(map[int]bool)
+	}
+
+	requestPath := stripQuery(requestURL)
+
+	var exact, samePath []int
+	for i, interaction := range c.Interactions {
+		if interaction.Request.Method != method {
+			continue
+		}
+		if interaction.Request.URL == requestURL {
+			exact = append(exact, i)
+		} else if stripQuery(interaction.Request.URL) == requestPath {
+			samePath = append(samePath, i)
+		}
+	}
+
+	for _, candidates := range [][]int{exact, samePath} {
+		if len(candidates) == 0 {
+			continue
+		}
+		for _, i := range candidates {
+			if !c.used[i] {
+				c.used[i] = true
+				return &c.Interactions[i], nil
+			}
+		}
+		return &c.Interactions[candidates[len(candidates)-1]], nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction for %s %s", method, requestURL)
+}
+
+// ToHTTPResponse builds an http.Response from the recorded response
+func (r *CassetteResponse) ToHTTPResponse() *http.Response {
+	headers := make(http.Header)
+	for key, values := range r.Headers {
+		headers[key] = append([]string(nil), values...)
+	}
+
+	return &http.Response{
+		StatusCode: r.StatusCode,
+		Status:     fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		Header:     headers,
+		Body:       io.NopCloser(strings.NewReader(r.Body)),
+	}
+}
+
+// cassetteSuffix closes the interactions array and the document. It is
+// rewritten after every interaction so the cassette on disk is always valid JSON.
+const cassetteSuffix = "\n  ]\n}\n"
+
+// cassetteRecorder appends every exchange to a cassette on disk. Each
+// interaction is written in place of the closing brackets, so nothing is lost
+// if the process exits early and the cost of recording does not grow with
+// the size of the cassette.
+type cassetteRecorder struct {
+	mu           sync.Mutex
+	file         *os.File
+	end          int64 // Offset of cassetteSuffix in file
+	interactions int
+}
+
+// newCassetteRecorder creates a recorder that writes to dir/cassette.json
+func newCassetteRecorder(dir string) (*cassetteRecorder, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create record directory: %w", err)
+	}
+
+	recordedAt, err := json.Marshal(time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+
+	file, err := os.OpenFile(filepath.Join(dir, CassetteFileName), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	header := "{\n  \"recorded_at\": " + string(recordedAt) + ",\n  \"interactions\": ["
+	if _, err := file.WriteString(header + cassetteSuffix); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return &cassetteRecorder{
+		file: file,
+		end:  int64(len(header)),
+	}, nil
+}
+
+// Close closes the cassette file. Later exchanges are not recorded.
+func (r *cassetteRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// Record stores the request/response pair and rewinds the response body so
+// the caller can still read it
+func (r *cassetteRecorder) Record(req *http.Request, path string, reqBody []byte, resp *http.Response) error {
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	reqHeaders := make(http.Header)
+	for key, values := range req.Header {
+		for _, value := range values {
+			reqHeaders.Add(key, dryrun.RedactHeader(key, value))
+		}
+	}
+
+	return r.append(Interaction{
+		Request: CassetteRequest{
+			Method:  req.Method,
+			URL:     dryrun.RedactURL(path),
+			Headers: reqHeaders,
+			Body:    string(dryrun.RedactBody(reqBody)),
+		},
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header.Clone(),
+			Body:       string(respBody),
+		},
+	})
+}
+
+// append writes interaction over the closing brackets and restores them after it
+func (r *cassetteRecorder) append(interaction Interaction) error {
+	data, err := json.MarshalIndent(interaction, "    ", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette interaction: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	separator := "\n    "
+	if r.interactions > 0 {
+		separator = ",\n    "
+	}
+
+	chunk := separator + string(data)
+	if _, err := r.file.WriteAt([]byte(chunk+cassetteSuffix), r.end); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	r.end += int64(len(chunk))
+	r.interactions++
+	return nil
+}
+
+// stripQuery returns the path portion of a relative URL
+func stripQuery(rawURL string) string {
+	if parsed, err := url.Parse(rawURL); err == nil {
+		return parsed.Path
+	}
+	if i := strings.Index(rawURL, "?"); i >= 0 {
+		return rawURL[:i]
+	}
+	return rawURL
+}
@@ -0,0 +1,243 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/courses/1":
+			w.Write([]byte(`{"id": 1, "name": "Recorded Course"}`))
+		case "/api/v1/courses/404":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": [{"message": "not found"}]}`))
+		case "/api/v1/users/1/logins":
+			w.Write([]byte(`{"id": 5}`))
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	recorder, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "super-secret-token",
+		RequestsPerSec: 100,
+		RecordDir:      dir,
+	})
+	if err != nil {
+		t.Fatalf("failed to create recording client: %v", err)
+	}
+
+	var course Course
+	if err := recorder.GetJSON(context.Background(), "/api/v1/courses/1", &course); err != nil {
+		t.Fatalf("recorded request failed: %v", err)
+	}
+	if err := recorder.GetJSON(context.Background(), "/api/v1/courses/404", &course); err == nil {
+		t.Fatal("expected error for 404 response")
+	}
+	login := map[string]interface{}{"login": map[string]string{"unique_id": "jane", "password": "login-password"}}
+	if err := recorder.PostJSON(context.Background(), "/api/v1/users/1/logins", login, nil); err != nil {
+		t.Fatalf("recorded POST failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, CassetteFileName))
+	if err != nil {
+		t.Fatalf("cassette was not written: %v", err)
+	}
+	if strings.Contains(string(data), "super-secret-token") {
+		t.Error("cassette must not contain the token")
+	}
+	if strings.Contains(string(data), "login-password") {
+		t.Error("cassette must not contain request body credentials")
+	}
+
+	// Replay against a closed server to prove no network access happens
+	server.Close()
+
+	replayer, err := NewClient(ClientConfig{
+		BaseURL:   server.URL,
+		Token:     "other-token",
+		ReplayDir: dir,
+	})
+	if err != nil {
+		t.Fatalf("failed to create replay client: %v", err)
+	}
+
+	var replayed Course
+	if err := replayer.GetJSON(context.Background(), "/api/v1/courses/1", &replayed); err != nil {
+		t.Fatalf("replayed request failed: %v", err)
+	}
+	if replayed.Name != "Recorded Course" {
+		t.Errorf("expected 'Recorded Course', got %q", replayed.Name)
+	}
+
+	err = replayer.GetJSON(context.Background(), "/api/v1/courses/404", &replayed)
+	if !IsNotFoundError(err) {
+		t.Errorf("expected replayed 404 error, got %v", err)
+	}
+
+	if err := replayer.GetJSON(context.Background(), "/api/v1/users/1", &replayed); err == nil {
+		t.Error("expected error for request missing from cassette")
+	}
+}
+
+func TestClient_RecordAndReplay_RetriedRequest(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"errors": [{"message": "unavailable"}]}`))
+			return
+		}
+		w.Write([]byte(`{"id": 1, "name": "Recovered Course"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	recorder, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		RecordDir:      dir,
+	})
+	if err != nil {
+		t.Fatalf("failed to create recording client: %v", err)
+	}
+	recorder.retryPolicy.InitialBackoff = time.Millisecond
+
+	var course Course
+	if err := recorder.GetJSON(context.Background(), "/api/v1/courses/1", &course); err != nil {
+		t.Fatalf("recorded request failed: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected the request to be retried once, got %d attempts", attempts)
+	}
+
+	cassette, err := LoadCassette(filepath.Join(dir, CassetteFileName))
+	if err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	if len(cassette.Interactions) != 1 || cassette.Interactions[0].Response.StatusCode != http.StatusOK {
+		t.Fatalf("expected only the final 200 to be recorded, got %+v", cassette.Interactions)
+	}
+
+	server.Close()
+
+	replayer, err := NewClient(ClientConfig{
+		BaseURL:   server.URL,
+		Token:     "test-token",
+		ReplayDir: dir,
+	})
+	if err != nil {
+		t.Fatalf("failed to create replay client: %v", err)
+	}
+
+	var replayed Course
+	if err := replayer.GetJSON(context.Background(), "/api/v1/courses/1", &replayed); err != nil {
+		t.Fatalf("replayed request failed: %v", err)
+	}
+	if replayed.Name != "Recovered Course" {
+		t.Errorf("expected 'Recovered Course', got %q", replayed.Name)
+	}
+}
+
+func TestCassetteRecorder_AppendsInteractions(t *testing.T) {
+	dir := t.TempDir()
+
+	recorder, err := newCassetteRecorder(dir)
+	if err != nil {
+		t.Fatalf("newCassetteRecorder failed: %v", err)
+	}
+	defer recorder.Close()
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/courses/1", nil)
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"id":1}`)),
+		}
+		if err := recorder.Record(req, "/api/v1/courses/1", nil, resp); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+
+		// The cassette is valid after every exchange
+		cassette, err := LoadCassette(filepath.Join(dir, CassetteFileName))
+		if err != nil {
+			t.Fatalf("Invalid cassette after %d interactions: %v", i+1, err)
+		}
+		if len(cassette.Interactions) != i+1 {
+			t.Errorf("Expected %d interactions, got %d", i+1, len(cassette.Interactions))
+		}
+		if cassette.RecordedAt.IsZero() {
+			t.Error("Expected recorded_at to be set")
+		}
+	}
+}
+
+func TestCassette_Find(t *testing.T) {
+	cassette := &Cassette{
+		Interactions: []Interaction{
+			{Request: CassetteRequest{Method: "GET", URL: "/api/v1/progress/1"}, Response: CassetteResponse{StatusCode: 200, Body: "first"}},
+			{Request: CassetteRequest{Method: "GET", URL: "/api/v1/progress/1"}, Response: CassetteResponse{StatusCode: 200, Body: "second"}},
+			{Request: CassetteRequest{Method: "GET", URL: "/api/v1/courses?per_page=10"}, Response: CassetteResponse{StatusCode: 200, Body: "courses"}},
+			{Request: CassetteRequest{Method: "GET", URL: "/api/v1/courses/1/assignments"}, Response: CassetteResponse{StatusCode: 200, Body: "assignments"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		want   string
+	}{
+		{"exact match consumed in order", "GET", "/api/v1/progress/1", "first"},
+		{"second exact match", "GET", "/api/v1/progress/1", "second"},
+		{"exhausted match reuses last", "GET", "/api/v1/progress/1", "second"},
+		{"same path different query", "GET", "/api/v1/courses?page=2", "courses"},
+	}
+
+	for _, tt := range tests {
+		interaction, err := cassette.Find(tt.method, tt.url)
+		if err != nil {
+			t.Errorf("%s: expected a match, got %v", tt.name, err)
+			continue
+		}
+		if interaction.Response.Body != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, interaction.Response.Body)
+		}
+	}
+
+	if _, err := cassette.Find("POST", "/api/v1/courses"); err == nil {
+		t.Error("expected no match for unrecorded method")
+	}
+
+	// A recorded parent path does not answer requests for its children
+	_, err := cassette.Find("GET", "/api/v1/courses/1/assignments/5")
+	if err == nil || err.Error() != "no recorded interaction for GET /api/v1/courses/1/assignments/5" {
+		t.Errorf("expected no recorded interaction error, got %v", err)
+	}
+}
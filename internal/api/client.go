@@ -3,14 +3,13 @@ package api
 import (
 	"bytes"
 	"context"
-	"crypto/md5"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -35,6 +34,9 @@ const (
 const (
 	// defaultQuotaTotal is the default Canvas API quota if not detected from headers
 	defaultQuotaTotal = 700.0
+
+	// backgroundRefreshTimeout bounds stale-while-revalidate refreshes
+	backgroundRefreshTimeout = 30 * time.Second
 )
 
 // HTTPClient defines the interface for making HTTP requests to Canvas API
@@ -61,24 +63,30 @@ type HTTPClient interface {
 
 // Client is the Canvas API client
 type Client struct {
-	httpClient     *http.Client
-	baseURL        string
-	token          string             // Static token (used if tokenSource is nil)
-	tokenSource    oauth2.TokenSource // Auto-refreshing token source (preferred)
-	asUserID       int64              // For admin masquerading
-	rateLimiter    *AdaptiveRateLimiter
-	retryPolicy    *RetryPolicy
-	version        *CanvasVersion
-	featureChecker *FeatureChecker
-	logger         *slog.Logger
-	quotaTotal     float64 // Detected or configured quota total
-	cache          cache.CacheInterface
-	cacheEnabled   bool
-	userAgent      string // User-Agent header for API requests
-	maxResults     int    // Max results for paginated requests (0 = unlimited)
-	dryRun         bool   // Print curl commands instead of executing
-	showToken      bool   // Show actual token in dry-run output (default: redacted)
-	mu             sync.RWMutex
+	httpClient           *http.Client
+	baseURL              string
+	token                string             // Static token (used if tokenSource is nil)
+	tokenSource          oauth2.TokenSource // Auto-refreshing token source (preferred)
+	asUserID             int64              // For admin masquerading
+	rateLimiter          *AdaptiveRateLimiter
+	sharedLimiter        *SharedRateLimiter // Cross-process limiter (nil unless enabled)
+	retryPolicy          *RetryPolicy
+	version              *CanvasVersion
+	featureChecker       *FeatureChecker
+	logger               *slog.Logger
+	quotaTotal           float64 // Detected or configured quota total
+	cache                cache.CacheInterface
+	cacheEnabled         bool
+	userAgent            string            // User-Agent header for API requests
+	maxResults           int               // Max results for paginated requests (0 = unlimited)
+	dryRun               bool              // Print curl commands instead of executing
+	showToken            bool              // Show actual token in dry-run output (default: redacted)
+	recorder             *cassetteRecorder // Records traffic to a cassette (--record)
+	replay               *Cassette         // Serves responses from a cassette (--replay)
+	staleWhileRevalidate bool              // Serve expired cache entries while refreshing in the background
+	refreshing           sync.Map          // Cache keys with a background refresh in flight
+	refreshes            sync.WaitGroup    // Tracks background refreshes
+	mu                   sync.RWMutex
 }
 
 // Ensure Client implements HTTPClient interface
@@ -161,6 +169,23 @@ type ClientConfig struct {
 	MaxResults     int    // Max results for paginated requests (0 = unlimited)
 	DryRun         bool   // Print curl commands instead of executing requests
 	ShowToken      bool   // Show actual token in dry-run output (default: redacted)
+	RecordDir      string // Record every request/response pair to a cassette in this directory
+	ReplayDir      string // Serve responses from the cassette in this directory instead of the network
+	// SharedRateLimitDir enables a rate limiter shared by all processes using the
+	// same base URL, with its lock and state files kept in this directory
+	SharedRateLimitDir string
+	// StaleWhileRevalidate returns expired cache entries immediately and refreshes
+	// them in the background. Intended for long-lived sessions such as the REPL.
+	StaleWhileRevalidate bool
+	// RetryMethods overrides the methods retried after network and server
+	// errors (default: GET, HEAD, OPTIONS, PUT, DELETE)
+	RetryMethods []string
+	// RetryStatuses overrides the response statuses that trigger a retry
+	// (default: 429, 500, 502, 503, 504)
+	RetryStatuses []int
+	// TraceHAR writes every HTTP exchange, including version detection and
+	// file transfers, to a HAR 1.2 archive at this path (credentials redacted)
+	TraceHAR string
 }
 
 // NewClient creates a new Canvas API client
@@ -201,25 +226,68 @@ func NewClient(config ClientConfig) (*Client, error) {
 		ExpectContinueTimeout: 1 * time.Second,
 	}
 
+	var roundTripper http.RoundTripper = transport
+	if config.TraceHAR != "" {
+		_, creatorVersion, _ := strings.Cut(config.UserAgent, "/")
+		harTransport, err := NewHARTransport(transport, config.TraceHAR, creatorVersion)
+		if err != nil {
+			return nil, err
+		}
+		roundTripper = harTransport
+	}
+
 	client := &Client{
 		httpClient: &http.Client{
 			Timeout:   config.Timeout,
-			Transport: transport,
+			Transport: roundTripper,
 		},
-		baseURL:      config.BaseURL,
-		token:        config.Token,
-		tokenSource:  config.TokenSource,
-		asUserID:     config.AsUserID,
-		rateLimiter:  NewAdaptiveRateLimiter(config.RequestsPerSec),
-		retryPolicy:  DefaultRetryPolicy(),
-		logger:       config.Logger,
-		quotaTotal:   defaultQuotaTotal, // Will be updated from headers if available
-		cache:        config.Cache,
-		cacheEnabled: config.CacheEnabled && config.Cache != nil,
-		userAgent:    config.UserAgent,
-		maxResults:   config.MaxResults,
-		dryRun:       config.DryRun,
-		showToken:    config.ShowToken,
+		baseURL:              config.BaseURL,
+		token:                config.Token,
+		tokenSource:          config.TokenSource,
+		asUserID:             config.AsUserID,
+		rateLimiter:          NewAdaptiveRateLimiter(config.RequestsPerSec),
+		retryPolicy:          newRetryPolicy(config.RetryMethods, config.RetryStatuses),
+		logger:               config.Logger,
+		quotaTotal:           defaultQuotaTotal, // Will be updated from headers if available
+		cache:                config.Cache,
+		cacheEnabled:         config.CacheEnabled && config.Cache != nil,
+		userAgent:            config.UserAgent,
+		maxResults:           config.MaxResults,
+		dryRun:               config.DryRun,
+		showToken:            config.ShowToken,
+		staleWhileRevalidate: config.StaleWhileRevalidate,
+	}
+
+	if config.SharedRateLimitDir != "" {
+		sharedLimiter, err := NewSharedRateLimiter(config.SharedRateLimitDir, config.BaseURL, config.RequestsPerSec)
+		if err != nil {
+			return nil, err
+		}
+		client.sharedLimiter = sharedLimiter
+	}
+
+	if config.RecordDir != "" && config.ReplayDir != "" {
+		return nil, fmt.Errorf("record and replay modes cannot be used together")
+	}
+
+	if config.RecordDir != "" {
+		recorder, err := newCassetteRecorder(config.RecordDir)
+		if err != nil {
+			return nil, err
+		}
+		client.recorder = recorder
+	}
+
+	// Skip version detection in replay mode (no network access)
+	if config.ReplayDir != "" {
+		cassette, err := LoadCassette(filepath.Join(config.ReplayDir, CassetteFileName))
+		if err != nil {
+			return nil, err
+		}
+		client.replay = cassette
+		client.version = &CanvasVersion{Major: 999, Minor: 999, Patch: 999, Raw: "replay"}
+		client.featureChecker = NewFeatureChecker(client.version)
+		return client, nil
 	}
 
 	// Skip version detection in dry-run mode (no actual requests)
@@ -280,6 +348,11 @@ func (c *Client) getToken() (string, error) {
 
 // doRequest performs an HTTP request with rate limiting and retry logic
 func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	return c.doRequestWithHeaders(ctx, method, path, body, nil)
+}
+
+// doRequestWithHeaders performs an HTTP request with additional request headers
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, path string, body io.Reader, headers http.Header) (*http.Response, error) {
 	// Get current token (may trigger refresh if using token source)
 	token, err := c.getToken()
 	if err != nil {
@@ -304,14 +377,37 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 		return c.handleDryRun(method, fullURL, token, body)
 	}
 
+	// Handle replay mode: serve the recorded response without touching the network
+	if c.replay != nil {
+		return c.handleReplay(method, path)
+	}
+
+	// Buffer the request body so retries can replay it and the recorder can
+	// write it to the cassette
+	var bodyBytes []byte
+	if body != nil {
+		bodyBytes, err = io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
 	// Wait for rate limiter
-	if err := c.rateLimiter.Wait(ctx); err != nil {
+	if err := c.waitForRateLimit(ctx); err != nil {
 		return nil, fmt.Errorf("rate limiter error: %w", err)
 	}
 
-	// Execute with retry
-	return c.retryPolicy.ExecuteWithRetry(ctx, func() (*http.Response, error) {
-		req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
+	// Execute with retry. Only the final attempt is recorded: a replayed
+	// session never retries, so recorded 429/503s would fail it.
+	var lastReq *http.Request
+	resp, err := c.retryPolicy.ExecuteRequestWithRetry(ctx, method, func() (*http.Response, error) {
+		// Every attempt sends the full body from the start
+		var reqBody io.Reader
+		if bodyBytes != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
@@ -321,25 +417,53 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
 		req.Header.Set("User-Agent", c.userAgent)
+		for key, values := range headers {
+			req.Header[key] = values
+		}
 
 		// Make request
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			return nil, err
 		}
+		lastReq = req
 
 		// Update rate limiter based on response headers
 		c.updateRateLimitFromHeaders(resp)
 
 		// Check for API errors
 		if resp.StatusCode >= 400 {
+			// Keep the error body readable for the recorder
+			var errBody []byte
+			if c.recorder != nil {
+				errBody, _ = io.ReadAll(resp.Body)
+				resp.Body.Close()
+				resp.Body = io.NopCloser(bytes.NewReader(errBody))
+			}
+
 			err := ParseAPIError(resp)
 			resp.Body.Close()
+			if c.recorder != nil {
+				resp.Body = io.NopCloser(bytes.NewReader(errBody))
+			}
 			return resp, err
 		}
 
 		return resp, nil
 	})
+
+	if c.recorder != nil && lastReq != nil && resp != nil {
+		if err := c.recorder.Record(lastReq, path, bodyBytes, resp); err != nil {
+			c.logger.Warn("Failed to record HTTP interaction", "error", err)
+		}
+	}
+
+	// Evict cached reads of the resource after a successful mutation
+	if err == nil && method != http.MethodGet {
+		c.invalidateCache(path)
+	}
+
+	return resp, err
 }
 
 // handleDryRun prints the curl command and returns a mock response
@@ -384,13 +508,51 @@ func (c *Client) handleDryRun(method, fullURL, token string, body io.Reader) (*h
 	}, nil
 }
 
+// handleReplay serves a recorded response from the replay cassette
+func (c *Client) handleReplay(method, path string) (*http.Response, error) {
+	interaction, err := c.replay.Find(method, path)
+	if err != nil {
+		return nil, fmt.Errorf("replay cassette: %w", err)
+	}
+
+	resp := interaction.Response.ToHTTPResponse()
+	if resp.StatusCode >= 400 {
+		err := ParseAPIError(resp)
+		resp.Body.Close()
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// waitForRateLimit waits on the shared limiter when enabled, otherwise on the
+// process-local adaptive limiter. If the shared state cannot be used (e.g. the
+// config dir is read-only), the local limiter is used instead.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.sharedLimiter != nil {
+		err := c.sharedLimiter.Wait(ctx)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		c.logger.Warn("Shared rate limiter unavailable, using local limiter", "error", err)
+	}
+
+	return c.rateLimiter.Wait(ctx)
+}
+
 // updateRateLimitFromHeaders updates the rate limiter based on response headers
 func (c *Client) updateRateLimitFromHeaders(resp *http.Response) {
 	// Parse X-Rate-Limit-Remaining header
 	remaining := resp.Header.Get("X-Rate-Limit-Remaining")
 	if remaining != "" {
 		if remainingFloat, err := strconv.ParseFloat(remaining, 64); err == nil {
-			c.rateLimiter.AdjustRate(remainingFloat, c.quotaTotal)
+			c.rateLimiter.AdjustRate(remainingFloat, c.GetQuotaTotal())
+
+			if c.sharedLimiter != nil {
+				if err := c.sharedLimiter.Observe(context.Background(), remainingFloat, c.GetQuotaTotal()); err != nil {
+					c.logger.Debug("Failed to share rate limit state", "error", err)
+				}
+			}
 		}
 	}
 }
@@ -410,17 +572,99 @@ func (c *Client) GetQuotaTotal() float64 {
 	return c.quotaTotal
 }
 
-// cacheKey generates a unique cache key for the given path
+// cacheKey generates a unique cache key for the given path.
+// Keys are readable rather than hashed ("<base URL><path>[#pages][#as_user:<id>]")
+// so that entries under a resource path can be evicted by prefix after mutations.
 func (c *Client) cacheKey(path string) string {
-	// Include base URL and masquerade user to ensure unique keys per instance/user
-	key := c.baseURL + path
+	return c.baseURL + path + c.cacheKeySuffix()
+}
+
+// pagesCacheKey generates the cache key for the combined result of a paginated path
+func (c *Client) pagesCacheKey(path string) string {
+	return c.baseURL + path + "#pages" + c.cacheKeySuffix()
+}
+
+// cacheKeySuffix scopes cache keys to the masquerade user so entries are unique per user
+func (c *Client) cacheKeySuffix() string {
 	if c.asUserID > 0 {
-		key += fmt.Sprintf(":as_user:%d", c.asUserID)
+		return fmt.Sprintf("#as_user:%d", c.asUserID)
+	}
+	return ""
+}
+
+// invalidateCache evicts cached GET responses affected by a mutation of path.
+// Everything under the resource's collection is evicted, so updating
+// /api/v1/courses/1/assignments/5 also drops cached assignment lists for course 1.
+// Entries are evicted for all masquerade users since the data changed for everyone.
+// GraphQL requests are skipped: queries are POSTs that change nothing, and the
+// services that send mutations evict the REST resources they affect themselves.
+func (c *Client) invalidateCache(path string) {
+	if stripQuery(path) == graphQLPath {
+		return
 	}
 
-	// Hash the key to avoid issues with special characters
-	hash := md5.Sum([]byte(key))
-	return hex.EncodeToString(hash[:])
+	c.evictPrefix(resourcePrefix(path))
+}
+
+// evictPrefix evicts every cached response whose path starts with prefix
+func (c *Client) evictPrefix(prefix string) {
+	if c.cache == nil {
+		return
+	}
+
+	c.cache.DeletePrefix(c.baseURL + prefix)
+}
+
+// resourcePrefix returns the collection path a mutation applies to, with the
+// query string dropped:
+//   - a trailing resource identifier is dropped, so /courses/1/assignments/5
+//     maps to /courses/1/assignments
+//   - a trailing string key or action on a top-level context maps to the
+//     collection it belongs to, so /courses/1/tabs/people maps to
+//     /courses/1/tabs and /courses/1/features/flags/x to /courses/1/features
+//   - a trailing action on a nested resource maps to that resource's
+//     collection, so /courses/1/course_pacing/7/publish maps to
+//     /courses/1/course_pacing
+func resourcePrefix(path string) string {
+	path = stripQuery(path)
+	path = strings.TrimSuffix(path, "/")
+
+	segments := strings.Split(path, "/")
+	last := len(segments) - 1
+	if last > 0 && isResourceID(segments[last]) {
+		return strings.Join(segments[:last], "/")
+	}
+
+	// Find the context ID (the first one) and the innermost resource ID
+	// before the trailing segment
+	first, inner := -1, -1
+	for i := 0; i < last; i++ {
+		if isResourceID(segments[i]) {
+			if first < 0 {
+				first = i
+			}
+			inner = i
+		}
+	}
+
+	switch {
+	case inner < 0:
+		return path
+	case inner == first:
+		return strings.Join(segments[:inner+2], "/")
+	default:
+		return strings.Join(segments[:inner], "/")
+	}
+}
+
+// isResourceID reports whether a path segment identifies a single resource:
+// a numeric ID, "self", or an SIS-style ID such as sis_course_id:ABC
+func isResourceID(segment string) bool {
+	if segment == "self" || strings.Contains(segment, ":") {
+		return true
+	}
+	_, err := strconv.ParseInt(segment, 10, 64)
+	return err == nil
 }
 
 // IsCacheEnabled returns whether caching is enabled
@@ -437,6 +681,27 @@ func (c *Client) SetCacheEnabled(enabled bool) {
 	c.cacheEnabled = enabled && c.cache != nil
 }
 
+// noCacheKey marks a context whose reads bypass the response cache
+type noCacheKey struct{}
+
+// WithoutCache returns a context whose GET requests always go to the API and
+// skip the response cache, e.g. for polling a job. Other callers sharing the
+// client keep using the cache.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// useCache reports whether reads made with ctx go through the response cache
+func (c *Client) useCache(ctx context.Context) bool {
+	if bypass, _ := ctx.Value(noCacheKey{}).(bool); bypass {
+		return false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheEnabled && c.cache != nil
+}
+
 // ClearCache clears all cached responses
 func (c *Client) ClearCache() {
 	if c.cache != nil {
@@ -467,6 +732,11 @@ func (c *Client) Put(ctx context.Context, path string, body io.Reader) (*http.Re
 	return c.doRequest(ctx, http.MethodPut, path, body)
 }
 
+// Patch performs a PATCH request
+func (c *Client) Patch(ctx context.Context, path string, body io.Reader) (*http.Response, error) {
+	return c.doRequest(ctx, http.MethodPatch, path, body)
+}
+
 // Delete performs a DELETE request
 func (c *Client) Delete(ctx context.Context, path string) (*http.Response, error) {
 	return c.doRequest(ctx, http.MethodDelete, path, nil)
@@ -475,38 +745,166 @@ func (c *Client) Delete(ctx context.Context, path string) (*http.Response, error
 // GetJSON performs a GET request and decodes JSON response
 // If caching is enabled, cached responses will be returned when available
 func (c *Client) GetJSON(ctx context.Context, path string, result interface{}) error {
-	// Check cache first if enabled
-	if c.cacheEnabled && c.cache != nil {
-		key := c.cacheKey(path)
-		if err := c.cache.GetJSON(key, result); err == nil {
+	if !c.useCache(ctx) {
+		resp, err := c.Get(ctx, path)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+
+	key := c.cacheKey(path)
+	entry, ok := c.cache.GetEntry(key)
+	if ok && !entry.IsExpired() {
+		if err := json.Unmarshal(entry.Value, result); err == nil {
 			return nil // Cache hit
 		}
+		ok = false
+	}
+
+	// Serve the stale entry and refresh it for next time
+	if ok && c.staleWhileRevalidate {
+		if err := json.Unmarshal(entry.Value, result); err == nil {
+			c.refreshInBackground(key, path, entry, c.revalidate)
+			return nil
+		}
+		ok = false
+	}
+
+	if !ok {
+		entry = nil
 	}
 
-	resp, err := c.Get(ctx, path)
+	body, err := c.revalidate(ctx, key, path, entry)
 	if err != nil {
 		return err
 	}
+
+	return json.Unmarshal(body, result)
+}
+
+// revalidate fetches path and stores the response in the cache along with its
+// ETag and Last-Modified validators. When a previous entry has validators, the
+// request is made conditional; a 304 Not Modified only renews the entry, which
+// skips the response body and costs less of the Canvas rate-limit quota.
+func (c *Client) revalidate(ctx context.Context, key, path string, entry *cache.Entry) ([]byte, error) {
+	resp, err := c.doRequestWithHeaders(ctx, http.MethodGet, path, nil, conditionalHeaders(entry))
+	if err != nil {
+		return nil, err
+	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		c.renewEntry(key, entry, resp)
+		return entry.Value, nil
+	}
+
 	// Read the response body so we can cache it
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	// Decode the response
-	if err := json.Unmarshal(body, result); err != nil {
-		return err
+	if json.Valid(body) {
+		c.cache.SetWithValidators(key, body, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"))
+	}
+
+	return body, nil
+}
+
+// revalidatePages fetches every page of path and stores the combined items
+// in the cache along with the first page's validators. When a previous entry
+// has validators, the first page is requested conditionally and a 304 Not
+// Modified renews the combined entry without fetching the remaining pages.
+// Changes that only affect later pages are therefore picked up once the
+// first page changes or the entry is evicted.
+func (c *Client) revalidatePages(ctx context.Context, key, path string, entry *cache.Entry) ([]byte, error) {
+	resp, err := c.doRequestWithHeaders(ctx, http.MethodGet, path, nil, conditionalHeaders(entry))
+	if err != nil {
+		return nil, err
 	}
 
-	// Cache the response if caching is enabled
-	if c.cacheEnabled && c.cache != nil {
-		key := c.cacheKey(path)
-		c.cache.Set(key, body)
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		resp.Body.Close()
+		c.renewEntry(key, entry, resp)
+		return entry.Value, nil
 	}
 
-	return nil
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+
+	var items []json.RawMessage
+	for raw, err := range pagesFrom[json.RawMessage](c, ctx, path, resp) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, raw)
+	}
+
+	body, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pages: %w", err)
+	}
+
+	c.cache.SetWithValidators(key, body, etag, lastModified)
+	return body, nil
+}
+
+// conditionalHeaders returns the If-None-Match and If-Modified-Since headers
+// for revalidating entry, or nil if it has no validators
+func conditionalHeaders(entry *cache.Entry) http.Header {
+	if entry == nil || !entry.HasValidators() {
+		return nil
+	}
+
+	headers := make(http.Header)
+	if entry.ETag != "" {
+		headers.Set("If-None-Match", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		headers.Set("If-Modified-Since", entry.LastModified)
+	}
+	return headers
+}
+
+// renewEntry stores entry again after a 304 Not Modified, picking up any
+// validators the response replaced
+func (c *Client) renewEntry(key string, entry *cache.Entry, resp *http.Response) {
+	etag, lastModified := entry.ETag, entry.LastModified
+	if v := resp.Header.Get("ETag"); v != "" {
+		etag = v
+	}
+	if v := resp.Header.Get("Last-Modified"); v != "" {
+		lastModified = v
+	}
+	c.cache.SetWithValidators(key, entry.Value, etag, lastModified)
+}
+
+// refreshInBackground revalidates a stale cache entry without blocking the caller.
+// Only one refresh per key runs at a time.
+func (c *Client) refreshInBackground(key, path string, entry *cache.Entry, revalidate func(ctx context.Context, key, path string, entry *cache.Entry) ([]byte, error)) {
+	if _, inFlight := c.refreshing.LoadOrStore(key, struct{}{}); inFlight {
+		return
+	}
+
+	c.refreshes.Add(1)
+	go func() {
+		defer c.refreshes.Done()
+		defer c.refreshing.Delete(key)
+
+		ctx, cancel := context.WithTimeout(context.Background(), backgroundRefreshTimeout)
+		defer cancel()
+
+		if _, err := revalidate(ctx, key, path, entry); err != nil {
+			c.logger.Debug("Background cache refresh failed", "path", path, "error", err)
+		}
+	}()
+}
+
+// WaitForRefreshes blocks until background cache refreshes have finished
+func (c *Client) WaitForRefreshes() {
+	c.refreshes.Wait()
 }
 
 // PostJSON performs a POST request with JSON body and decodes JSON response
@@ -549,72 +947,50 @@ func (c *Client) PutJSON(ctx context.Context, path string, body interface{}, res
 	return nil
 }
 
+// PatchJSON performs a PATCH request with JSON body and decodes JSON response
+func (c *Client) PatchJSON(ctx context.Context, path string, body interface{}, result interface{}) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	resp, err := c.Patch(ctx, path, bytes.NewReader(jsonBody))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+
+	return nil
+}
+
 // GetAllPagesGeneric fetches all pages of a paginated endpoint using generics
 // This is the preferred method for type-safe pagination with better performance
 // If caching is enabled, cached responses will be returned when available
 // If maxResults is set, stops fetching when limit is reached
 func GetAllPagesGeneric[T any](c *Client, ctx context.Context, path string) ([]T, error) {
-	// Check cache first if enabled (only if no limit set, as cached results might exceed limit)
-	if c.cacheEnabled && c.cache != nil && c.maxResults == 0 {
-		key := c.cacheKey("pages:" + path)
-		var cached []T
-		if err := c.cache.GetJSON(key, &cached); err == nil {
-			return cached, nil // Cache hit
+	// Cached results might exceed the limit, so only use the cache without one
+	if c.useCache(ctx) && c.maxResults == 0 {
+		var results []T
+		err := c.getAllPagesCached(ctx, path, func(body []byte) error {
+			results = nil
+			return json.Unmarshal(body, &results)
+		})
+		if err != nil {
+			return nil, err
 		}
+		return results, nil
 	}
 
 	var allResults []T
-	currentURL := path
-
-	for currentURL != "" {
-		resp, err := c.Get(ctx, currentURL)
+	for item, err := range Pages[T](c, ctx, path) {
 		if err != nil {
 			return nil, err
 		}
-
-		var pageResults []T
-		if err := json.NewDecoder(resp.Body).Decode(&pageResults); err != nil {
-			resp.Body.Close()
-			return nil, fmt.Errorf("failed to decode response: %w", err)
-		}
-		resp.Body.Close()
-
-		allResults = append(allResults, pageResults...)
-
-		// Check if we've reached the limit
-		if c.maxResults > 0 && len(allResults) >= c.maxResults {
-			// Truncate to exact limit
-			allResults = allResults[:c.maxResults]
-			break
-		}
-
-		// Check for next page
-		links := ParsePaginationLinks(resp)
-		if links.HasNextPage() {
-			// Extract path from full URL
-			nextURL, err := url.Parse(links.Next)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse next URL: %w", err)
-			}
-			// Handle empty query string properly to avoid trailing '?'
-			if nextURL.RawQuery != "" {
-				currentURL = nextURL.Path + "?" + nextURL.RawQuery
-			} else {
-				currentURL = nextURL.Path
-			}
-		} else {
-			currentURL = ""
-		}
-	}
-
-	// Cache the combined result if caching is enabled
-	if c.cacheEnabled && c.cache != nil {
-		key := c.cacheKey("pages:" + path)
-		// Marshal for caching
-		allJSON, err := json.Marshal(allResults)
-		if err == nil {
-			c.cache.Set(key, allJSON)
-		}
+		allResults = append(allResults, item)
 	}
 
 	return allResults, nil
@@ -626,69 +1002,28 @@ func GetAllPagesGeneric[T any](c *Client, ctx context.Context, path string) ([]T
 // If caching is enabled, cached responses will be returned when available
 // If maxResults is set, stops fetching when limit is reached
 func (c *Client) GetAllPages(ctx context.Context, path string, result interface{}) error {
-	// Check cache first if enabled (only if no limit set, as cached results might exceed limit)
-	if c.cacheEnabled && c.cache != nil && c.maxResults == 0 {
-		key := c.cacheKey("pages:" + path)
-		if err := c.cache.GetJSON(key, result); err == nil {
-			return nil // Cache hit
-		}
+	resultValue := reflect.ValueOf(result)
+	if resultValue.Kind() != reflect.Ptr || resultValue.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("result must be a pointer to a slice")
 	}
 
-	var allResults []json.RawMessage
-	currentURL := path
-
-	for currentURL != "" {
-		resp, err := c.Get(ctx, currentURL)
-		if err != nil {
-			return err
-		}
-
-		var pageResults []json.RawMessage
-		if err := json.NewDecoder(resp.Body).Decode(&pageResults); err != nil {
-			resp.Body.Close()
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
-		resp.Body.Close()
-
-		allResults = append(allResults, pageResults...)
-
-		// Check if we've reached the limit
-		if c.maxResults > 0 && len(allResults) >= c.maxResults {
-			// Truncate to exact limit
-			allResults = allResults[:c.maxResults]
-			break
-		}
-
-		// Check for next page
-		links := ParsePaginationLinks(resp)
-		if links.HasNextPage() {
-			// Extract path from full URL
-			nextURL, err := url.Parse(links.Next)
-			if err != nil {
-				return fmt.Errorf("failed to parse next URL: %w", err)
-			}
-			// Handle empty query string properly to avoid trailing '?'
-			if nextURL.RawQuery != "" {
-				currentURL = nextURL.Path + "?" + nextURL.RawQuery
-			} else {
-				currentURL = nextURL.Path
-			}
-		} else {
-			currentURL = ""
-		}
+	// Cached results might exceed the limit, so only use the cache without one
+	if c.useCache(ctx) && c.maxResults == 0 {
+		return c.getAllPagesCached(ctx, path, func(body []byte) error {
+			return json.Unmarshal(body, result)
+		})
 	}
 
 	// Use reflection to directly append results to the slice
 	// This avoids unnecessary marshal/unmarshal round-trip
-	resultValue := reflect.ValueOf(result)
-	if resultValue.Kind() != reflect.Ptr || resultValue.Elem().Kind() != reflect.Slice {
-		return fmt.Errorf("result must be a pointer to a slice")
-	}
-
 	sliceValue := resultValue.Elem()
 	elemType := sliceValue.Type().Elem()
 
-	for _, raw := range allResults {
+	for raw, err := range Pages[json.RawMessage](c, ctx, path) {
+		if err != nil {
+			return err
+		}
+
 		// Create a new element of the slice's element type
 		elem := reflect.New(elemType)
 		if err := json.Unmarshal(raw, elem.Interface()); err != nil {
@@ -700,15 +1035,38 @@ func (c *Client) GetAllPages(ctx context.Context, path string, result interface{
 	// Set the slice back to the result pointer
 	resultValue.Elem().Set(sliceValue)
 
-	// Cache the combined result if caching is enabled
-	if c.cacheEnabled && c.cache != nil {
-		key := c.cacheKey("pages:" + path)
-		// Marshal once for caching only
-		allJSON, err := json.Marshal(allResults)
-		if err == nil {
-			c.cache.Set(key, allJSON)
+	return nil
+}
+
+// getAllPagesCached decodes all pages of path from the cache, revalidating
+// expired entries the same way GetJSON does
+func (c *Client) getAllPagesCached(ctx context.Context, path string, decode func([]byte) error) error {
+	key := c.pagesCacheKey(path)
+	entry, ok := c.cache.GetEntry(key)
+	if ok && !entry.IsExpired() {
+		if err := decode(entry.Value); err == nil {
+			return nil // Cache hit
 		}
+		ok = false
 	}
 
-	return nil
+	// Serve the stale entry and refresh it for next time
+	if ok && c.staleWhileRevalidate {
+		if err := decode(entry.Value); err == nil {
+			c.refreshInBackground(key, path, entry, c.revalidatePages)
+			return nil
+		}
+		ok = false
+	}
+
+	if !ok {
+		entry = nil
+	}
+
+	body, err := c.revalidatePages(ctx, key, path, entry)
+	if err != nil {
+		return err
+	}
+
+	return decode(body)
 }
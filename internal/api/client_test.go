@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -295,6 +297,370 @@ func TestClient_GetJSON_WithCache(t *testing.T) {
 	}
 }
 
+// prefixCountingCache counts DeletePrefix calls
+type prefixCountingCache struct {
+	cache.CacheInterface
+	deletes int32
+}
+
+func (c *prefixCountingCache) DeletePrefix(prefix string) {
+	atomic.AddInt32(&c.deletes, 1)
+	c.CacheInterface.DeletePrefix(prefix)
+}
+
+func TestClient_GraphQLSkipsInvalidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"course":{"id":"1"}}}`))
+	}))
+	defer server.Close()
+
+	counting := &prefixCountingCache{CacheInterface: cache.New(5 * time.Minute)}
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		Cache:          counting,
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := NewGraphQLService(client).Query(context.Background(), &GraphQLRequest{Query: "{ course(id: 1) { id } }"}); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	if deletes := atomic.LoadInt32(&counting.deletes); deletes != 0 {
+		t.Errorf("Expected no cache eviction for GraphQL, got %d", deletes)
+	}
+}
+
+func TestClient_MutationInvalidatesCache(t *testing.T) {
+	var requestCount int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/courses/1/assignments":
+			atomic.AddInt32(&requestCount, 1)
+			w.Write([]byte(`[{"id":5,"name":"Essay"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/courses/2/assignments":
+			atomic.AddInt32(&requestCount, 1)
+			w.Write([]byte(`[{"id":6,"name":"Quiz"}]`))
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/courses/1/assignments/5":
+			w.Write([]byte(`{"id":5,"name":"Essay v2"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		Cache:          cache.New(5 * time.Minute),
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	var assignments []Assignment
+
+	for _, path := range []string{"/api/v1/courses/1/assignments", "/api/v1/courses/2/assignments"} {
+		if err := client.GetAllPages(ctx, path, &assignments); err != nil {
+			t.Fatalf("GetAllPages failed: %v", err)
+		}
+	}
+	if got := atomic.LoadInt32(&requestCount); got != 2 {
+		t.Fatalf("Expected 2 requests, got %d", got)
+	}
+
+	if err := client.PutJSON(ctx, "/api/v1/courses/1/assignments/5", map[string]string{"name": "Essay v2"}, nil); err != nil {
+		t.Fatalf("PutJSON failed: %v", err)
+	}
+
+	// Course 1 assignments were evicted, course 2 is still cached
+	for _, path := range []string{"/api/v1/courses/1/assignments", "/api/v1/courses/2/assignments"} {
+		if err := client.GetAllPages(ctx, path, &assignments); err != nil {
+			t.Fatalf("GetAllPages failed: %v", err)
+		}
+	}
+	if got := atomic.LoadInt32(&requestCount); got != 3 {
+		t.Errorf("Expected 3 requests after invalidation, got %d", got)
+	}
+}
+
+func TestClient_GetJSON_ConditionalRequest(t *testing.T) {
+	var fullResponses, notModified int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		atomic.AddInt32(&fullResponses, 1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"id":123,"name":"Test Course"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		Cache:          cache.New(10 * time.Millisecond),
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	var course Course
+	if err := client.GetJSON(ctx, "/api/v1/courses/123", &course); err != nil {
+		t.Fatalf("GetJSON failed: %v", err)
+	}
+
+	// Let the entry expire, then revalidate
+	time.Sleep(20 * time.Millisecond)
+
+	course = Course{}
+	if err := client.GetJSON(ctx, "/api/v1/courses/123", &course); err != nil {
+		t.Fatalf("GetJSON after expiry failed: %v", err)
+	}
+	if course.Name != "Test Course" {
+		t.Errorf("Expected cached body after 304, got %+v", course)
+	}
+
+	if full, nm := atomic.LoadInt32(&fullResponses), atomic.LoadInt32(&notModified); full != 1 || nm != 1 {
+		t.Errorf("Expected 1 full response and 1 not-modified, got %d and %d", full, nm)
+	}
+
+	// The 304 renewed the entry, so the next call is a cache hit
+	if err := client.GetJSON(ctx, "/api/v1/courses/123", &course); err != nil {
+		t.Fatalf("GetJSON failed: %v", err)
+	}
+	if nm := atomic.LoadInt32(&notModified); nm != 1 {
+		t.Errorf("Expected renewed entry to be served from cache, got %d revalidations", nm)
+	}
+}
+
+func TestClient_GetJSON_StaleWhileRevalidate(t *testing.T) {
+	var version int32 = 1
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		v := atomic.LoadInt32(&version)
+		w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, v))
+		fmt.Fprintf(w, `{"id":123,"name":"Course v%d"}`, v)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:              server.URL,
+		Token:                "test-token",
+		RequestsPerSec:       100,
+		Cache:                cache.New(10 * time.Millisecond),
+		CacheEnabled:         true,
+		StaleWhileRevalidate: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	var course Course
+	if err := client.GetJSON(ctx, "/api/v1/courses/123", &course); err != nil {
+		t.Fatalf("GetJSON failed: %v", err)
+	}
+
+	atomic.StoreInt32(&version, 2)
+	time.Sleep(20 * time.Millisecond)
+
+	// Stale value is returned immediately
+	if err := client.GetJSON(ctx, "/api/v1/courses/123", &course); err != nil {
+		t.Fatalf("GetJSON failed: %v", err)
+	}
+	if course.Name != "Course v1" {
+		t.Errorf("Expected stale value, got %q", course.Name)
+	}
+
+	client.WaitForRefreshes()
+
+	if err := client.GetJSON(ctx, "/api/v1/courses/123", &course); err != nil {
+		t.Fatalf("GetJSON failed: %v", err)
+	}
+	if course.Name != "Course v2" {
+		t.Errorf("Expected refreshed value, got %q", course.Name)
+	}
+}
+
+func TestClient_GetAllPages_Revalidation(t *testing.T) {
+	var fullResponses, notModified, secondPages int32
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		if r.URL.Query().Get("page") == "2" {
+			atomic.AddInt32(&secondPages, 1)
+			w.Write([]byte(`[{"id":2,"name":"Course 2"}]`))
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		atomic.AddInt32(&fullResponses, 1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Link", fmt.Sprintf(`<%s/api/v1/courses?page=2>; rel="next"`, server.URL))
+		w.Write([]byte(`[{"id":1,"name":"Course 1"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		Cache:          cache.New(10 * time.Millisecond),
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	courses, err := GetAllPagesGeneric[Course](client, ctx, "/api/v1/courses")
+	if err != nil {
+		t.Fatalf("GetAllPagesGeneric failed: %v", err)
+	}
+	if len(courses) != 2 {
+		t.Fatalf("Expected 2 courses, got %+v", courses)
+	}
+
+	// Let the entry expire, then revalidate the first page only
+	time.Sleep(20 * time.Millisecond)
+
+	var again []Course
+	if err := client.GetAllPages(ctx, "/api/v1/courses", &again); err != nil {
+		t.Fatalf("GetAllPages after expiry failed: %v", err)
+	}
+	if len(again) != 2 || again[1].Name != "Course 2" {
+		t.Errorf("Expected cached pages after 304, got %+v", again)
+	}
+
+	full, nm, second := atomic.LoadInt32(&fullResponses), atomic.LoadInt32(&notModified), atomic.LoadInt32(&secondPages)
+	if full != 1 || nm != 1 || second != 1 {
+		t.Errorf("Expected 1 full response, 1 not-modified and 1 second page, got %d, %d and %d", full, nm, second)
+	}
+}
+
+func TestClient_GetAllPages_StaleWhileRevalidate(t *testing.T) {
+	var version int32 = 1
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		v := atomic.LoadInt32(&version)
+		w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, v))
+		fmt.Fprintf(w, `[{"id":123,"name":"Course v%d"}]`, v)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:              server.URL,
+		Token:                "test-token",
+		RequestsPerSec:       100,
+		Cache:                cache.New(10 * time.Millisecond),
+		CacheEnabled:         true,
+		StaleWhileRevalidate: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := GetAllPagesGeneric[Course](client, ctx, "/api/v1/courses"); err != nil {
+		t.Fatalf("GetAllPagesGeneric failed: %v", err)
+	}
+
+	atomic.StoreInt32(&version, 2)
+	time.Sleep(20 * time.Millisecond)
+
+	// Stale value is returned immediately
+	courses, err := GetAllPagesGeneric[Course](client, ctx, "/api/v1/courses")
+	if err != nil {
+		t.Fatalf("GetAllPagesGeneric failed: %v", err)
+	}
+	if courses[0].Name != "Course v1" {
+		t.Errorf("Expected stale value, got %q", courses[0].Name)
+	}
+
+	client.WaitForRefreshes()
+
+	courses, err = GetAllPagesGeneric[Course](client, ctx, "/api/v1/courses")
+	if err != nil {
+		t.Fatalf("GetAllPagesGeneric failed: %v", err)
+	}
+	if courses[0].Name != "Course v2" {
+		t.Errorf("Expected refreshed value, got %q", courses[0].Name)
+	}
+}
+
+func TestResourcePrefix(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/api/v1/courses/1/assignments/5", "/api/v1/courses/1/assignments"},
+		{"/api/v1/courses/1/assignments", "/api/v1/courses/1/assignments"},
+		{"/api/v1/courses/1/assignments?include[]=x", "/api/v1/courses/1/assignments"},
+		{"/api/v1/courses/sis_course_id:ABC", "/api/v1/courses"},
+		{"/api/v1/users/self/", "/api/v1/users"},
+		{"/api/v1/courses", "/api/v1/courses"},
+		// String keys evict their collection
+		{"/api/v1/courses/1/tabs/people", "/api/v1/courses/1/tabs"},
+		{"/api/v1/courses/1/features/flags/new_gradebook", "/api/v1/courses/1/features"},
+		{"/api/v1/users/self/communication_channels", "/api/v1/users/self/communication_channels"},
+		// Actions on nested resources evict the resource's collection
+		{"/api/v1/courses/1/course_pacing/7/publish", "/api/v1/courses/1/course_pacing"},
+		{"/api/v1/courses/1/assignments/5/provisional_grades/publish", "/api/v1/courses/1/assignments"},
+		{"/api/v1/courses/1/assignments/5/submissions/update_grades", "/api/v1/courses/1/assignments"},
+		{"/api/v1/courses/1/assignments/5/provisional_grades/9/select", "/api/v1/courses/1/assignments/5/provisional_grades"},
+	}
+
+	for _, tt := range tests {
+		if got := resourcePrefix(tt.path); got != tt.expected {
+			t.Errorf("resourcePrefix(%q) = %q, expected %q", tt.path, got, tt.expected)
+		}
+	}
+}
+
 func TestClient_GetJSON_CacheDisabled(t *testing.T) {
 	var requestCount int32
 
@@ -352,6 +718,59 @@ func TestClient_GetJSON_CacheDisabled(t *testing.T) {
 	}
 }
 
+func TestClient_GetJSON_WithoutCache(t *testing.T) {
+	var requestCount int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		if r.URL.Path == "/api/v1/courses/456" {
+			atomic.AddInt32(&requestCount, 1)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":456,"name":"Test Course"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 10,
+		Cache:          cache.New(5 * time.Minute),
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+
+	var course Course
+	if err := client.GetJSON(ctx, "/api/v1/courses/456", &course); err != nil {
+		t.Fatalf("Cached GetJSON failed: %v", err)
+	}
+
+	// Bypassing the cache reaches the server without disabling the cache
+	// for other callers
+	if err := client.GetJSON(WithoutCache(ctx), "/api/v1/courses/456", &course); err != nil {
+		t.Fatalf("Uncached GetJSON failed: %v", err)
+	}
+	if err := client.GetJSON(ctx, "/api/v1/courses/456", &course); err != nil {
+		t.Fatalf("Cached GetJSON failed: %v", err)
+	}
+
+	if count := atomic.LoadInt32(&requestCount); count != 2 {
+		t.Errorf("Expected 2 requests, got %d", count)
+	}
+	if !client.IsCacheEnabled() {
+		t.Error("Expected the cache to stay enabled")
+	}
+}
+
 func TestClient_CacheHelpers(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/api/v1/accounts" {
@@ -761,3 +1180,82 @@ func BenchmarkGetAllPages_LargeDataset_Generics(b *testing.B) {
 		}
 	}
 }
+
+func TestClient_RetryReplaysRequestBody(t *testing.T) {
+	var attempts int32
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":5}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.Put(context.Background(), "/api/v1/courses/1/assignments/5", strings.NewReader(`{"name":"Essay"}`))
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(bodies) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"name":"Essay"}` {
+			t.Errorf("attempt %d sent body %q, want the full request body", i+1, body)
+		}
+	}
+}
+
+func TestClient_PostNotRetriedOnServerError(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.Post(context.Background(), "/api/v1/courses/1/assignments", strings.NewReader(`{}`))
+	if err == nil {
+		t.Fatal("expected error for 502 response")
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("expected POST to be sent once, got %d attempts", got)
+	}
+}
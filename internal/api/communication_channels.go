@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// NotificationFrequencies are the valid notification preference frequencies
+var NotificationFrequencies = []string{"immediately", "daily", "weekly", "never"}
+
+// CommunicationChannelsService handles communication channel and
+// notification preference API calls
+type CommunicationChannelsService struct {
+	client *Client
+}
+
+// NewCommunicationChannelsService creates a new communication channels service
+func NewCommunicationChannelsService(client *Client) *CommunicationChannelsService {
+	return &CommunicationChannelsService{client: client}
+}
+
+// CommunicationChannel represents a way to contact a user (email, sms, push)
+type CommunicationChannel struct {
+	ID            int64  `json:"id"`
+	Address       string `json:"address"`
+	Type          string `json:"type"`
+	Position      int    `json:"position"`
+	UserID        int64  `json:"user_id"`
+	WorkflowState string `json:"workflow_state"`
+}
+
+// NotificationPreference is how often a notification is sent to a channel
+type NotificationPreference struct {
+	Notification string `json:"notification"`
+	Category     string `json:"category"`
+	Frequency    string `json:"frequency"`
+}
+
+// userSegment returns the users path segment, using "self" for userID 0
+func userSegment(userID int64) string {
+	if userID > 0 {
+		return strconv.FormatInt(userID, 10)
+	}
+	return "self"
+}
+
+// List retrieves a user's communication channels. A userID of 0 means the current user.
+func (s *CommunicationChannelsService) List(ctx context.Context, userID int64) ([]CommunicationChannel, error) {
+	path := fmt.Sprintf("/api/v1/users/%s/communication_channels", userSegment(userID))
+
+	var channels []CommunicationChannel
+	if err := s.client.GetAllPages(ctx, path, &channels); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// CreateChannelParams holds parameters for creating a communication channel
+type CreateChannelParams struct {
+	Address          string
+	Type             string // email, sms, push
+	SkipConfirmation bool   // Requires account admin rights
+}
+
+// Create adds a communication channel to a user
+func (s *CommunicationChannelsService) Create(ctx context.Context, userID int64, params *CreateChannelParams) (*CommunicationChannel, error) {
+	path := fmt.Sprintf("/api/v1/users/%s/communication_channels", userSegment(userID))
+
+	body := map[string]interface{}{
+		"communication_channel": map[string]interface{}{
+			"address": params.Address,
+			"type":    params.Type,
+		},
+	}
+	if params.SkipConfirmation {
+		body["skip_confirmation"] = true
+	}
+
+	var channel CommunicationChannel
+	if err := s.client.PostJSON(ctx, path, body, &channel); err != nil {
+		return nil, err
+	}
+
+	return &channel, nil
+}
+
+// Delete removes a communication channel from a user
+func (s *CommunicationChannelsService) Delete(ctx context.Context, userID, channelID int64) (*CommunicationChannel, error) {
+	path := fmt.Sprintf("/api/v1/users/%s/communication_channels/%d", userSegment(userID), channelID)
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var channel CommunicationChannel
+	if err := json.NewDecoder(resp.Body).Decode(&channel); err != nil {
+		return nil, err
+	}
+
+	return &channel, nil
+}
+
+// ListPreferences retrieves the notification preferences of a channel
+func (s *CommunicationChannelsService) ListPreferences(ctx context.Context, userID, channelID int64) ([]NotificationPreference, error) {
+	path := fmt.Sprintf("/api/v1/users/%s/communication_channels/%d/notification_preferences", userSegment(userID), channelID)
+
+	var response struct {
+		NotificationPreferences []NotificationPreference `json:"notification_preferences"`
+	}
+	if err := s.client.GetJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	return response.NotificationPreferences, nil
+}
+
+// ListPreferenceCategories retrieves the notification categories of a channel
+func (s *CommunicationChannelsService) ListPreferenceCategories(ctx context.Context, userID, channelID int64) ([]string, error) {
+	path := fmt.Sprintf("/api/v1/users/%s/communication_channels/%d/notification_preference_categories", userSegment(userID), channelID)
+
+	var response struct {
+		Categories []string `json:"categories"`
+	}
+	if err := s.client.GetJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Categories, nil
+}
+
+// UpdateCategory sets the frequency of every notification in a category
+func (s *CommunicationChannelsService) UpdateCategory(ctx context.Context, userID, channelID int64, category, frequency string) ([]NotificationPreference, error) {
+	resource := "notification_preference_categories/" + url.PathEscape(category)
+
+	body := map[string]interface{}{
+		"notification_preferences": map[string]interface{}{
+			"frequency": frequency,
+		},
+	}
+
+	return s.updatePreferences(ctx, userID, channelID, resource, body)
+}
+
+// UpdatePreferences sets the frequency of individual notifications, keyed by
+// notification name
+func (s *CommunicationChannelsService) UpdatePreferences(ctx context.Context, userID, channelID int64, frequencies map[string]string) ([]NotificationPreference, error) {
+	preferences := make(map[string]interface{}, len(frequencies))
+	for notification, frequency := range frequencies {
+		preferences[notification] = map[string]interface{}{
+			"frequency": frequency,
+		}
+	}
+
+	body := map[string]interface{}{
+		"notification_preferences": preferences,
+	}
+
+	return s.updatePreferences(ctx, userID, channelID, "notification_preferences", body)
+}
+
+func (s *CommunicationChannelsService) updatePreferences(ctx context.Context, userID, channelID int64, resource string, body map[string]interface{}) ([]NotificationPreference, error) {
+	path, err := s.preferencesPath(userID, channelID, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		NotificationPreferences []NotificationPreference `json:"notification_preferences"`
+	}
+	if err := s.client.PutJSON(ctx, path, body, &response); err != nil {
+		return nil, err
+	}
+
+	// The update went through /users/self, so evict the user's own paths
+	// that ListPreferences reads from
+	if userID > 0 {
+		s.client.invalidateCache(fmt.Sprintf("/api/v1/users/%d/communication_channels/%d", userID, channelID))
+	}
+
+	return response.NotificationPreferences, nil
+}
+
+// preferencesPath builds a preference update path. Canvas only accepts
+// updates for the current user, so other users are updated by masquerading
+// as them, which requires the "Act as users" permission. A client that
+// already masquerades can only update the user it acts as.
+func (s *CommunicationChannelsService) preferencesPath(userID, channelID int64, resource string) (string, error) {
+	path := fmt.Sprintf("/api/v1/users/self/communication_channels/%d/%s", channelID, resource)
+
+	switch {
+	case userID <= 0 || userID == s.client.asUserID:
+		return path, nil
+	case s.client.asUserID > 0:
+		return "", fmt.Errorf("cannot update notification preferences of user %d while masquerading as user %d", userID, s.client.asUserID)
+	default:
+		return path + "?as_user_id=" + strconv.FormatInt(userID, 10), nil
+	}
+}
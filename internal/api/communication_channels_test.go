@@ -0,0 +1,214 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jjuanrivvera/canvas-cli/internal/cache"
+)
+
+func TestCommunicationChannelsService_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/users/self/communication_channels" {
+			t.Errorf("Expected path /api/v1/users/self/communication_channels, got %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`[{"id":11,"address":"me@example.edu","type":"email","position":1,"workflow_state":"active"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewCommunicationChannelsService(client)
+	channels, err := service.List(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if len(channels) != 1 || channels[0].Address != "me@example.edu" {
+		t.Errorf("Unexpected channels: %+v", channels)
+	}
+}
+
+func TestCommunicationChannelsService_UpdatePreferencesAsUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/users/self/communication_channels/11/notification_preferences" {
+			t.Errorf("Expected PUT to self preferences, got %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("as_user_id"); got != "101" {
+			t.Errorf("Expected as_user_id=101, got %q", got)
+		}
+
+		var body struct {
+			NotificationPreferences map[string]map[string]string `json:"notification_preferences"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if body.NotificationPreferences["new_announcement"]["frequency"] != "daily" {
+			t.Errorf("Unexpected body: %+v", body)
+		}
+
+		w.Write([]byte(`{"notification_preferences":[{"notification":"new_announcement","category":"announcement","frequency":"daily"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewCommunicationChannelsService(client)
+	preferences, err := service.UpdatePreferences(context.Background(), 101, 11, map[string]string{"new_announcement": "daily"})
+	if err != nil {
+		t.Fatalf("UpdatePreferences failed: %v", err)
+	}
+
+	if len(preferences) != 1 || preferences[0].Frequency != "daily" {
+		t.Errorf("Unexpected preferences: %+v", preferences)
+	}
+}
+
+func TestCommunicationChannelsService_UpdateCategory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/users/self/communication_channels/11/notification_preference_categories/due_date" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Has("as_user_id") {
+			t.Errorf("Expected no masquerading for the current user, got %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"notification_preferences":[{"notification":"assignment_due_date_changed","category":"due_date","frequency":"weekly"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewCommunicationChannelsService(client)
+	if _, err := service.UpdateCategory(context.Background(), 0, 11, "due_date", "weekly"); err != nil {
+		t.Fatalf("UpdateCategory failed: %v", err)
+	}
+}
+
+func TestCommunicationChannelsService_UpdatePreferencesEvictsUserCache(t *testing.T) {
+	frequency := "never"
+	reads := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/accounts":
+			handleVersionDetection(w)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/users/101/communication_channels/11/notification_preferences":
+			reads++
+			w.Write([]byte(`{"notification_preferences":[{"notification":"new_announcement","frequency":"` + frequency + `"}]}`))
+		case r.Method == http.MethodPut:
+			frequency = "daily"
+			w.Write([]byte(`{"notification_preferences":[{"notification":"new_announcement","frequency":"daily"}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		Cache:          cache.New(5 * time.Minute),
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewCommunicationChannelsService(client)
+	ctx := context.Background()
+
+	if _, err := service.ListPreferences(ctx, 101, 11); err != nil {
+		t.Fatalf("ListPreferences failed: %v", err)
+	}
+	if _, err := service.UpdatePreferences(ctx, 101, 11, map[string]string{"new_announcement": "daily"}); err != nil {
+		t.Fatalf("UpdatePreferences failed: %v", err)
+	}
+
+	preferences, err := service.ListPreferences(ctx, 101, 11)
+	if err != nil {
+		t.Fatalf("ListPreferences failed: %v", err)
+	}
+	if reads != 2 || preferences[0].Frequency != "daily" {
+		t.Errorf("Expected a fresh read after the update, got %d reads and %+v", reads, preferences)
+	}
+}
+
+func TestCommunicationChannelsService_UpdatePreferencesMasqueradeConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if got := r.URL.Query().Get("as_user_id"); got != "101" {
+			t.Errorf("Expected as_user_id=101, got %q", got)
+		}
+
+		w.Write([]byte(`{"notification_preferences":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		AsUserID:       101,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewCommunicationChannelsService(client)
+	ctx := context.Background()
+
+	if _, err := service.UpdateCategory(ctx, 101, 11, "due_date", "weekly"); err != nil {
+		t.Errorf("Expected the masqueraded user to be updated, got %v", err)
+	}
+	if _, err := service.UpdateCategory(ctx, 202, 11, "due_date", "weekly"); err == nil {
+		t.Error("Expected an error updating a user other than the masqueraded one")
+	}
+}
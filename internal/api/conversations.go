@@ -348,8 +348,9 @@ type BatchUpdateParams struct {
 	Event           string // mark_as_read, mark_as_unread, star, unstar, archive, destroy
 }
 
-// BatchUpdate updates multiple conversations at once
-func (s *ConversationsService) BatchUpdate(ctx context.Context, params *BatchUpdateParams) (*BatchUpdateProgress, error) {
+// BatchUpdate updates multiple conversations at once.
+// Canvas processes the update asynchronously and returns a Progress object.
+func (s *ConversationsService) BatchUpdate(ctx context.Context, params *BatchUpdateParams) (*JobProgress, error) {
 	path := "/api/v1/conversations"
 
 	ids := make([]string, len(params.ConversationIDs))
@@ -362,7 +363,7 @@ func (s *ConversationsService) BatchUpdate(ctx context.Context, params *BatchUpd
 		"event":            params.Event,
 	}
 
-	var progress BatchUpdateProgress
+	var progress JobProgress
 	if err := s.client.PutJSON(ctx, path, body, &progress); err != nil {
 		return nil, err
 	}
@@ -370,24 +371,6 @@ func (s *ConversationsService) BatchUpdate(ctx context.Context, params *BatchUpd
 	return &progress, nil
 }
 
-// BatchUpdateProgress represents the progress of a batch update operation
-type BatchUpdateProgress struct {
-	Progress *ConversationProgress `json:"progress,omitempty"`
-}
-
-// ConversationProgress represents progress for a conversation batch operation
-type ConversationProgress struct {
-	ID            int64   `json:"id"`
-	ContextID     int64   `json:"context_id"`
-	ContextType   string  `json:"context_type"`
-	UserID        int64   `json:"user_id"`
-	Tag           string  `json:"tag"`
-	Completion    float64 `json:"completion"`
-	WorkflowState string  `json:"workflow_state"`
-	URL           string  `json:"url"`
-	Message       string  `json:"message,omitempty"`
-}
-
 // GetUnreadCount retrieves the unread conversation count
 func (s *ConversationsService) GetUnreadCount(ctx context.Context) (*UnreadCount, error) {
 	path := "/api/v1/conversations/unread_count"
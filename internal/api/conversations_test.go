@@ -507,11 +507,9 @@ func TestConversationsService_BatchUpdate(t *testing.T) {
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(BatchUpdateProgress{
-			Progress: &ConversationProgress{
-				ID:            1,
-				WorkflowState: "completed",
-			},
+		json.NewEncoder(w).Encode(JobProgress{
+			ID:            1,
+			WorkflowState: "completed",
 		})
 	}))
 	defer server.Close()
@@ -535,12 +533,8 @@ func TestConversationsService_BatchUpdate(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if progress.Progress == nil {
-		t.Fatal("expected progress in response")
-	}
-
-	if progress.Progress.WorkflowState != "completed" {
-		t.Errorf("expected workflow_state 'completed', got %s", progress.Progress.WorkflowState)
+	if !progress.IsCompleted() {
+		t.Errorf("expected workflow_state 'completed', got %s", progress.WorkflowState)
 	}
 }
 
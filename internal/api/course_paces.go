@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// CoursePacesService handles Course Pacing API calls
+type CoursePacesService struct {
+	client *Client
+}
+
+// NewCoursePacesService creates a new course paces service
+func NewCoursePacesService(client *Client) *CoursePacesService {
+	return &CoursePacesService{client: client}
+}
+
+// CoursePace represents the pace of a course, section or student. Section
+// and student paces that were never saved have an ID of 0.
+type CoursePace struct {
+	ID                 int64              `json:"id"`
+	CourseID           int64              `json:"course_id"`
+	CourseSectionID    int64              `json:"course_section_id,omitempty"`
+	UserID             int64              `json:"user_id,omitempty"`
+	WorkflowState      string             `json:"workflow_state"`
+	StartDate          string             `json:"start_date,omitempty"`
+	EndDate            string             `json:"end_date,omitempty"`
+	ExcludeWeekends    bool               `json:"exclude_weekends"`
+	SelectedDaysToSkip []string           `json:"selected_days_to_skip,omitempty"`
+	HardEndDates       bool               `json:"hard_end_dates"`
+	PublishedAt        *time.Time         `json:"published_at,omitempty"`
+	CreatedAt          *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time         `json:"updated_at,omitempty"`
+	Modules            []CoursePaceModule `json:"modules,omitempty"`
+}
+
+// CoursePaceModule is a course module as laid out in a pace
+type CoursePaceModule struct {
+	ID       int64                  `json:"id"`
+	Name     string                 `json:"name"`
+	Position int                    `json:"position"`
+	Items    []CoursePaceModuleItem `json:"items"`
+}
+
+// CoursePaceModuleItem is the number of days a pace gives to a module item
+type CoursePaceModuleItem struct {
+	ID              int64   `json:"id"`
+	ModuleItemID    int64   `json:"module_item_id"`
+	Duration        int     `json:"duration"`
+	Position        int     `json:"position"`
+	AssignmentTitle string  `json:"assignment_title"`
+	ModuleItemType  string  `json:"module_item_type"`
+	PointsPossible  float64 `json:"points_possible"`
+	Published       bool    `json:"published"`
+}
+
+// CoursePaceItemDuration sets the duration of a module item in a pace. ID is
+// the pace item ID and is only needed when updating an existing pace.
+type CoursePaceItemDuration struct {
+	ID           int64 `json:"id,omitempty"`
+	ModuleItemID int64 `json:"module_item_id"`
+	Duration     int   `json:"duration"` // Days
+}
+
+// CoursePaceParams holds parameters for creating or updating a pace.
+// Nil or empty fields are left unchanged.
+type CoursePaceParams struct {
+	CourseSectionID    int64 // Create only: pace a section
+	UserID             int64 // Create only: pace a student
+	EndDate            *string
+	ExcludeWeekends    *bool
+	SelectedDaysToSkip []string // sun, mon, tue, wed, thu, fri, sat
+	HardEndDates       *bool
+	ModuleItems        []CoursePaceItemDuration
+}
+
+// coursePaceResponse is the envelope Canvas wraps paces in. Saving a pace
+// also starts publishing it.
+type coursePaceResponse struct {
+	CoursePace CoursePace   `json:"course_pace"`
+	Progress   *JobProgress `json:"progress"`
+}
+
+// Get retrieves a pace by ID
+func (s *CoursePacesService) Get(ctx context.Context, courseID, paceID int64) (*CoursePace, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/course_pacing/%d", courseID, paceID)
+
+	var response coursePaceResponse
+	if err := s.client.GetJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.CoursePace, nil
+}
+
+// GetCoursePace retrieves the default pace of a course
+func (s *CoursePacesService) GetCoursePace(ctx context.Context, courseID int64) (*CoursePace, error) {
+	return s.getForContext(ctx, courseID, nil)
+}
+
+// GetSectionPace retrieves the pace of a section. Sections without a pace of
+// their own get an unsaved copy of the course pace.
+func (s *CoursePacesService) GetSectionPace(ctx context.Context, courseID, sectionID int64) (*CoursePace, error) {
+	return s.getForContext(ctx, courseID, url.Values{
+		"course_section_id": {strconv.FormatInt(sectionID, 10)},
+	})
+}
+
+// GetStudentPace retrieves the pace of a student enrollment. Students without
+// a pace of their own get an unsaved copy of their section or course pace.
+func (s *CoursePacesService) GetStudentPace(ctx context.Context, courseID, enrollmentID int64) (*CoursePace, error) {
+	return s.getForContext(ctx, courseID, url.Values{
+		"enrollment_id": {strconv.FormatInt(enrollmentID, 10)},
+	})
+}
+
+func (s *CoursePacesService) getForContext(ctx context.Context, courseID int64, query url.Values) (*CoursePace, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/course_pacing/new", courseID)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var response coursePaceResponse
+	if err := s.client.GetJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.CoursePace, nil
+}
+
+// Create creates a pace and starts publishing it. The returned progress may
+// be nil.
+func (s *CoursePacesService) Create(ctx context.Context, courseID int64, params *CoursePaceParams) (*CoursePace, *JobProgress, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/course_pacing", courseID)
+
+	pace := coursePaceBody(params)
+	if params.CourseSectionID > 0 {
+		pace["course_section_id"] = params.CourseSectionID
+	}
+	if params.UserID > 0 {
+		pace["user_id"] = params.UserID
+	}
+
+	var response coursePaceResponse
+	if err := s.client.PostJSON(ctx, path, map[string]interface{}{"course_pace": pace}, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response.CoursePace, response.Progress, nil
+}
+
+// Update updates a pace and starts publishing it. The returned progress may
+// be nil.
+func (s *CoursePacesService) Update(ctx context.Context, courseID, paceID int64, params *CoursePaceParams) (*CoursePace, *JobProgress, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/course_pacing/%d", courseID, paceID)
+
+	pace := coursePaceBody(params)
+	if len(pace) == 0 {
+		return nil, nil, fmt.Errorf("no course pace fields to update")
+	}
+
+	var response coursePaceResponse
+	if err := s.client.PutJSON(ctx, path, map[string]interface{}{"course_pace": pace}, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response.CoursePace, response.Progress, nil
+}
+
+// SetModuleItemDurations updates how many days a pace gives to module items
+func (s *CoursePacesService) SetModuleItemDurations(ctx context.Context, courseID, paceID int64, items []CoursePaceItemDuration) (*CoursePace, *JobProgress, error) {
+	return s.Update(ctx, courseID, paceID, &CoursePaceParams{ModuleItems: items})
+}
+
+// Publish publishes the draft changes of a pace
+func (s *CoursePacesService) Publish(ctx context.Context, courseID, paceID int64) (*JobProgress, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/course_pacing/%d/publish", courseID, paceID)
+
+	var progress JobProgress
+	if err := s.client.PostJSON(ctx, path, nil, &progress); err != nil {
+		return nil, err
+	}
+
+	return &progress, nil
+}
+
+// Delete deletes a section or student pace, which then follows the course pace again
+func (s *CoursePacesService) Delete(ctx context.Context, courseID, paceID int64) error {
+	path := fmt.Sprintf("/api/v1/courses/%d/course_pacing/%d", courseID, paceID)
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func coursePaceBody(params *CoursePaceParams) map[string]interface{} {
+	pace := make(map[string]interface{})
+
+	if params.EndDate != nil {
+		pace["end_date"] = *params.EndDate
+	}
+
+	if params.ExcludeWeekends != nil {
+		pace["exclude_weekends"] = *params.ExcludeWeekends
+	}
+
+	if len(params.SelectedDaysToSkip) > 0 {
+		pace["selected_days_to_skip"] = params.SelectedDaysToSkip
+	}
+
+	if params.HardEndDates != nil {
+		pace["hard_end_dates"] = *params.HardEndDates
+	}
+
+	if len(params.ModuleItems) > 0 {
+		pace["course_pace_module_item_attributes"] = params.ModuleItems
+	}
+
+	return pace
+}
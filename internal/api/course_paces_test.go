@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCoursePacesService_GetSectionPace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/courses/123/course_pacing/new" {
+			t.Errorf("Expected path /api/v1/courses/123/course_pacing/new, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("course_section_id"); got != "45" {
+			t.Errorf("Expected course_section_id 45, got %s", got)
+		}
+
+		w.Write([]byte(`{"course_pace":{"id":null,"course_id":123,"course_section_id":45,"workflow_state":"unpublished",
+			"modules":[{"id":1,"name":"Week 1","items":[{"id":null,"module_item_id":301,"duration":2,"assignment_title":"Reading"}]}]},
+			"progress":null}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewCoursePacesService(client)
+	pace, err := service.GetSectionPace(context.Background(), 123, 45)
+	if err != nil {
+		t.Fatalf("GetSectionPace failed: %v", err)
+	}
+
+	if pace.ID != 0 {
+		t.Errorf("Expected unsaved pace, got ID %d", pace.ID)
+	}
+	if len(pace.Modules) != 1 || pace.Modules[0].Items[0].ModuleItemID != 301 || pace.Modules[0].Items[0].Duration != 2 {
+		t.Errorf("Unexpected modules: %+v", pace.Modules)
+	}
+}
+
+func TestCoursePacesService_Update(t *testing.T) {
+	var body map[string]map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/courses/123/course_pacing/9" {
+			t.Errorf("Expected path /api/v1/courses/123/course_pacing/9, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"course_pace":{"id":9,"course_id":123,"workflow_state":"active"},"progress":{"id":55,"workflow_state":"queued"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewCoursePacesService(client)
+	pace, progress, err := service.SetModuleItemDurations(context.Background(), 123, 9, []CoursePaceItemDuration{
+		{ID: 70, ModuleItemID: 301, Duration: 4},
+	})
+	if err != nil {
+		t.Fatalf("SetModuleItemDurations failed: %v", err)
+	}
+
+	if pace.ID != 9 || progress == nil || progress.ID != 55 {
+		t.Errorf("Unexpected result: %+v, %+v", pace, progress)
+	}
+
+	sent := body["course_pace"]
+	if len(sent) != 1 {
+		t.Errorf("Expected only module items to be sent, got %v", sent)
+	}
+	items, ok := sent["course_pace_module_item_attributes"].([]interface{})
+	if !ok || len(items) != 1 {
+		t.Fatalf("Expected 1 module item, got %v", sent["course_pace_module_item_attributes"])
+	}
+	item := items[0].(map[string]interface{})
+	if item["id"] != 70.0 || item["module_item_id"] != 301.0 || item["duration"] != 4.0 {
+		t.Errorf("Unexpected module item: %v", item)
+	}
+}
+
+func TestCoursePacesService_Update_NoFields(t *testing.T) {
+	service := NewCoursePacesService(nil)
+	if _, _, err := service.Update(context.Background(), 123, 9, &CoursePaceParams{}); err == nil {
+		t.Error("Expected error for empty update")
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -29,6 +30,23 @@ type ListCoursesOptions struct {
 
 // List retrieves all courses for the current user
 func (s *CoursesService) List(ctx context.Context, opts *ListCoursesOptions) ([]Course, error) {
+	path := coursesPath(opts)
+
+	courses, err := GetAllPagesGeneric[Course](s.client, ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NormalizeCourses(courses), nil
+}
+
+// ListIter streams the current user's courses page by page
+func (s *CoursesService) ListIter(ctx context.Context, opts *ListCoursesOptions) iter.Seq2[Course, error] {
+	return normalizeSeq(Pages[Course](s.client, ctx, coursesPath(opts)), NormalizeCourse)
+}
+
+// coursesPath builds the current user's courses list path with query parameters
+func coursesPath(opts *ListCoursesOptions) string {
 	path := "/api/v1/courses"
 
 	if opts != nil {
@@ -63,12 +81,7 @@ func (s *CoursesService) List(ctx context.Context, opts *ListCoursesOptions) ([]
 		}
 	}
 
-	courses, err := GetAllPagesGeneric[Course](s.client, ctx, path)
-	if err != nil {
-		return nil, err
-	}
-
-	return NormalizeCourses(courses), nil
+	return path
 }
 
 // Get retrieves a single course by ID
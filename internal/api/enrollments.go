@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -39,6 +40,23 @@ type ListEnrollmentsOptions struct {
 
 // ListCourse retrieves enrollments for a course
 func (s *EnrollmentsService) ListCourse(ctx context.Context, courseID int64, opts *ListEnrollmentsOptions) ([]Enrollment, error) {
+	path := courseEnrollmentsPath(courseID, opts)
+
+	var enrollments []Enrollment
+	if err := s.client.GetAllPages(ctx, path, &enrollments); err != nil {
+		return nil, err
+	}
+
+	return NormalizeEnrollments(enrollments), nil
+}
+
+// ListCourseIter streams enrollments for a course page by page
+func (s *EnrollmentsService) ListCourseIter(ctx context.Context, courseID int64, opts *ListEnrollmentsOptions) iter.Seq2[Enrollment, error] {
+	return normalizeSeq(Pages[Enrollment](s.client, ctx, courseEnrollmentsPath(courseID, opts)), NormalizeEnrollment)
+}
+
+// courseEnrollmentsPath builds the course enrollments list path with query parameters
+func courseEnrollmentsPath(courseID int64, opts *ListEnrollmentsOptions) string {
 	path := fmt.Sprintf("/api/v1/courses/%d/enrollments", courseID)
 
 	if opts != nil {
@@ -109,12 +127,7 @@ func (s *EnrollmentsService) ListCourse(ctx context.Context, courseID int64, opt
 		}
 	}
 
-	var enrollments []Enrollment
-	if err := s.client.GetAllPages(ctx, path, &enrollments); err != nil {
-		return nil, err
-	}
-
-	return NormalizeEnrollments(enrollments), nil
+	return path
 }
 
 // ListSection retrieves enrollments for a section
@@ -175,6 +188,23 @@ func (s *EnrollmentsService) ListSection(ctx context.Context, sectionID int64, o
 
 // ListUser retrieves enrollments for a user
 func (s *EnrollmentsService) ListUser(ctx context.Context, userID int64, opts *ListEnrollmentsOptions) ([]Enrollment, error) {
+	path := userEnrollmentsPath(userID, opts)
+
+	var enrollments []Enrollment
+	if err := s.client.GetAllPages(ctx, path, &enrollments); err != nil {
+		return nil, err
+	}
+
+	return NormalizeEnrollments(enrollments), nil
+}
+
+// ListUserIter streams enrollments for a user page by page
+func (s *EnrollmentsService) ListUserIter(ctx context.Context, userID int64, opts *ListEnrollmentsOptions) iter.Seq2[Enrollment, error] {
+	return normalizeSeq(Pages[Enrollment](s.client, ctx, userEnrollmentsPath(userID, opts)), NormalizeEnrollment)
+}
+
+// userEnrollmentsPath builds the user enrollments list path with query parameters
+func userEnrollmentsPath(userID int64, opts *ListEnrollmentsOptions) string {
 	path := fmt.Sprintf("/api/v1/users/%d/enrollments", userID)
 
 	if opts != nil {
@@ -221,12 +251,7 @@ func (s *EnrollmentsService) ListUser(ctx context.Context, userID int64, opts *L
 		}
 	}
 
-	var enrollments []Enrollment
-	if err := s.client.GetAllPages(ctx, path, &enrollments); err != nil {
-		return nil, err
-	}
-
-	return NormalizeEnrollments(enrollments), nil
+	return path
 }
 
 // EnrollUserParams holds parameters for enrolling a user
@@ -0,0 +1,201 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// FeatureFlagStates are the valid feature flag states. Courses and users only
+// accept "on" and "off"; "allowed" and "allowed_on" let sub-accounts and
+// courses decide for themselves.
+var FeatureFlagStates = []string{"off", "allowed", "allowed_on", "on"}
+
+// FeatureFlagsService handles feature flag API calls for accounts, courses and users
+type FeatureFlagsService struct {
+	client *Client
+}
+
+// NewFeatureFlagsService creates a new feature flags service
+func NewFeatureFlagsService(client *Client) *FeatureFlagsService {
+	return &FeatureFlagsService{client: client}
+}
+
+// FeatureContext identifies the account, course or user a feature flag applies to
+type FeatureContext struct {
+	Type string // accounts, courses, users
+	ID   int64
+}
+
+// AccountFeatures returns the feature context of an account
+func AccountFeatures(accountID int64) FeatureContext {
+	return FeatureContext{Type: "accounts", ID: accountID}
+}
+
+// CourseFeatures returns the feature context of a course
+func CourseFeatures(courseID int64) FeatureContext {
+	return FeatureContext{Type: "courses", ID: courseID}
+}
+
+// UserFeatures returns the feature context of a user
+func UserFeatures(userID int64) FeatureContext {
+	return FeatureContext{Type: "users", ID: userID}
+}
+
+// String returns a readable name such as "course 123"
+func (c FeatureContext) String() string {
+	return fmt.Sprintf("%s %d", strings.TrimSuffix(c.Type, "s"), c.ID)
+}
+
+func (c FeatureContext) path(resource string) string {
+	return fmt.Sprintf("/api/v1/%s/%d/features%s", c.Type, c.ID, resource)
+}
+
+// Feature represents a Canvas feature and its flag in a context
+type Feature struct {
+	Feature            string       `json:"feature"`
+	DisplayName        string       `json:"display_name"`
+	AppliesTo          string       `json:"applies_to"`
+	State              string       `json:"state"`
+	Locked             bool         `json:"locked"`
+	Beta               bool         `json:"beta"`
+	RootOptIn          bool         `json:"root_opt_in"`
+	PendingEnforcement bool         `json:"pending_enforcement,omitempty"`
+	EnableAt           string       `json:"enable_at,omitempty"`
+	ReleaseNotesURL    string       `json:"release_notes_url,omitempty"`
+	FeatureFlag        *FeatureFlag `json:"feature_flag,omitempty"`
+}
+
+// FeatureFlag represents the state of a feature in a context
+type FeatureFlag struct {
+	ContextType string `json:"context_type"`
+	ContextID   int64  `json:"context_id"`
+	Feature     string `json:"feature"`
+	State       string `json:"state"`
+	Locked      bool   `json:"locked"`
+}
+
+// FeatureDiff is a feature whose flag state differs between two contexts
+type FeatureDiff struct {
+	Feature     string `json:"feature"`
+	DisplayName string `json:"display_name"`
+	StateA      string `json:"state_a"`
+	StateB      string `json:"state_b"`
+}
+
+// List retrieves the features of a context with their flag states
+func (s *FeatureFlagsService) List(ctx context.Context, fc FeatureContext) ([]Feature, error) {
+	var features []Feature
+	if err := s.client.GetAllPages(ctx, fc.path(""), &features); err != nil {
+		return nil, err
+	}
+
+	for i := range features {
+		if flag := features[i].FeatureFlag; flag != nil {
+			features[i].State = flag.State
+			features[i].Locked = flag.Locked
+		}
+	}
+
+	return features, nil
+}
+
+// ListEnabled retrieves the names of the features enabled in a context
+func (s *FeatureFlagsService) ListEnabled(ctx context.Context, fc FeatureContext) ([]string, error) {
+	var features []string
+	if err := s.client.GetJSON(ctx, fc.path("/enabled"), &features); err != nil {
+		return nil, err
+	}
+
+	return features, nil
+}
+
+// GetFlag retrieves the flag of a feature in a context, which may be
+// inherited from a parent account
+func (s *FeatureFlagsService) GetFlag(ctx context.Context, fc FeatureContext, feature string) (*FeatureFlag, error) {
+	var flag FeatureFlag
+	if err := s.client.GetJSON(ctx, fc.path("/flags/"+url.PathEscape(feature)), &flag); err != nil {
+		return nil, err
+	}
+
+	return &flag, nil
+}
+
+// SetFlag sets the state of a feature in a context
+func (s *FeatureFlagsService) SetFlag(ctx context.Context, fc FeatureContext, feature, state string) (*FeatureFlag, error) {
+	if !slices.Contains(FeatureFlagStates, state) {
+		return nil, fmt.Errorf("invalid feature flag state %q (valid: %s)", state, strings.Join(FeatureFlagStates, ", "))
+	}
+
+	body := map[string]interface{}{
+		"state": state,
+	}
+
+	var flag FeatureFlag
+	if err := s.client.PutJSON(ctx, fc.path("/flags/"+url.PathEscape(feature)), body, &flag); err != nil {
+		return nil, err
+	}
+
+	return &flag, nil
+}
+
+// DeleteFlag removes a feature flag so the context inherits the state of its parent
+func (s *FeatureFlagsService) DeleteFlag(ctx context.Context, fc FeatureContext, feature string) (*FeatureFlag, error) {
+	resp, err := s.client.Delete(ctx, fc.path("/flags/"+url.PathEscape(feature)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var flag FeatureFlag
+	if err := json.NewDecoder(resp.Body).Decode(&flag); err != nil {
+		return nil, err
+	}
+
+	return &flag, nil
+}
+
+// DiffFeatures compares the feature states of two contexts. Features missing
+// from one side are reported with an empty state. When all is false, only
+// features whose states differ are returned.
+func DiffFeatures(a, b []Feature, all bool) []FeatureDiff {
+	byName := make(map[string]Feature, len(b))
+	for _, feature := range b {
+		byName[feature.Feature] = feature
+	}
+
+	var diffs []FeatureDiff
+	seen := make(map[string]bool, len(a))
+
+	for _, feature := range a {
+		seen[feature.Feature] = true
+		other := byName[feature.Feature]
+		if all || feature.State != other.State {
+			diffs = append(diffs, FeatureDiff{
+				Feature:     feature.Feature,
+				DisplayName: feature.DisplayName,
+				StateA:      feature.State,
+				StateB:      other.State,
+			})
+		}
+	}
+
+	for _, feature := range b {
+		if !seen[feature.Feature] {
+			diffs = append(diffs, FeatureDiff{
+				Feature:     feature.Feature,
+				DisplayName: feature.DisplayName,
+				StateB:      feature.State,
+			})
+		}
+	}
+
+	slices.SortFunc(diffs, func(x, y FeatureDiff) int {
+		return strings.Compare(x.Feature, y.Feature)
+	})
+
+	return diffs
+}
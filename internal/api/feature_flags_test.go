@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFeatureFlagsService_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/courses/123/features" {
+			t.Errorf("Expected path /api/v1/courses/123/features, got %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`[{"feature":"quizzes_next","display_name":"New Quizzes","applies_to":"Course","feature_flag":{"context_type":"Account","context_id":1,"feature":"quizzes_next","state":"allowed_on","locked":false}},{"feature":"beta_only","display_name":"Beta","applies_to":"Course"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewFeatureFlagsService(client)
+	features, err := service.List(context.Background(), CourseFeatures(123))
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if len(features) != 2 {
+		t.Fatalf("Expected 2 features, got %d", len(features))
+	}
+	if features[0].State != "allowed_on" {
+		t.Errorf("Expected state from feature flag, got %q", features[0].State)
+	}
+	if features[1].State != "" {
+		t.Errorf("Expected empty state without a flag, got %q", features[1].State)
+	}
+}
+
+func TestFeatureFlagsService_SetFlag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/accounts/5/features/flags/quizzes_next" {
+			t.Errorf("Expected PUT to account flag, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if body["state"] != "allowed" {
+			t.Errorf("Expected state allowed, got %v", body["state"])
+		}
+
+		w.Write([]byte(`{"context_type":"Account","context_id":5,"feature":"quizzes_next","state":"allowed"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewFeatureFlagsService(client)
+	flag, err := service.SetFlag(context.Background(), AccountFeatures(5), "quizzes_next", "allowed")
+	if err != nil {
+		t.Fatalf("SetFlag failed: %v", err)
+	}
+	if flag.State != "allowed" || flag.ContextID != 5 {
+		t.Errorf("Unexpected flag: %+v", flag)
+	}
+
+	if _, err := service.SetFlag(context.Background(), AccountFeatures(5), "quizzes_next", "enabled"); err == nil {
+		t.Error("Expected error for invalid state")
+	}
+}
+
+func TestDiffFeatures(t *testing.T) {
+	a := []Feature{
+		{Feature: "quizzes_next", State: "on"},
+		{Feature: "react_discussions_post", State: "off"},
+		{Feature: "only_a", State: "on"},
+	}
+	b := []Feature{
+		{Feature: "quizzes_next", State: "off"},
+		{Feature: "react_discussions_post", State: "off"},
+		{Feature: "only_b", State: "on"},
+	}
+
+	diffs := DiffFeatures(a, b, false)
+	want := []FeatureDiff{
+		{Feature: "only_a", StateA: "on"},
+		{Feature: "only_b", StateB: "on"},
+		{Feature: "quizzes_next", StateA: "on", StateB: "off"},
+	}
+	if len(diffs) != len(want) {
+		t.Fatalf("Expected %d diffs, got %+v", len(want), diffs)
+	}
+	for i := range want {
+		if diffs[i] != want[i] {
+			t.Errorf("Diff %d: expected %+v, got %+v", i, want[i], diffs[i])
+		}
+	}
+
+	if all := DiffFeatures(a, b, true); len(all) != 4 {
+		t.Errorf("Expected 4 features with all, got %d", len(all))
+	}
+}
+
+func TestFeatureContext_String(t *testing.T) {
+	if got := CourseFeatures(123).String(); got != "course 123" {
+		t.Errorf("Expected %q, got %q", "course 123", got)
+	}
+	if got := AccountFeatures(1).String(); got != "account 1" {
+		t.Errorf("Expected %q, got %q", "account 1", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"mime/multipart"
 	"net/http"
 	"net/url"
@@ -69,8 +70,38 @@ func (s *FilesService) ListUserFiles(ctx context.Context, userID int64, opts *Li
 	return s.listFiles(ctx, path, opts)
 }
 
+// ListCourseFilesIter streams files for a course page by page
+func (s *FilesService) ListCourseFilesIter(ctx context.Context, courseID int64, opts *ListFilesOptions) iter.Seq2[Attachment, error] {
+	path := fmt.Sprintf("/api/v1/courses/%d/files", courseID)
+	return Pages[Attachment](s.client, ctx, listFilesPath(path, opts))
+}
+
+// ListFolderFilesIter streams files in a folder page by page
+func (s *FilesService) ListFolderFilesIter(ctx context.Context, folderID int64, opts *ListFilesOptions) iter.Seq2[Attachment, error] {
+	path := fmt.Sprintf("/api/v1/folders/%d/files", folderID)
+	return Pages[Attachment](s.client, ctx, listFilesPath(path, opts))
+}
+
+// ListUserFilesIter streams files for a user page by page
+func (s *FilesService) ListUserFilesIter(ctx context.Context, userID int64, opts *ListFilesOptions) iter.Seq2[Attachment, error] {
+	path := fmt.Sprintf("/api/v1/users/%d/files", userID)
+	return Pages[Attachment](s.client, ctx, listFilesPath(path, opts))
+}
+
 // listFiles is a helper for listing files with options
 func (s *FilesService) listFiles(ctx context.Context, basePath string, opts *ListFilesOptions) ([]Attachment, error) {
+	path := listFilesPath(basePath, opts)
+
+	var files []Attachment
+	if err := s.client.GetAllPages(ctx, path, &files); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// listFilesPath appends list options to a files endpoint as query parameters
+func listFilesPath(basePath string, opts *ListFilesOptions) string {
 	path := basePath
 
 	if opts != nil {
@@ -113,12 +144,7 @@ func (s *FilesService) listFiles(ctx context.Context, basePath string, opts *Lis
 		}
 	}
 
-	var files []Attachment
-	if err := s.client.GetAllPages(ctx, path, &files); err != nil {
-		return nil, err
-	}
-
-	return files, nil
+	return path
 }
 
 // Get retrieves a single file by ID
@@ -293,7 +319,8 @@ func (s *FilesService) upload(ctx context.Context, uploadPath, filePath string,
 	// Create a custom client that doesn't follow redirects automatically
 	// We need to handle the redirect ourselves to get the file confirmation
 	noRedirectClient := &http.Client{
-		Timeout: 5 * time.Minute, // File uploads may take longer
+		Timeout:   5 * time.Minute, // File uploads may take longer
+		Transport: s.client.httpClient.Transport,
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			return http.ErrUseLastResponse
 		},
@@ -409,6 +436,7 @@ type UpdateParams struct {
 	UnlockAt       *string // ISO8601 date
 	Locked         *bool
 	Hidden         *bool
+	OnDuplicate    string // overwrite, rename; applies when moving or renaming
 }
 
 // Update updates file metadata
@@ -435,6 +463,9 @@ func (s *FilesService) Update(ctx context.Context, fileID int64, params *UpdateP
 	if params.Hidden != nil {
 		body["hidden"] = *params.Hidden
 	}
+	if params.OnDuplicate != "" {
+		body["on_duplicate"] = params.OnDuplicate
+	}
 
 	var updatedFile Attachment
 	if err := s.client.PutJSON(ctx, path, body, &updatedFile); err != nil {
@@ -0,0 +1,324 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// FoldersService handles folder API calls
+type FoldersService struct {
+	client *Client
+}
+
+// NewFoldersService creates a new folders service
+func NewFoldersService(client *Client) *FoldersService {
+	return &FoldersService{client: client}
+}
+
+// Folder represents a Canvas folder
+type Folder struct {
+	ID             int64      `json:"id"`
+	Name           string     `json:"name"`
+	FullName       string     `json:"full_name"` // e.g. course files/week1/slides
+	ContextID      int64      `json:"context_id"`
+	ContextType    string     `json:"context_type"`
+	ParentFolderID *int64     `json:"parent_folder_id"` // Nil for the root folder
+	FilesCount     int        `json:"files_count"`
+	FoldersCount   int        `json:"folders_count"`
+	Position       int        `json:"position,omitempty"`
+	Locked         bool       `json:"locked"`
+	LockAt         *time.Time `json:"lock_at,omitempty"`
+	UnlockAt       *time.Time `json:"unlock_at,omitempty"`
+	Hidden         bool       `json:"hidden"`
+	LockedForUser  bool       `json:"locked_for_user"`
+	HiddenForUser  bool       `json:"hidden_for_user"`
+	ForSubmissions bool       `json:"for_submissions"`
+	FilesURL       string     `json:"files_url,omitempty"`
+	FoldersURL     string     `json:"folders_url,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// IsRoot reports whether the folder is the root folder of its context
+func (f *Folder) IsRoot() bool {
+	return f.ParentFolderID == nil
+}
+
+// FolderParams holds parameters for creating or updating a folder. Nil
+// fields are left unchanged.
+type FolderParams struct {
+	Name             string
+	ParentFolderID   *int64  // Move the folder (update only)
+	ParentFolderPath string  // Folder to create the folder in, created if missing (course create only)
+	LockAt           *string // ISO8601 date
+	UnlockAt         *string // ISO8601 date
+	Locked           *bool
+	Hidden           *bool
+	Position         *int
+}
+
+func (p *FolderParams) body() map[string]interface{} {
+	body := make(map[string]interface{})
+	if p.Name != "" {
+		body["name"] = p.Name
+	}
+	if p.ParentFolderID != nil {
+		body["parent_folder_id"] = *p.ParentFolderID
+	}
+	if p.ParentFolderPath != "" {
+		body["parent_folder_path"] = p.ParentFolderPath
+	}
+	if p.LockAt != nil {
+		body["lock_at"] = *p.LockAt
+	}
+	if p.UnlockAt != nil {
+		body["unlock_at"] = *p.UnlockAt
+	}
+	if p.Locked != nil {
+		body["locked"] = *p.Locked
+	}
+	if p.Hidden != nil {
+		body["hidden"] = *p.Hidden
+	}
+	if p.Position != nil {
+		body["position"] = *p.Position
+	}
+	return body
+}
+
+// CourseFolderPath converts a course-relative folder path such as
+// "course files/week1/slides" to a path below the course's root folder
+// ("week1/slides"). The root folder's own name is optional.
+func CourseFolderPath(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 0 && strings.EqualFold(parts[0], "course files") {
+		parts = parts[1:]
+	}
+
+	clean := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" && part != "." {
+			clean = append(clean, part)
+		}
+	}
+	return strings.Join(clean, "/")
+}
+
+// Get retrieves a folder
+func (s *FoldersService) Get(ctx context.Context, folderID int64) (*Folder, error) {
+	path := fmt.Sprintf("/api/v1/folders/%d", folderID)
+
+	var folder Folder
+	if err := s.client.GetJSON(ctx, path, &folder); err != nil {
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+// ResolveCoursePath retrieves the course folder at a course-relative path.
+// An empty path resolves to the course's root folder. Canvas responds with
+// 404 when a folder along the path doesn't exist. The lookup bypasses the
+// cache, since moves and renames under /folders don't invalidate it.
+func (s *FoldersService) ResolveCoursePath(ctx context.Context, courseID int64, folderPath string) (*Folder, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/folders/by_path", courseID)
+	if rel := CourseFolderPath(folderPath); rel != "" {
+		parts := strings.Split(rel, "/")
+		for i, part := range parts {
+			parts[i] = url.PathEscape(part)
+		}
+		path += "/" + strings.Join(parts, "/")
+	}
+
+	resp, err := s.client.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Canvas returns every folder from the root down to the requested one
+	var folders []Folder
+	if err := json.NewDecoder(resp.Body).Decode(&folders); err != nil {
+		return nil, fmt.Errorf("failed to decode folders: %w", err)
+	}
+	if len(folders) == 0 {
+		return nil, fmt.Errorf("folder %q not found", folderPath)
+	}
+
+	return &folders[len(folders)-1], nil
+}
+
+// FindFile looks up a file by display name in a folder. It returns nil when
+// the folder has no such file. Like ResolveCoursePath, it bypasses the cache.
+func (s *FoldersService) FindFile(ctx context.Context, folderID int64, name string) (*Attachment, error) {
+	path := fmt.Sprintf("/api/v1/folders/%d/files", folderID)
+
+	// Let Canvas narrow the listing to partial name matches. It rejects
+	// search terms shorter than two characters, which are matched locally.
+	if len([]rune(name)) >= 2 {
+		path += "?" + url.Values{"search_term": {name}}.Encode()
+	}
+
+	for file, err := range Pages[Attachment](s.client, ctx, path) {
+		if err != nil {
+			return nil, err
+		}
+		if file.DisplayName == name {
+			return &file, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ListCourse lists every folder of a course, at any depth
+func (s *FoldersService) ListCourse(ctx context.Context, courseID int64) ([]Folder, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/folders", courseID)
+
+	var folders []Folder
+	if err := s.client.GetAllPages(ctx, path, &folders); err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
+
+// ListSubfolders lists the folders directly inside a folder
+func (s *FoldersService) ListSubfolders(ctx context.Context, folderID int64) ([]Folder, error) {
+	path := fmt.Sprintf("/api/v1/folders/%d/folders", folderID)
+
+	var folders []Folder
+	if err := s.client.GetAllPages(ctx, path, &folders); err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
+
+// ListRecursive lists every folder below a folder, parents before their
+// subfolders. The folder itself is not included.
+func (s *FoldersService) ListRecursive(ctx context.Context, folderID int64) ([]Folder, error) {
+	var all []Folder
+
+	queue := []int64{folderID}
+	for len(queue) > 0 {
+		folders, err := s.ListSubfolders(ctx, queue[0])
+		if err != nil {
+			return nil, err
+		}
+		queue = queue[1:]
+
+		for _, folder := range folders {
+			all = append(all, folder)
+			if folder.FoldersCount > 0 {
+				queue = append(queue, folder.ID)
+			}
+		}
+	}
+
+	return all, nil
+}
+
+// Create creates a folder inside another folder
+func (s *FoldersService) Create(ctx context.Context, parentFolderID int64, params *FolderParams) (*Folder, error) {
+	path := fmt.Sprintf("/api/v1/folders/%d/folders", parentFolderID)
+
+	var folder Folder
+	if err := s.client.PostJSON(ctx, path, params.body(), &folder); err != nil {
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+// CreateInCourse creates a course folder. With ParentFolderPath, Canvas
+// creates the missing folders along that path as well.
+func (s *FoldersService) CreateInCourse(ctx context.Context, courseID int64, params *FolderParams) (*Folder, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/folders", courseID)
+
+	var folder Folder
+	if err := s.client.PostJSON(ctx, path, params.body(), &folder); err != nil {
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+// Update updates a folder
+func (s *FoldersService) Update(ctx context.Context, folderID int64, params *FolderParams) (*Folder, error) {
+	path := fmt.Sprintf("/api/v1/folders/%d", folderID)
+
+	body := params.body()
+	if len(body) == 0 {
+		return nil, fmt.Errorf("no folder changes given")
+	}
+
+	var folder Folder
+	if err := s.client.PutJSON(ctx, path, body, &folder); err != nil {
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+// Move moves a folder into another folder
+func (s *FoldersService) Move(ctx context.Context, folderID, parentFolderID int64) (*Folder, error) {
+	return s.Update(ctx, folderID, &FolderParams{ParentFolderID: &parentFolderID})
+}
+
+// Copy copies a folder and everything in it into another folder
+func (s *FoldersService) Copy(ctx context.Context, sourceFolderID, destFolderID int64) (*Folder, error) {
+	path := fmt.Sprintf("/api/v1/folders/%d/copy_folder", destFolderID)
+
+	body := map[string]interface{}{
+		"source_folder_id": sourceFolderID,
+	}
+
+	var folder Folder
+	if err := s.client.PostJSON(ctx, path, body, &folder); err != nil {
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+// CopyFile copies a file into a folder. onDuplicate is overwrite or rename;
+// empty makes Canvas fail when the folder has a file with the same name.
+func (s *FoldersService) CopyFile(ctx context.Context, sourceFileID, destFolderID int64, onDuplicate string) (*Attachment, error) {
+	path := fmt.Sprintf("/api/v1/folders/%d/copy_file", destFolderID)
+
+	body := map[string]interface{}{
+		"source_file_id": sourceFileID,
+	}
+	if onDuplicate != "" {
+		body["on_duplicate"] = onDuplicate
+	}
+
+	var file Attachment
+	if err := s.client.PostJSON(ctx, path, body, &file); err != nil {
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+// Delete deletes a folder. Canvas refuses to delete a folder that has files
+// or folders in it unless force is true.
+func (s *FoldersService) Delete(ctx context.Context, folderID int64, force bool) error {
+	path := fmt.Sprintf("/api/v1/folders/%d", folderID)
+	if force {
+		path += "?force=true"
+	}
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newFoldersTestService(t *testing.T, handler http.HandlerFunc) (*FoldersService, func()) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+		handler(w, r)
+	}))
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		server.Close()
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return NewFoldersService(client), server.Close
+}
+
+func TestCourseFolderPath(t *testing.T) {
+	tests := map[string]string{
+		"":                           "",
+		"/":                          "",
+		"course files":               "",
+		"Course Files/week1":         "week1",
+		"course files/week1/slides/": "week1/slides",
+		"/week1//./slides":           "week1/slides",
+		"week1/course files":         "week1/course files",
+	}
+
+	for input, want := range tests {
+		if got := CourseFolderPath(input); got != want {
+			t.Errorf("CourseFolderPath(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestFoldersService_ResolveCoursePath(t *testing.T) {
+	service, cleanup := newFoldersTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v1/courses/123/folders/by_path/week%201/slides" {
+			t.Errorf("Unexpected path %s", r.URL.EscapedPath())
+		}
+
+		w.Write([]byte(`[
+			{"id":1,"name":"course files","full_name":"course files","parent_folder_id":null},
+			{"id":10,"name":"week 1","full_name":"course files/week 1","parent_folder_id":1},
+			{"id":11,"name":"slides","full_name":"course files/week 1/slides","parent_folder_id":10}
+		]`))
+	})
+	defer cleanup()
+
+	folder, err := service.ResolveCoursePath(context.Background(), 123, "course files/week 1/slides")
+	if err != nil {
+		t.Fatalf("ResolveCoursePath failed: %v", err)
+	}
+
+	if folder.ID != 11 || folder.IsRoot() {
+		t.Errorf("Unexpected folder: %+v", folder)
+	}
+}
+
+func TestFoldersService_ResolveCoursePath_Root(t *testing.T) {
+	service, cleanup := newFoldersTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/courses/123/folders/by_path" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`[{"id":1,"name":"course files","full_name":"course files","parent_folder_id":null}]`))
+	})
+	defer cleanup()
+
+	folder, err := service.ResolveCoursePath(context.Background(), 123, "course files")
+	if err != nil {
+		t.Fatalf("ResolveCoursePath failed: %v", err)
+	}
+
+	if !folder.IsRoot() {
+		t.Errorf("Expected the root folder, got %+v", folder)
+	}
+}
+
+func TestFoldersService_CreateInCourse(t *testing.T) {
+	var body map[string]interface{}
+
+	service, cleanup := newFoldersTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/courses/123/folders" {
+			t.Errorf("Expected path /api/v1/courses/123/folders, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"id":12,"name":"drafts","full_name":"course files/week1/slides/drafts","parent_folder_id":11}`))
+	})
+	defer cleanup()
+
+	folder, err := service.CreateInCourse(context.Background(), 123, &FolderParams{
+		Name:             "drafts",
+		ParentFolderPath: "week1/slides",
+	})
+	if err != nil {
+		t.Fatalf("CreateInCourse failed: %v", err)
+	}
+
+	if body["name"] != "drafts" || body["parent_folder_path"] != "week1/slides" {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if folder.ID != 12 {
+		t.Errorf("Unexpected folder: %+v", folder)
+	}
+}
+
+func TestFoldersService_Copy(t *testing.T) {
+	var body map[string]interface{}
+
+	service, cleanup := newFoldersTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/folders/20/copy_folder" {
+			t.Errorf("Expected path /api/v1/folders/20/copy_folder, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"id":21,"name":"week1","parent_folder_id":20}`))
+	})
+	defer cleanup()
+
+	folder, err := service.Copy(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("Copy failed: %v", err)
+	}
+
+	if body["source_folder_id"] != float64(10) {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if folder.ID != 21 {
+		t.Errorf("Unexpected folder: %+v", folder)
+	}
+}
+
+func TestFoldersService_ListRecursive(t *testing.T) {
+	service, cleanup := newFoldersTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/folders/1/folders":
+			w.Write([]byte(`[{"id":10,"name":"week1","folders_count":1},{"id":20,"name":"week2"}]`))
+		case "/api/v1/folders/10/folders":
+			w.Write([]byte(`[{"id":11,"name":"slides"}]`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.Write([]byte(`[]`))
+		}
+	})
+	defer cleanup()
+
+	folders, err := service.ListRecursive(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("ListRecursive failed: %v", err)
+	}
+
+	if len(folders) != 3 || folders[0].ID != 10 || folders[1].ID != 20 || folders[2].ID != 11 {
+		t.Errorf("Unexpected folders: %+v", folders)
+	}
+}
+
+func TestFoldersService_FindFile(t *testing.T) {
+	service, cleanup := newFoldersTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/folders/10/files" || r.URL.Query().Get("search_term") != "intro.pdf" {
+			t.Errorf("Unexpected request %s", r.URL.String())
+		}
+
+		// Search terms match partial names
+		w.Write([]byte(`[{"id":55,"display_name":"intro.pdf.bak"},{"id":56,"display_name":"intro.pdf"}]`))
+	})
+	defer cleanup()
+
+	file, err := service.FindFile(context.Background(), 10, "intro.pdf")
+	if err != nil {
+		t.Fatalf("FindFile failed: %v", err)
+	}
+
+	if file == nil || file.ID != 56 {
+		t.Errorf("Expected the exact match, got %+v", file)
+	}
+}
+
+func TestFoldersService_Delete(t *testing.T) {
+	service, cleanup := newFoldersTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/folders/10" || r.URL.Query().Get("force") != "true" {
+			t.Errorf("Unexpected request %s", r.URL.String())
+		}
+
+		w.Write([]byte(`{"id":10}`))
+	})
+	defer cleanup()
+
+	if err := service.Delete(context.Background(), 10, true); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
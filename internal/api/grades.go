@@ -383,8 +383,9 @@ type BulkUpdateGrade struct {
 	Excused      bool
 }
 
-// BulkUpdateGrades updates multiple grades at once
-func (s *GradesService) BulkUpdateGrades(ctx context.Context, courseID int64, grades []BulkUpdateGrade) error {
+// BulkUpdateGrades updates multiple grades at once.
+// Canvas processes the update asynchronously and returns a Progress object.
+func (s *GradesService) BulkUpdateGrades(ctx context.Context, courseID int64, grades []BulkUpdateGrade) (*JobProgress, error) {
 	path := fmt.Sprintf("/api/v1/courses/%d/submissions/update_grades", courseID)
 
 	gradeUpdates := make(map[string]map[string]interface{})
@@ -412,5 +413,10 @@ func (s *GradesService) BulkUpdateGrades(ctx context.Context, courseID int64, gr
 		"grade_data": gradeUpdates,
 	}
 
-	return s.client.PostJSON(ctx, path, body, nil)
+	var progress JobProgress
+	if err := s.client.PostJSON(ctx, path, body, &progress); err != nil {
+		return nil, err
+	}
+
+	return &progress, nil
 }
@@ -489,7 +489,11 @@ func TestGradesService_BulkUpdateGrades(t *testing.T) {
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(map[string]interface{}{})
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":             42,
+			"workflow_state": "queued",
+			"url":            "https://canvas.example.com/api/v1/progress/42",
+		})
 	}))
 	defer server.Close()
 
@@ -507,10 +511,14 @@ func TestGradesService_BulkUpdateGrades(t *testing.T) {
 		{StudentID: 101, AssignmentID: 200, Grade: "B"},
 	}
 
-	err = service.BulkUpdateGrades(context.Background(), 123, grades)
+	progress, err := service.BulkUpdateGrades(context.Background(), 123, grades)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+
+	if progress.ID != 42 {
+		t.Errorf("expected progress ID 42, got %d", progress.ID)
+	}
 }
 
 func TestNewGradesService(t *testing.T) {
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// GradingStandardsService handles grading standard (grading scheme) API calls
+type GradingStandardsService struct {
+	client *Client
+}
+
+// NewGradingStandardsService creates a new grading standards service
+func NewGradingStandardsService(client *Client) *GradingStandardsService {
+	return &GradingStandardsService{client: client}
+}
+
+// GradingStandard represents a grading scheme that maps scores to grades
+type GradingStandard struct {
+	ID            int64                `json:"id"`
+	Title         string               `json:"title"`
+	ContextType   string               `json:"context_type"`
+	ContextID     int64                `json:"context_id"`
+	PointsBased   bool                 `json:"points_based"`
+	ScalingFactor float64              `json:"scaling_factor,omitempty"`
+	GradingScheme []GradingSchemeEntry `json:"grading_scheme"`
+}
+
+// GradingSchemeEntry is a grade and the lowest score that earns it. Canvas
+// returns values as fractions (0.94 for 94%).
+type GradingSchemeEntry struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+// ListCourse retrieves the grading standards available to a course,
+// including those of its accounts
+func (s *GradingStandardsService) ListCourse(ctx context.Context, courseID int64) ([]GradingStandard, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/grading_standards", courseID)
+	return s.list(ctx, path)
+}
+
+// ListAccount retrieves the grading standards available to an account,
+// including those of its parent accounts
+func (s *GradingStandardsService) ListAccount(ctx context.Context, accountID int64) ([]GradingStandard, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/grading_standards", accountID)
+	return s.list(ctx, path)
+}
+
+func (s *GradingStandardsService) list(ctx context.Context, path string) ([]GradingStandard, error) {
+	var standards []GradingStandard
+	if err := s.client.GetAllPages(ctx, path, &standards); err != nil {
+		return nil, err
+	}
+
+	return standards, nil
+}
+
+// GetCourse retrieves a single grading standard of a course
+func (s *GradingStandardsService) GetCourse(ctx context.Context, courseID, standardID int64) (*GradingStandard, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/grading_standards/%d", courseID, standardID)
+	return s.get(ctx, path)
+}
+
+// GetAccount retrieves a single grading standard of an account
+func (s *GradingStandardsService) GetAccount(ctx context.Context, accountID, standardID int64) (*GradingStandard, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/grading_standards/%d", accountID, standardID)
+	return s.get(ctx, path)
+}
+
+func (s *GradingStandardsService) get(ctx context.Context, path string) (*GradingStandard, error) {
+	var standard GradingStandard
+	if err := s.client.GetJSON(ctx, path, &standard); err != nil {
+		return nil, err
+	}
+
+	return &standard, nil
+}
+
+// CreateGradingStandardParams holds parameters for creating a grading standard
+type CreateGradingStandardParams struct {
+	Title         string
+	PointsBased   bool
+	ScalingFactor float64
+	Entries       []GradingSchemeEntry // Values as percentages (94 for 94%)
+}
+
+// CreateInCourse creates a grading standard in a course
+func (s *GradingStandardsService) CreateInCourse(ctx context.Context, courseID int64, params *CreateGradingStandardParams) (*GradingStandard, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/grading_standards", courseID)
+	return s.create(ctx, path, params)
+}
+
+// CreateInAccount creates a grading standard in an account, making it
+// available to every course of the account and its sub-accounts
+func (s *GradingStandardsService) CreateInAccount(ctx context.Context, accountID int64, params *CreateGradingStandardParams) (*GradingStandard, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/grading_standards", accountID)
+	return s.create(ctx, path, params)
+}
+
+func (s *GradingStandardsService) create(ctx context.Context, path string, params *CreateGradingStandardParams) (*GradingStandard, error) {
+	entries := make([]map[string]interface{}, len(params.Entries))
+	for i, entry := range params.Entries {
+		entries[i] = map[string]interface{}{
+			"name":  entry.Name,
+			"value": entry.Value,
+		}
+	}
+
+	body := map[string]interface{}{
+		"title":                params.Title,
+		"grading_scheme_entry": entries,
+	}
+
+	if params.PointsBased {
+		body["points_based"] = true
+	}
+
+	if params.ScalingFactor > 0 {
+		body["scaling_factor"] = params.ScalingFactor
+	}
+
+	var standard GradingStandard
+	if err := s.client.PostJSON(ctx, path, body, &standard); err != nil {
+		return nil, err
+	}
+
+	return &standard, nil
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGradingStandardsService_CreateInAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/accounts/5/grading_standards" {
+			t.Errorf("Expected POST /api/v1/accounts/5/grading_standards, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			Title   string `json:"title"`
+			Entries []struct {
+				Name  string  `json:"name"`
+				Value float64 `json:"value"`
+			} `json:"grading_scheme_entry"`
+			PointsBased *bool `json:"points_based"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if body.Title != "Pass/Fail" {
+			t.Errorf("Expected title 'Pass/Fail', got %q", body.Title)
+		}
+		if len(body.Entries) != 2 || body.Entries[0].Name != "Pass" || body.Entries[0].Value != 60 {
+			t.Errorf("Unexpected entries: %+v", body.Entries)
+		}
+		if body.PointsBased != nil {
+			t.Error("Expected points_based to be omitted")
+		}
+
+		w.Write([]byte(`{"id":12,"title":"Pass/Fail","context_type":"Account","context_id":5,"grading_scheme":[{"name":"Pass","value":0.6},{"name":"Fail","value":0}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewGradingStandardsService(client)
+	standard, err := service.CreateInAccount(context.Background(), 5, &CreateGradingStandardParams{
+		Title: "Pass/Fail",
+		Entries: []GradingSchemeEntry{
+			{Name: "Pass", Value: 60},
+			{Name: "Fail", Value: 0},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateInAccount failed: %v", err)
+	}
+
+	if standard.ID != 12 || standard.ContextType != "Account" || len(standard.GradingScheme) != 2 {
+		t.Errorf("Unexpected grading standard: %+v", standard)
+	}
+	if standard.GradingScheme[0].Value != 0.6 {
+		t.Errorf("Expected fractional value 0.6, got %v", standard.GradingScheme[0].Value)
+	}
+}
+
+func TestGradingStandardsService_ListCourse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/courses/123/grading_standards" {
+			t.Errorf("Expected path /api/v1/courses/123/grading_standards, got %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`[{"id":1,"title":"Course Scheme","context_type":"Course","context_id":123},{"id":12,"title":"Account Scheme","context_type":"Account","context_id":5}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewGradingStandardsService(client)
+	standards, err := service.ListCourse(context.Background(), 123)
+	if err != nil {
+		t.Fatalf("ListCourse failed: %v", err)
+	}
+
+	if len(standards) != 2 || standards[1].ContextType != "Account" {
+		t.Errorf("Unexpected grading standards: %+v", standards)
+	}
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const graphQLPath = "/api/graphql"
+
+// GraphQLRequest is a query sent to the Canvas GraphQL endpoint
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	OperationName string                 `json:"operationName,omitempty"`
+}
+
+// GraphQLError is a single entry of a GraphQL response's errors array
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// graphQLResponse is the envelope returned by the GraphQL endpoint
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []GraphQLError  `json:"errors"`
+}
+
+// relayConnection is the part of a Relay connection needed for pagination
+type relayConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+	Edges []struct {
+		Node json.RawMessage `json:"node"`
+	} `json:"edges"`
+	PageInfo *struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+}
+
+// GraphQLService handles queries against the Canvas GraphQL API
+type GraphQLService struct {
+	client *Client
+}
+
+// NewGraphQLService creates a new GraphQL service
+func NewGraphQLService(client *Client) *GraphQLService {
+	return &GraphQLService{client: client}
+}
+
+// Query executes a GraphQL request and returns its data field.
+// Errors reported in the response body are returned as an *APIError; the
+// data is returned alongside it so callers can use partial results.
+func (s *GraphQLService) Query(ctx context.Context, req *GraphQLRequest) (json.RawMessage, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GraphQL request: %w", err)
+	}
+
+	resp, err := s.client.doRequest(ctx, http.MethodPost, graphQLPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result graphQLResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode GraphQL response: %w", err)
+	}
+
+	if len(result.Errors) > 0 {
+		return result.Data, newGraphQLAPIError(result.Errors)
+	}
+
+	return result.Data, nil
+}
+
+// QueryAll executes a query that selects a Relay connection and follows its
+// cursors until every node has been fetched. connectionPath is the dotted
+// path to the connection in the response data (e.g.
+// "course.assignmentsConnection"). The query must declare an $after: String
+// variable, pass it to the connection, and select pageInfo { hasNextPage
+// endCursor } along with nodes or edges { node }.
+func (s *GraphQLService) QueryAll(ctx context.Context, req *GraphQLRequest, connectionPath string) ([]json.RawMessage, error) {
+	variables := make(map[string]interface{}, len(req.Variables)+1)
+	for key, value := range req.Variables {
+		variables[key] = value
+	}
+
+	page := &GraphQLRequest{
+		Query:         req.Query,
+		Variables:     variables,
+		OperationName: req.OperationName,
+	}
+
+	var nodes []json.RawMessage
+	for {
+		data, err := s.Query(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		conn, err := findConnection(data, connectionPath)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, conn.Nodes...)
+		for _, edge := range conn.Edges {
+			nodes = append(nodes, edge.Node)
+		}
+
+		if limit := s.client.maxResults; limit > 0 && len(nodes) >= limit {
+			return nodes[:limit], nil
+		}
+
+		if conn.PageInfo == nil {
+			return nil, fmt.Errorf("connection %q does not select pageInfo { hasNextPage endCursor }", connectionPath)
+		}
+
+		if !conn.PageInfo.HasNextPage || conn.PageInfo.EndCursor == "" {
+			return nodes, nil
+		}
+
+		variables["after"] = conn.PageInfo.EndCursor
+	}
+}
+
+// findConnection walks a dotted path through the response data to a connection
+func findConnection(data json.RawMessage, path string) (*relayConnection, error) {
+	current := data
+	for _, field := range strings.Split(path, ".") {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(current, &object); err != nil || object == nil {
+			return nil, fmt.Errorf("connection path %q not found in response", path)
+		}
+
+		next, ok := object[field]
+		if !ok {
+			return nil, fmt.Errorf("connection path %q not found in response", path)
+		}
+		current = next
+	}
+
+	var conn relayConnection
+	if err := json.Unmarshal(current, &conn); err != nil {
+		return nil, fmt.Errorf("%q is not a connection: %w", path, err)
+	}
+
+	return &conn, nil
+}
+
+// newGraphQLAPIError converts GraphQL errors to an APIError. Canvas returns
+// these with a 200 status, so the query is reported as unprocessable.
+func newGraphQLAPIError(errs []GraphQLError) *APIError {
+	apiErr := &APIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Suggestion: "Check the query against the schema at /graphiql on your Canvas instance.",
+	}
+
+	for _, e := range errs {
+		detail := ErrorDetail{Message: e.Message}
+		if len(e.Path) > 0 {
+			parts := make([]string, len(e.Path))
+			for i, p := range e.Path {
+				parts[i] = fmt.Sprint(p)
+			}
+			detail.Message = fmt.Sprintf("%s (at %s)", e.Message, strings.Join(parts, "."))
+		}
+		if code, ok := e.Extensions["code"].(string); ok {
+			detail.ErrorCode = code
+		}
+		apiErr.Errors = append(apiErr.Errors, detail)
+	}
+
+	return apiErr
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGraphQLService_Query(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/graphql" || r.Method != http.MethodPost {
+			t.Errorf("Expected POST /api/graphql, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if req.Variables["courseId"] != "123" {
+			t.Errorf("Expected courseId variable 123, got %v", req.Variables["courseId"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"course":{"name":"Physics 101"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewGraphQLService(client)
+	data, err := service.Query(context.Background(), &GraphQLRequest{
+		Query:     `query($courseId: ID!) { course(id: $courseId) { name } }`,
+		Variables: map[string]interface{}{"courseId": "123"},
+	})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	if string(data) != `{"course":{"name":"Physics 101"}}` {
+		t.Errorf("Unexpected data: %s", data)
+	}
+}
+
+func TestGraphQLService_Query_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":null,"errors":[{"message":"Field 'nme' doesn't exist","path":["query","course",0],"extensions":{"code":"undefinedField"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = NewGraphQLService(client).Query(context.Background(), &GraphQLRequest{Query: `{ course { nme } }`})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status 422, got %d", apiErr.StatusCode)
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].ErrorCode != "undefinedField" {
+		t.Fatalf("Unexpected error details: %+v", apiErr.Errors)
+	}
+	if apiErr.Errors[0].Message != "Field 'nme' doesn't exist (at query.course.0)" {
+		t.Errorf("Unexpected message: %s", apiErr.Errors[0].Message)
+	}
+}
+
+func TestGraphQLService_QueryAll(t *testing.T) {
+	var cursors []interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		cursors = append(cursors, req.Variables["after"])
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.Variables["after"] == nil {
+			w.Write([]byte(`{"data":{"course":{"assignmentsConnection":{"nodes":[{"_id":"1"},{"_id":"2"}],"pageInfo":{"hasNextPage":true,"endCursor":"Mg"}}}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"course":{"assignmentsConnection":{"edges":[{"node":{"_id":"3"}}],"pageInfo":{"hasNextPage":false,"endCursor":"Mw"}}}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req := &GraphQLRequest{
+		Query:     `query($courseId: ID!, $after: String) { course(id: $courseId) { assignmentsConnection(after: $after) { nodes { _id } pageInfo { hasNextPage endCursor } } } }`,
+		Variables: map[string]interface{}{"courseId": "123"},
+	}
+
+	nodes, err := NewGraphQLService(client).QueryAll(context.Background(), req, "course.assignmentsConnection")
+	if err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+
+	if len(nodes) != 3 {
+		t.Fatalf("Expected 3 nodes, got %d", len(nodes))
+	}
+	if string(nodes[2]) != `{"_id":"3"}` {
+		t.Errorf("Unexpected last node: %s", nodes[2])
+	}
+	if len(cursors) != 2 || cursors[0] != nil || cursors[1] != "Mg" {
+		t.Errorf("Unexpected cursors sent: %v", cursors)
+	}
+	if _, ok := req.Variables["after"]; ok {
+		t.Error("QueryAll should not modify the caller's variables")
+	}
+
+	if _, err := NewGraphQLService(client).QueryAll(context.Background(), req, "course.missing"); err == nil {
+		t.Error("Expected error for unknown connection path")
+	}
+}
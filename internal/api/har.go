@@ -0,0 +1,467 @@
+package api
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jjuanrivvera/canvas-cli/internal/dryrun"
+)
+
+// maxHARBodySize is the largest request or response body whose text is kept
+// in the archive. Larger and binary bodies (file downloads) only record their size.
+const maxHARBodySize = 1 << 20
+
+// HAR is an HTTP Archive 1.2 document
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+// HARLog is the root of a HAR document
+type HARLog struct {
+	Version string     `json:"version"`
+	Creator HARCreator `json:"creator"`
+	Entries []HAREntry `json:"entries"`
+}
+
+// HARCreator identifies the application that wrote the archive
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// HAREntry is a single request/response exchange.
+// RateLimitRemaining and RequestCost are custom fields copied from the
+// Canvas rate-limit headers for quick scanning.
+type HAREntry struct {
+	StartedDateTime    time.Time   `json:"startedDateTime"`
+	Time               float64     `json:"time"`
+	Request            HARRequest  `json:"request"`
+	Response           HARResponse `json:"response"`
+	Cache              struct{}    `json:"cache"`
+	Timings            HARTimings  `json:"timings"`
+	RateLimitRemaining string      `json:"_rateLimitRemaining,omitempty"`
+	RequestCost        string      `json:"_requestCost,omitempty"`
+	Comment            string      `json:"comment,omitempty"`
+}
+
+// HARRequest is the request part of an entry
+type HARRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	QueryString []HARNameValue `json:"queryString"`
+	PostData    *HARPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+// HARResponse is the response part of an entry
+type HARResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	Content     HARContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+// HARNameValue is a header, cookie or query parameter
+type HARNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HARPostData describes a request body
+type HARPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// HARContent describes a response body
+type HARContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+// HARTimings breaks down the time spent on an exchange, in milliseconds.
+// Phases that did not happen (e.g. DNS on a reused connection) are -1.
+type HARTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	SSL     float64 `json:"ssl"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// harSuffix closes the entries array and the document. It is rewritten
+// after every entry so the archive on disk is always valid JSON.
+const harSuffix = "\n  ]\n}}\n"
+
+// HARTransport is an http.RoundTripper that records every exchange to a HAR
+// file. Credentials are redacted the same way as in dry-run output. Each
+// exchange is written in place of the closing brackets as soon as it
+// completes, so nothing is lost if the process exits early and the cost of
+// recording does not grow with the size of the archive.
+type HARTransport struct {
+	base http.RoundTripper
+
+	mu      sync.Mutex
+	file    *os.File
+	end     int64 // Offset of harSuffix in file
+	entries int
+}
+
+// NewHARTransport wraps base (http.DefaultTransport if nil) to write a HAR
+// archive to path
+func NewHARTransport(base http.RoundTripper, path, creatorVersion string) (*HARTransport, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, fmt.Errorf("failed to create HAR directory: %w", err)
+		}
+	}
+
+	if creatorVersion == "" {
+		creatorVersion = "dev"
+	}
+
+	creator, err := json.Marshal(HARCreator{Name: "canvas-cli", Version: creatorVersion})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal HAR: %w", err)
+	}
+
+	// Create the file up front so an unwritable path fails immediately
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write HAR file: %w", err)
+	}
+
+	header := `{"log": {` + "\n" + `  "version": "1.2",` + "\n" + `  "creator": ` + string(creator) + ",\n" + `  "entries": [`
+	if _, err := file.WriteString(header + harSuffix); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to write HAR file: %w", err)
+	}
+
+	return &HARTransport{
+		base: base,
+		file: file,
+		end:  int64(len(header)),
+	}, nil
+}
+
+// Close closes the archive file. Entries completed later are not recorded.
+func (t *HARTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.file == nil {
+		return nil
+	}
+
+	err := t.file.Close()
+	t.file = nil
+	return err
+}
+
+// harTimer collects connection phase timestamps through httptrace
+type harTimer struct {
+	start, getConn, gotConn           time.Time
+	dnsStart, dnsDone                 time.Time
+	connectStart, connectDone         time.Time
+	tlsStart, tlsDone                 time.Time
+	wroteRequest, firstByte, finished time.Time
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *HARTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timer := &harTimer{start: time.Now()}
+	trace := &httptrace.ClientTrace{
+		GetConn:              func(string) { timer.getConn = time.Now() },
+		GotConn:              func(httptrace.GotConnInfo) { timer.gotConn = time.Now() },
+		DNSStart:             func(httptrace.DNSStartInfo) { timer.dnsStart = time.Now() },
+		DNSDone:              func(httptrace.DNSDoneInfo) { timer.dnsDone = time.Now() },
+		ConnectStart:         func(string, string) { timer.connectStart = time.Now() },
+		ConnectDone:          func(string, string, error) { timer.connectDone = time.Now() },
+		TLSHandshakeStart:    func() { timer.tlsStart = time.Now() },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { timer.tlsDone = time.Now() },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { timer.wroteRequest = time.Now() },
+		GotFirstResponseByte: func() { timer.firstByte = time.Now() },
+	}
+
+	entry := HAREntry{
+		StartedDateTime: timer.start.UTC(),
+		Request:         harRequest(req),
+	}
+
+	resp, err := t.base.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+	if err != nil {
+		timer.finished = time.Now()
+		entry.Comment = "request failed: " + err.Error()
+		t.add(entry, timer)
+		return nil, err
+	}
+
+	entry.Response = harResponse(resp)
+	entry.RateLimitRemaining = resp.Header.Get("X-Rate-Limit-Remaining")
+	entry.RequestCost = resp.Header.Get("X-Request-Cost")
+
+	// Finish the entry once the caller has consumed the body
+	resp.Body = &harBody{
+		body:    resp.Body,
+		capture: isTextContent(entry.Response.Content.MimeType),
+		done: func(body *harBody) {
+			timer.finished = time.Now()
+			entry.Response.BodySize = body.size
+			entry.Response.Content.Size = body.size
+			if body.capture && !body.truncated {
+				entry.Response.Content.Text = body.buf.String()
+			}
+			t.add(entry, timer)
+		},
+	}
+
+	return resp, nil
+}
+
+// add appends a completed entry to the archive
+func (t *HARTransport) add(entry HAREntry, timer *harTimer) {
+	entry.Timings = timer.timings()
+	entry.Time = entry.Timings.total()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Tracing is best-effort; a failed write must not fail the request
+	_ = t.appendLocked(entry)
+}
+
+// appendLocked writes entry over the closing brackets and restores them
+// after it; the caller must hold t.mu
+func (t *HARTransport) appendLocked(entry HAREntry) error {
+	if t.file == nil {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(entry, "    ", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal HAR entry: %w", err)
+	}
+
+	separator := "\n    "
+	if t.entries > 0 {
+		separator = ",\n    "
+	}
+
+	chunk := separator + string(data)
+	if _, err := t.file.WriteAt([]byte(chunk+harSuffix), t.end); err != nil {
+		return fmt.Errorf("failed to write HAR file: %w", err)
+	}
+
+	t.end += int64(len(chunk))
+	t.entries++
+	return nil
+}
+
+// harRequest converts a request, redacting credentials
+func harRequest(req *http.Request) HARRequest {
+	r := HARRequest{
+		Method:      req.Method,
+		URL:         dryrun.RedactURL(req.URL.String()),
+		HTTPVersion: req.Proto,
+		Cookies:     []HARNameValue{},
+		Headers:     harHeaders(req.Header, true),
+		QueryString: []HARNameValue{},
+		HeadersSize: -1,
+		BodySize:    req.ContentLength,
+	}
+
+	if r.HTTPVersion == "" {
+		r.HTTPVersion = "HTTP/1.1"
+	}
+
+	if redacted, err := url.Parse(r.URL); err == nil {
+		query := redacted.Query()
+		for _, name := range slices.Sorted(maps.Keys(query)) {
+			for _, value := range query[name] {
+				r.QueryString = append(r.QueryString, HARNameValue{Name: name, Value: value})
+			}
+		}
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		mimeType := req.Header.Get("Content-Type")
+		r.PostData = &HARPostData{MimeType: mimeType}
+
+		// Read a copy of the body without consuming the one being sent
+		if req.GetBody != nil && isTextContent(mimeType) && req.ContentLength <= maxHARBodySize {
+			if body, err := req.GetBody(); err == nil {
+				data, _ := io.ReadAll(body)
+				body.Close()
+				r.PostData.Text = string(dryrun.RedactBody(data))
+			}
+		}
+	}
+
+	return r
+}
+
+// harResponse converts a response; the body is filled in once it is read
+func harResponse(resp *http.Response) HARResponse {
+	r := HARResponse{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HTTPVersion: resp.Proto,
+		Cookies:     []HARNameValue{},
+		Headers:     harHeaders(resp.Header, false),
+		Content:     HARContent{MimeType: resp.Header.Get("Content-Type")},
+		RedirectURL: dryrun.RedactURL(resp.Header.Get("Location")),
+		HeadersSize: -1,
+	}
+
+	if r.HTTPVersion == "" {
+		r.HTTPVersion = "HTTP/1.1"
+	}
+
+	return r
+}
+
+// harHeaders converts headers in a stable order, redacting credentials
+func harHeaders(header http.Header, redact bool) []HARNameValue {
+	headers := []HARNameValue{}
+	for _, name := range slices.Sorted(maps.Keys(header)) {
+		for _, value := range header[name] {
+			if redact {
+				value = dryrun.RedactHeader(name, value)
+			}
+			headers = append(headers, HARNameValue{Name: name, Value: value})
+		}
+	}
+	return headers
+}
+
+// isTextContent reports whether a body of this type is worth keeping as text
+func isTextContent(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "json") ||
+		strings.HasSuffix(mediaType, "xml") ||
+		mediaType == "application/x-www-form-urlencoded"
+}
+
+// harBody wraps a response body to measure it and capture textual content
+type harBody struct {
+	body      io.ReadCloser
+	capture   bool
+	buf       bytes.Buffer
+	size      int64
+	truncated bool
+	once      sync.Once
+	done      func(*harBody)
+}
+
+// Read implements io.Reader
+func (b *harBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	b.size += int64(n)
+
+	if b.capture && !b.truncated {
+		if b.buf.Len()+n > maxHARBodySize {
+			b.truncated = true
+			b.buf.Reset()
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+
+	if err == io.EOF {
+		b.once.Do(func() { b.done(b) })
+	}
+
+	return n, err
+}
+
+// Close implements io.Closer
+func (b *harBody) Close() error {
+	err := b.body.Close()
+	b.once.Do(func() { b.done(b) })
+	return err
+}
+
+// timings converts the collected timestamps to HAR timings
+func (t *harTimer) timings() HARTimings {
+	timings := HARTimings{
+		Blocked: phase(t.getConn, t.gotConn),
+		DNS:     phase(t.dnsStart, t.dnsDone),
+		Connect: phase(t.connectStart, t.connectDone),
+		SSL:     phase(t.tlsStart, t.tlsDone),
+		Send:    phase(t.gotConn, t.wroteRequest),
+		Wait:    phase(t.wroteRequest, t.firstByte),
+		Receive: phase(t.firstByte, t.finished),
+	}
+
+	// Blocked covers the whole wait for a connection; report the time not
+	// already attributed to DNS, connect and TLS
+	if timings.Blocked > 0 {
+		for _, p := range []float64{timings.DNS, timings.Connect} {
+			if p > 0 {
+				timings.Blocked -= p
+			}
+		}
+		timings.Blocked = max(timings.Blocked, 0)
+	}
+
+	// HAR requires send, wait and receive to be non-negative
+	timings.Send = max(timings.Send, 0)
+	timings.Wait = max(timings.Wait, 0)
+	timings.Receive = max(timings.Receive, 0)
+
+	return timings
+}
+
+// total returns the entry time: the sum of all phases that happened
+func (t HARTimings) total() float64 {
+	var total float64
+	for _, p := range []float64{t.Blocked, t.DNS, t.Connect, t.Send, t.Wait, t.Receive} {
+		if p > 0 {
+			total += p
+		}
+	}
+	return total
+}
+
+// phase returns the milliseconds between two timestamps, or -1 if the phase did not happen
+func phase(start, end time.Time) float64 {
+	if start.IsZero() || end.IsZero() {
+		return -1
+	}
+	return float64(end.Sub(start).Microseconds()) / 1000
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClient_TraceHAR(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit-Remaining", "650.5")
+		w.Header().Set("X-Request-Cost", "1.2")
+		w.Write([]byte(`{"id":1,"name":"Physics 101"}`))
+	}))
+	defer server.Close()
+
+	harPath := filepath.Join(t.TempDir(), "trace", "session.har")
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "secret-token",
+		RequestsPerSec: 100,
+		UserAgent:      "canvas-cli/1.2.3",
+		TraceHAR:       harPath,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.Put(context.Background(), "/api/v1/courses/1?access_token=secret-token", strings.NewReader(`{"course":{"name":"Physics 101"}}`))
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	data, err := os.ReadFile(harPath)
+	if err != nil {
+		t.Fatalf("Failed to read HAR file: %v", err)
+	}
+
+	if strings.Contains(string(data), "secret-token") {
+		t.Error("HAR file contains the unredacted token")
+	}
+
+	var har HAR
+	if err := json.Unmarshal(data, &har); err != nil {
+		t.Fatalf("Failed to parse HAR file: %v", err)
+	}
+
+	if har.Log.Version != "1.2" || har.Log.Creator.Version != "1.2.3" {
+		t.Errorf("Unexpected log header: version %q, creator %+v", har.Log.Version, har.Log.Creator)
+	}
+
+	// Version detection may also be traced unless it was served from its cache
+	if len(har.Log.Entries) == 0 {
+		t.Fatal("Expected HAR entries")
+	}
+
+	entry := har.Log.Entries[len(har.Log.Entries)-1]
+	if entry.Request.Method != http.MethodPut {
+		t.Errorf("Expected PUT, got %s", entry.Request.Method)
+	}
+	if !strings.Contains(entry.Request.URL, "access_token=%5BREDACTED%5D") {
+		t.Errorf("Expected redacted access_token in URL, got %s", entry.Request.URL)
+	}
+	if entry.Request.PostData == nil || entry.Request.PostData.Text != `{"course":{"name":"Physics 101"}}` {
+		t.Errorf("Unexpected post data: %+v", entry.Request.PostData)
+	}
+	if entry.Response.Status != http.StatusOK || entry.Response.Content.Text != `{"id":1,"name":"Physics 101"}` {
+		t.Errorf("Unexpected response: %d %q", entry.Response.Status, entry.Response.Content.Text)
+	}
+	if entry.RateLimitRemaining != "650.5" || entry.RequestCost != "1.2" {
+		t.Errorf("Unexpected rate limit fields: %q, %q", entry.RateLimitRemaining, entry.RequestCost)
+	}
+	if entry.Timings.Send < 0 || entry.Timings.Wait < 0 || entry.Timings.Receive < 0 {
+		t.Errorf("Timings must be non-negative: %+v", entry.Timings)
+	}
+
+	var authorization string
+	for _, h := range entry.Request.Headers {
+		if h.Name == "Authorization" {
+			authorization = h.Value
+		}
+	}
+	if authorization != "Bearer [REDACTED]" {
+		t.Errorf("Expected redacted Authorization header, got %q", authorization)
+	}
+}
+
+func TestHARTransport_AppendsEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	harPath := filepath.Join(t.TempDir(), "session.har")
+	transport, err := NewHARTransport(nil, harPath, "")
+	if err != nil {
+		t.Fatalf("NewHARTransport failed: %v", err)
+	}
+	defer transport.Close()
+
+	httpClient := &http.Client{Transport: transport}
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/v1/users/1/logins", strings.NewReader(`{"login":{"unique_id":"jane","password":"hunter2"}}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		// The archive is valid after every exchange
+		data, err := os.ReadFile(harPath)
+		if err != nil {
+			t.Fatalf("Failed to read HAR file: %v", err)
+		}
+		var har HAR
+		if err := json.Unmarshal(data, &har); err != nil {
+			t.Fatalf("Invalid HAR after %d entries: %v", i+1, err)
+		}
+		if len(har.Log.Entries) != i+1 {
+			t.Errorf("Expected %d entries, got %d", i+1, len(har.Log.Entries))
+		}
+		if har.Log.Creator.Version != "dev" {
+			t.Errorf("Unexpected creator: %+v", har.Log.Creator)
+		}
+		if strings.Contains(string(data), "hunter2") {
+			t.Error("HAR file contains the unredacted password")
+		}
+	}
+}
+
+func TestIsTextContent(t *testing.T) {
+	tests := map[string]bool{
+		"application/json; charset=utf-8":   true,
+		"text/csv":                          true,
+		"application/x-www-form-urlencoded": true,
+		"application/pdf":                   false,
+		"multipart/form-data; boundary=x":   false,
+		"":                                  false,
+	}
+
+	for contentType, want := range tests {
+		if got := isTextContent(contentType); got != want {
+			t.Errorf("isTextContent(%q) = %v, want %v", contentType, got, want)
+		}
+	}
+}
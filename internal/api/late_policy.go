@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LatePolicyService handles course late policy API calls
+type LatePolicyService struct {
+	client *Client
+}
+
+// NewLatePolicyService creates a new late policy service
+func NewLatePolicyService(client *Client) *LatePolicyService {
+	return &LatePolicyService{client: client}
+}
+
+// LatePolicy represents the automatic penalties a course applies to late and
+// missing submissions
+type LatePolicy struct {
+	ID                                  int64      `json:"id"`
+	CourseID                            int64      `json:"course_id"`
+	MissingSubmissionDeductionEnabled   bool       `json:"missing_submission_deduction_enabled"`
+	MissingSubmissionDeduction          float64    `json:"missing_submission_deduction"`
+	LateSubmissionDeductionEnabled      bool       `json:"late_submission_deduction_enabled"`
+	LateSubmissionDeduction             float64    `json:"late_submission_deduction"`
+	LateSubmissionInterval              string     `json:"late_submission_interval"`
+	LateSubmissionMinimumPercentEnabled bool       `json:"late_submission_minimum_percent_enabled"`
+	LateSubmissionMinimumPercent        float64    `json:"late_submission_minimum_percent"`
+	CreatedAt                           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt                           *time.Time `json:"updated_at,omitempty"`
+}
+
+// LatePolicyParams holds parameters for creating or updating a late policy.
+// Nil fields are left unchanged.
+type LatePolicyParams struct {
+	MissingSubmissionDeductionEnabled   *bool
+	MissingSubmissionDeduction          *float64 // Percent deducted from missing submissions
+	LateSubmissionDeductionEnabled      *bool
+	LateSubmissionDeduction             *float64 // Percent deducted per interval late
+	LateSubmissionInterval              *string  // day, hour
+	LateSubmissionMinimumPercentEnabled *bool
+	LateSubmissionMinimumPercent        *float64 // Lowest percent late deductions can reduce a grade to
+}
+
+// Get retrieves the late policy of a course. Canvas responds with 404 when
+// the course has no late policy yet.
+func (s *LatePolicyService) Get(ctx context.Context, courseID int64) (*LatePolicy, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/late_policy", courseID)
+
+	var response struct {
+		LatePolicy LatePolicy `json:"late_policy"`
+	}
+	if err := s.client.GetJSON(ctx, path, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.LatePolicy, nil
+}
+
+// Create creates the late policy of a course
+func (s *LatePolicyService) Create(ctx context.Context, courseID int64, params *LatePolicyParams) (*LatePolicy, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/late_policy", courseID)
+
+	var response struct {
+		LatePolicy LatePolicy `json:"late_policy"`
+	}
+	if err := s.client.PostJSON(ctx, path, latePolicyBody(params), &response); err != nil {
+		return nil, err
+	}
+
+	return &response.LatePolicy, nil
+}
+
+// Update updates the late policy of a course. Canvas responds without a body,
+// so use Get to read the updated policy.
+func (s *LatePolicyService) Update(ctx context.Context, courseID int64, params *LatePolicyParams) error {
+	path := fmt.Sprintf("/api/v1/courses/%d/late_policy", courseID)
+
+	body := latePolicyBody(params)
+	if len(body["late_policy"]) == 0 {
+		return fmt.Errorf("no late policy fields to update")
+	}
+
+	return s.client.PatchJSON(ctx, path, body, nil)
+}
+
+func latePolicyBody(params *LatePolicyParams) map[string]map[string]interface{} {
+	policy := make(map[string]interface{})
+
+	if params.MissingSubmissionDeductionEnabled != nil {
+		policy["missing_submission_deduction_enabled"] = *params.MissingSubmissionDeductionEnabled
+	}
+
+	if params.MissingSubmissionDeduction != nil {
+		policy["missing_submission_deduction"] = *params.MissingSubmissionDeduction
+	}
+
+	if params.LateSubmissionDeductionEnabled != nil {
+		policy["late_submission_deduction_enabled"] = *params.LateSubmissionDeductionEnabled
+	}
+
+	if params.LateSubmissionDeduction != nil {
+		policy["late_submission_deduction"] = *params.LateSubmissionDeduction
+	}
+
+	if params.LateSubmissionInterval != nil {
+		policy["late_submission_interval"] = *params.LateSubmissionInterval
+	}
+
+	if params.LateSubmissionMinimumPercentEnabled != nil {
+		policy["late_submission_minimum_percent_enabled"] = *params.LateSubmissionMinimumPercentEnabled
+	}
+
+	if params.LateSubmissionMinimumPercent != nil {
+		policy["late_submission_minimum_percent"] = *params.LateSubmissionMinimumPercent
+	}
+
+	return map[string]map[string]interface{}{
+		"late_policy": policy,
+	}
+}
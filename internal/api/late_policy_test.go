@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLatePolicyService_Get(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/courses/123/late_policy" {
+			t.Errorf("Expected path /api/v1/courses/123/late_policy, got %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`{"late_policy":{"id":1,"course_id":123,"late_submission_deduction_enabled":true,"late_submission_deduction":10,"late_submission_interval":"day"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewLatePolicyService(client)
+	policy, err := service.Get(context.Background(), 123)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	if policy.ID != 1 || !policy.LateSubmissionDeductionEnabled || policy.LateSubmissionDeduction != 10 || policy.LateSubmissionInterval != "day" {
+		t.Errorf("Unexpected late policy: %+v", policy)
+	}
+}
+
+func TestLatePolicyService_Update(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/v1/courses/123/late_policy" {
+			t.Errorf("Expected PATCH /api/v1/courses/123/late_policy, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			LatePolicy map[string]interface{} `json:"late_policy"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if len(body.LatePolicy) != 2 {
+			t.Errorf("Expected only the set fields, got %v", body.LatePolicy)
+		}
+		if body.LatePolicy["missing_submission_deduction_enabled"] != false {
+			t.Errorf("Expected missing deduction to be disabled, got %v", body.LatePolicy)
+		}
+		if body.LatePolicy["late_submission_interval"] != "hour" {
+			t.Errorf("Expected interval 'hour', got %v", body.LatePolicy["late_submission_interval"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewLatePolicyService(client)
+
+	disabled := false
+	interval := "hour"
+	err = service.Update(context.Background(), 123, &LatePolicyParams{
+		MissingSubmissionDeductionEnabled: &disabled,
+		LateSubmissionInterval:            &interval,
+	})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	if err := service.Update(context.Background(), 123, &LatePolicyParams{}); err == nil {
+		t.Error("Expected error for empty update")
+	}
+}
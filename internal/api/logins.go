@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// LoginsService handles login (pseudonym) API calls
+type LoginsService struct {
+	client *Client
+}
+
+// NewLoginsService creates a new logins service
+func NewLoginsService(client *Client) *LoginsService {
+	return &LoginsService{client: client}
+}
+
+// Login represents a Canvas login (pseudonym) that a user signs in with
+type Login struct {
+	ID                         int64  `json:"id"`
+	UserID                     int64  `json:"user_id"`
+	AccountID                  int64  `json:"account_id"`
+	UniqueID                   string `json:"unique_id"`
+	SISUserID                  string `json:"sis_user_id,omitempty"`
+	IntegrationID              string `json:"integration_id,omitempty"`
+	AuthenticationProviderID   int64  `json:"authentication_provider_id,omitempty"`
+	AuthenticationProviderType string `json:"authentication_provider_type,omitempty"`
+	WorkflowState              string `json:"workflow_state,omitempty"`
+	DeclaredUserType           string `json:"declared_user_type,omitempty"`
+	CreatedAt                  string `json:"created_at,omitempty"`
+}
+
+// ListUser retrieves the logins of a user
+func (s *LoginsService) ListUser(ctx context.Context, userID int64) ([]Login, error) {
+	path := fmt.Sprintf("/api/v1/users/%d/logins", userID)
+
+	var logins []Login
+	if err := s.client.GetAllPages(ctx, path, &logins); err != nil {
+		return nil, err
+	}
+
+	return logins, nil
+}
+
+// ListAccount retrieves the logins of an account
+func (s *LoginsService) ListAccount(ctx context.Context, accountID int64) ([]Login, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/logins", accountID)
+
+	var logins []Login
+	if err := s.client.GetAllPages(ctx, path, &logins); err != nil {
+		return nil, err
+	}
+
+	return logins, nil
+}
+
+// CreateLoginParams holds parameters for creating a login
+type CreateLoginParams struct {
+	UserID                   int64
+	UniqueID                 string
+	Password                 string
+	SISUserID                string
+	IntegrationID            string
+	AuthenticationProviderID string // ID or type, e.g. "saml"
+}
+
+// Create adds a login to an existing user
+func (s *LoginsService) Create(ctx context.Context, accountID int64, params *CreateLoginParams) (*Login, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/logins", accountID)
+
+	loginData := map[string]interface{}{
+		"unique_id": params.UniqueID,
+	}
+
+	if params.Password != "" {
+		loginData["password"] = params.Password
+	}
+
+	if params.SISUserID != "" {
+		loginData["sis_user_id"] = params.SISUserID
+	}
+
+	if params.IntegrationID != "" {
+		loginData["integration_id"] = params.IntegrationID
+	}
+
+	if params.AuthenticationProviderID != "" {
+		loginData["authentication_provider_id"] = params.AuthenticationProviderID
+	}
+
+	body := map[string]interface{}{
+		"user":  map[string]interface{}{"id": params.UserID},
+		"login": loginData,
+	}
+
+	var login Login
+	if err := s.client.PostJSON(ctx, path, body, &login); err != nil {
+		return nil, err
+	}
+
+	return &login, nil
+}
+
+// UpdateLoginParams holds parameters for updating a login. Nil fields are left
+// unchanged; an empty string clears the SIS or integration ID.
+type UpdateLoginParams struct {
+	UniqueID                 *string
+	Password                 *string
+	OldPassword              *string // Required when changing your own password
+	SISUserID                *string
+	IntegrationID            *string
+	AuthenticationProviderID *string
+	WorkflowState            *string // active, suspended
+}
+
+// Update updates a login
+func (s *LoginsService) Update(ctx context.Context, accountID, loginID int64, params *UpdateLoginParams) (*Login, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/logins/%d", accountID, loginID)
+
+	loginData := make(map[string]interface{})
+
+	if params.UniqueID != nil {
+		loginData["unique_id"] = *params.UniqueID
+	}
+
+	if params.Password != nil {
+		loginData["password"] = *params.Password
+	}
+
+	if params.OldPassword != nil {
+		loginData["old_password"] = *params.OldPassword
+	}
+
+	if params.SISUserID != nil {
+		loginData["sis_user_id"] = *params.SISUserID
+	}
+
+	if params.IntegrationID != nil {
+		loginData["integration_id"] = *params.IntegrationID
+	}
+
+	if params.AuthenticationProviderID != nil {
+		loginData["authentication_provider_id"] = *params.AuthenticationProviderID
+	}
+
+	if params.WorkflowState != nil {
+		loginData["workflow_state"] = *params.WorkflowState
+	}
+
+	if len(loginData) == 0 {
+		return nil, fmt.Errorf("no login fields to update")
+	}
+
+	body := map[string]interface{}{
+		"login": loginData,
+	}
+
+	var login Login
+	if err := s.client.PutJSON(ctx, path, body, &login); err != nil {
+		return nil, err
+	}
+
+	return &login, nil
+}
+
+// Delete removes a login from a user
+func (s *LoginsService) Delete(ctx context.Context, userID, loginID int64) (*Login, error) {
+	path := fmt.Sprintf("/api/v1/users/%d/logins/%d", userID, loginID)
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var login Login
+	if err := json.NewDecoder(resp.Body).Decode(&login); err != nil {
+		return nil, err
+	}
+
+	return &login, nil
+}
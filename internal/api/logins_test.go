@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLoginsService_Create(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/accounts/1/logins" {
+			t.Errorf("Expected POST /api/v1/accounts/1/logins, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			User  map[string]interface{} `json:"user"`
+			Login map[string]interface{} `json:"login"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if body.User["id"] != float64(123) {
+			t.Errorf("Expected user id 123, got %v", body.User["id"])
+		}
+		if body.Login["unique_id"] != "jdoe" || body.Login["sis_user_id"] != "S123" {
+			t.Errorf("Unexpected login body: %v", body.Login)
+		}
+		if _, ok := body.Login["password"]; ok {
+			t.Error("Expected empty password to be omitted")
+		}
+
+		w.Write([]byte(`{"id":456,"user_id":123,"account_id":1,"unique_id":"jdoe","sis_user_id":"S123"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewLoginsService(client)
+	login, err := service.Create(context.Background(), 1, &CreateLoginParams{
+		UserID:    123,
+		UniqueID:  "jdoe",
+		SISUserID: "S123",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if login.ID != 456 || login.SISUserID != "S123" {
+		t.Errorf("Unexpected login: %+v", login)
+	}
+}
+
+func TestLoginsService_Update(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/accounts/1/logins/456" {
+			t.Errorf("Expected PUT /api/v1/accounts/1/logins/456, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			Login map[string]interface{} `json:"login"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if len(body.Login) != 2 || body.Login["sis_user_id"] != "S124" || body.Login["integration_id"] != "" {
+			t.Errorf("Unexpected login body: %v", body.Login)
+		}
+
+		w.Write([]byte(`{"id":456,"unique_id":"jdoe","sis_user_id":"S124"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewLoginsService(client)
+
+	sisUserID := "S124"
+	integrationID := ""
+	login, err := service.Update(context.Background(), 1, 456, &UpdateLoginParams{
+		SISUserID:     &sisUserID,
+		IntegrationID: &integrationID,
+	})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if login.SISUserID != "S124" {
+		t.Errorf("Expected SIS user ID S124, got %s", login.SISUserID)
+	}
+
+	if _, err := service.Update(context.Background(), 1, 456, &UpdateLoginParams{}); err == nil {
+		t.Error("Expected error for empty update")
+	}
+}
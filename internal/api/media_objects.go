@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// MediaObjectsService handles media object and media track API calls
+type MediaObjectsService struct {
+	client *Client
+}
+
+// NewMediaObjectsService creates a new media objects service
+func NewMediaObjectsService(client *Client) *MediaObjectsService {
+	return &MediaObjectsService{client: client}
+}
+
+// MediaObject represents an audio or video recording hosted by Canvas
+type MediaObject struct {
+	MediaID           string        `json:"media_id"`
+	Title             string        `json:"title"`
+	UserEnteredTitle  string        `json:"user_entered_title,omitempty"`
+	MediaType         string        `json:"media_type"`
+	CanAddCaptions    bool          `json:"can_add_captions"`
+	EmbeddedIframeURL string        `json:"embedded_iframe_url,omitempty"`
+	MediaTracks       []MediaTrack  `json:"media_tracks,omitempty"`
+	MediaSources      []MediaSource `json:"media_sources,omitempty"`
+}
+
+// MediaSource is one encoding of a media object
+type MediaSource struct {
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Height      string `json:"height,omitempty"`
+	Width       string `json:"width,omitempty"`
+	Bitrate     string `json:"bitrate,omitempty"`
+	Size        string `json:"size,omitempty"`
+}
+
+// MediaTrack is a caption or subtitle track of a media object
+type MediaTrack struct {
+	ID            int64      `json:"id"`
+	UserID        int64      `json:"user_id,omitempty"`
+	MediaObjectID int64      `json:"media_object_id,omitempty"`
+	Kind          string     `json:"kind"`
+	Locale        string     `json:"locale"`
+	Content       string     `json:"content,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// MediaTrackParams holds parameters for uploading a media track. Content may
+// be SRT or WebVTT.
+type MediaTrackParams struct {
+	Locale  string
+	Kind    string // subtitles (default), captions, descriptions, chapters, metadata
+	Content string
+}
+
+// ListMediaObjectsOptions holds options for listing media objects
+type ListMediaObjectsOptions struct {
+	Sort    string   // title, created_at
+	Order   string   // asc, desc
+	Exclude []string // sources, tracks
+}
+
+// ListCourse retrieves the media objects of a course
+func (s *MediaObjectsService) ListCourse(ctx context.Context, courseID int64, opts *ListMediaObjectsOptions) ([]MediaObject, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/media_objects", courseID)
+
+	if opts != nil {
+		query := url.Values{}
+
+		if opts.Sort != "" {
+			query.Add("sort", opts.Sort)
+		}
+
+		if opts.Order != "" {
+			query.Add("order", opts.Order)
+		}
+
+		for _, exclude := range opts.Exclude {
+			query.Add("exclude[]", exclude)
+		}
+
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	var objects []MediaObject
+	if err := s.client.GetAllPages(ctx, path, &objects); err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// Get retrieves a single media object. The public API has no show endpoint,
+// so this uses the info endpoint the Canvas media player reads.
+func (s *MediaObjectsService) Get(ctx context.Context, mediaID string) (*MediaObject, error) {
+	path := fmt.Sprintf("/media_objects/%s/info", url.PathEscape(mediaID))
+
+	var object MediaObject
+	if err := s.client.GetJSON(ctx, path, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// UpdateTitle sets the title users see for a media object
+func (s *MediaObjectsService) UpdateTitle(ctx context.Context, mediaID, title string) (*MediaObject, error) {
+	path := fmt.Sprintf("/api/v1/media_objects/%s", url.PathEscape(mediaID))
+
+	body := map[string]interface{}{
+		"user_entered_title": title,
+	}
+
+	var object MediaObject
+	if err := s.client.PutJSON(ctx, path, body, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// ListTracks retrieves the caption and subtitle tracks of a media object.
+// With content set, the track contents are included.
+func (s *MediaObjectsService) ListTracks(ctx context.Context, mediaID string, content bool) ([]MediaTrack, error) {
+	path := fmt.Sprintf("/api/v1/media_objects/%s/media_tracks", url.PathEscape(mediaID))
+	if content {
+		path += "?include[]=content"
+	}
+
+	var tracks []MediaTrack
+	if err := s.client.GetJSON(ctx, path, &tracks); err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+// UploadTrack adds a track to a media object, replacing any track with the
+// same locale. Canvas replaces the whole track list at once, so the other
+// tracks are read fresh and sent back unchanged.
+func (s *MediaObjectsService) UploadTrack(ctx context.Context, mediaID string, params *MediaTrackParams) ([]MediaTrack, error) {
+	if params.Locale == "" || params.Content == "" {
+		return nil, fmt.Errorf("a media track needs a locale and content")
+	}
+
+	tracks, err := s.currentTracks(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := params.Kind
+	if kind == "" {
+		kind = "subtitles"
+	}
+
+	body := []map[string]interface{}{{
+		"locale":  params.Locale,
+		"kind":    kind,
+		"content": params.Content,
+	}}
+	for _, track := range tracks {
+		if track.Locale != params.Locale {
+			body = append(body, map[string]interface{}{"locale": track.Locale})
+		}
+	}
+
+	return s.putTracks(ctx, mediaID, body)
+}
+
+// DeleteTrack removes the track with the given locale from a media object
+func (s *MediaObjectsService) DeleteTrack(ctx context.Context, mediaID, locale string) ([]MediaTrack, error) {
+	tracks, err := s.currentTracks(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	body := []map[string]interface{}{}
+	for _, track := range tracks {
+		if track.Locale == locale {
+			found = true
+			continue
+		}
+		body = append(body, map[string]interface{}{"locale": track.Locale})
+	}
+	if !found {
+		return nil, fmt.Errorf("media object %s has no %s track", mediaID, locale)
+	}
+
+	return s.putTracks(ctx, mediaID, body)
+}
+
+// currentTracks lists tracks bypassing the response cache, since the list is
+// about to be written back
+func (s *MediaObjectsService) currentTracks(ctx context.Context, mediaID string) ([]MediaTrack, error) {
+	path := fmt.Sprintf("/api/v1/media_objects/%s/media_tracks", url.PathEscape(mediaID))
+
+	resp, err := s.client.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tracks []MediaTrack
+	if err := json.NewDecoder(resp.Body).Decode(&tracks); err != nil {
+		return nil, fmt.Errorf("failed to decode media tracks: %w", err)
+	}
+
+	return tracks, nil
+}
+
+func (s *MediaObjectsService) putTracks(ctx context.Context, mediaID string, body []map[string]interface{}) ([]MediaTrack, error) {
+	path := fmt.Sprintf("/api/v1/media_objects/%s/media_tracks", url.PathEscape(mediaID))
+
+	var tracks []MediaTrack
+	if err := s.client.PutJSON(ctx, path, body, &tracks); err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newMediaTracksServer(t *testing.T, sent *[]map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/media_objects/m-abc/media_tracks" {
+			t.Errorf("Expected path /api/v1/media_objects/m-abc/media_tracks, got %s", r.URL.Path)
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`[{"id":1,"kind":"subtitles","locale":"en"},{"id":2,"kind":"subtitles","locale":"es"}]`))
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(sent); err != nil {
+				t.Fatalf("Failed to decode body: %v", err)
+			}
+			w.Write([]byte(`[{"id":3,"kind":"captions","locale":"en"},{"id":2,"kind":"subtitles","locale":"es"}]`))
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	}))
+}
+
+func TestMediaObjectsService_UploadTrack(t *testing.T) {
+	var sent []map[string]interface{}
+	server := newMediaTracksServer(t, &sent)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewMediaObjectsService(client)
+	tracks, err := service.UploadTrack(context.Background(), "m-abc", &MediaTrackParams{
+		Locale:  "en",
+		Kind:    "captions",
+		Content: "1\n00:00:00,000 --> 00:00:01,000\nHello\n",
+	})
+	if err != nil {
+		t.Fatalf("UploadTrack failed: %v", err)
+	}
+
+	if len(tracks) != 2 {
+		t.Errorf("Expected 2 tracks, got %d", len(tracks))
+	}
+
+	// The new en track replaces the old one and the es track is kept as is
+	if len(sent) != 2 {
+		t.Fatalf("Expected 2 tracks to be sent, got %v", sent)
+	}
+	if sent[0]["locale"] != "en" || sent[0]["kind"] != "captions" || sent[0]["content"] == nil {
+		t.Errorf("Unexpected uploaded track: %v", sent[0])
+	}
+	if sent[1]["locale"] != "es" || len(sent[1]) != 1 {
+		t.Errorf("Expected the es track to be kept by locale only, got %v", sent[1])
+	}
+}
+
+func TestMediaObjectsService_DeleteTrack(t *testing.T) {
+	var sent []map[string]interface{}
+	server := newMediaTracksServer(t, &sent)
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewMediaObjectsService(client)
+	if _, err := service.DeleteTrack(context.Background(), "m-abc", "es"); err != nil {
+		t.Fatalf("DeleteTrack failed: %v", err)
+	}
+	if len(sent) != 1 || sent[0]["locale"] != "en" {
+		t.Errorf("Expected only the en track to be kept, got %v", sent)
+	}
+
+	sent = nil
+	if _, err := service.DeleteTrack(context.Background(), "m-abc", "fr"); err == nil {
+		t.Error("Expected error for a missing locale")
+	}
+	if sent != nil {
+		t.Error("Expected no update for a missing locale")
+	}
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ModerationService handles moderated grading API calls: the moderation set
+// and the provisional grades given by each grader
+type ModerationService struct {
+	client *Client
+}
+
+// NewModerationService creates a new moderation service
+func NewModerationService(client *Client) *ModerationService {
+	return &ModerationService{client: client}
+}
+
+// ProvisionalGrade represents a grade given by one grader of a moderated
+// assignment before the final grade is selected
+type ProvisionalGrade struct {
+	ProvisionalGradeID            int64      `json:"provisional_grade_id"`
+	StudentID                     int64      `json:"student_id,omitempty"`
+	ScorerID                      int64      `json:"scorer_id,omitempty"`
+	Score                         *float64   `json:"score"`
+	Grade                         string     `json:"grade,omitempty"`
+	GradeMatchesCurrentSubmission bool       `json:"grade_matches_current_submission"`
+	Final                         bool       `json:"final"`
+	GradedAt                      *time.Time `json:"graded_at,omitempty"`
+	SpeedGraderURL                string     `json:"speedgrader_url,omitempty"`
+}
+
+// ProvisionalGradeStatus reports whether a student still needs a
+// provisional grade
+type ProvisionalGradeStatus struct {
+	NeedsProvisionalGrade bool `json:"needs_provisional_grade"`
+}
+
+// ProvisionalGradeSelection is the provisional grade chosen as a student's
+// final grade
+type ProvisionalGradeSelection struct {
+	AssignmentID               int64 `json:"assignment_id"`
+	StudentID                  int64 `json:"student_id"`
+	SelectedProvisionalGradeID int64 `json:"selected_provisional_grade_id"`
+}
+
+// ListModeratedStudents retrieves the students selected for moderation
+func (s *ModerationService) ListModeratedStudents(ctx context.Context, courseID, assignmentID int64) ([]User, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/assignments/%d/moderated_students", courseID, assignmentID)
+
+	var users []User
+	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// AddModeratedStudents adds students to the moderation set and returns the
+// students that were added
+func (s *ModerationService) AddModeratedStudents(ctx context.Context, courseID, assignmentID int64, studentIDs []int64) ([]User, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/assignments/%d/moderated_students", courseID, assignmentID)
+
+	body := map[string]interface{}{
+		"student_ids": studentIDs,
+	}
+
+	var users []User
+	if err := s.client.PostJSON(ctx, path, body, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// ListProvisionalGrades retrieves the provisional grades of every submission
+// of a moderated assignment. StudentID is set on each grade.
+func (s *ModerationService) ListProvisionalGrades(ctx context.Context, courseID, assignmentID int64) ([]ProvisionalGrade, error) {
+	query := url.Values{}
+	query.Add("include[]", "provisional_grades")
+	path := fmt.Sprintf("/api/v1/courses/%d/assignments/%d/submissions?%s", courseID, assignmentID, query.Encode())
+
+	var submissions []struct {
+		UserID            int64              `json:"user_id"`
+		ProvisionalGrades []ProvisionalGrade `json:"provisional_grades"`
+	}
+	if err := s.client.GetAllPages(ctx, path, &submissions); err != nil {
+		return nil, err
+	}
+
+	var grades []ProvisionalGrade
+	for _, submission := range submissions {
+		for _, grade := range submission.ProvisionalGrades {
+			grade.StudentID = submission.UserID
+			grades = append(grades, grade)
+		}
+	}
+
+	return grades, nil
+}
+
+// GetProvisionalGradeStatus reports whether a student needs a provisional grade
+func (s *ModerationService) GetProvisionalGradeStatus(ctx context.Context, courseID, assignmentID, studentID int64) (*ProvisionalGradeStatus, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/assignments/%d/provisional_grades/status?student_id=%d", courseID, assignmentID, studentID)
+
+	var status ProvisionalGradeStatus
+	if err := s.client.GetJSON(ctx, path, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// SelectProvisionalGrade chooses a provisional grade as the student's final
+// grade when grades are published
+func (s *ModerationService) SelectProvisionalGrade(ctx context.Context, courseID, assignmentID, provisionalGradeID int64) (*ProvisionalGradeSelection, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/assignments/%d/provisional_grades/%d/select", courseID, assignmentID, provisionalGradeID)
+
+	var selection ProvisionalGradeSelection
+	if err := s.client.PutJSON(ctx, path, nil, &selection); err != nil {
+		return nil, err
+	}
+
+	return &selection, nil
+}
+
+// PublishProvisionalGrades publishes the selected provisional grades of an
+// assignment, copying them to the submissions. This can only be done once.
+func (s *ModerationService) PublishProvisionalGrades(ctx context.Context, courseID, assignmentID int64) error {
+	path := fmt.Sprintf("/api/v1/courses/%d/assignments/%d/provisional_grades/publish", courseID, assignmentID)
+	return s.client.PostJSON(ctx, path, nil, nil)
+}
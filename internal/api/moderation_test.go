@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestModerationService_ListProvisionalGrades(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/courses/1/assignments/2/submissions" {
+			t.Errorf("Expected path /api/v1/courses/1/assignments/2/submissions, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("include[]") != "provisional_grades" {
+			t.Errorf("Expected include[]=provisional_grades, got %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`[
+			{"id":10,"user_id":100,"provisional_grades":[
+				{"provisional_grade_id":501,"scorer_id":7,"score":8,"grade":"8"},
+				{"provisional_grade_id":502,"scorer_id":8,"score":9,"grade":"9"}
+			]},
+			{"id":11,"user_id":101,"provisional_grades":[]}
+		]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewModerationService(client)
+	grades, err := service.ListProvisionalGrades(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatalf("ListProvisionalGrades failed: %v", err)
+	}
+
+	if len(grades) != 2 {
+		t.Fatalf("Expected 2 provisional grades, got %d", len(grades))
+	}
+	if grades[0].StudentID != 100 || grades[1].StudentID != 100 {
+		t.Errorf("Expected student ID to be set from the submission, got %+v", grades)
+	}
+	if grades[1].ProvisionalGradeID != 502 || grades[1].Score == nil || *grades[1].Score != 9 {
+		t.Errorf("Unexpected provisional grade: %+v", grades[1])
+	}
+}
+
+func TestModerationService_SelectProvisionalGrade(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/courses/1/assignments/2/provisional_grades/501/select" {
+			t.Errorf("Expected PUT .../provisional_grades/501/select, got %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Write([]byte(`{"assignment_id":2,"student_id":100,"selected_provisional_grade_id":501}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewModerationService(client)
+	selection, err := service.SelectProvisionalGrade(context.Background(), 1, 2, 501)
+	if err != nil {
+		t.Fatalf("SelectProvisionalGrade failed: %v", err)
+	}
+
+	if selection.StudentID != 100 || selection.SelectedProvisionalGradeID != 501 {
+		t.Errorf("Unexpected selection: %+v", selection)
+	}
+}
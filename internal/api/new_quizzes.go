@@ -0,0 +1,432 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuizEngineService handles New Quizzes API calls under /api/quiz/v1.
+// New Quizzes are identified by the ID of their assignment. (The name
+// NewQuizzesService is taken by the classic quizzes constructor.)
+type QuizEngineService struct {
+	client *Client
+}
+
+// NewQuizEngineService creates a new New Quizzes service
+func NewQuizEngineService(client *Client) *QuizEngineService {
+	return &QuizEngineService{client: client}
+}
+
+// Interaction types supported by the New Quizzes item helpers
+const (
+	NewQuizInteractionChoice    = "choice"
+	NewQuizInteractionTrueFalse = "true-false"
+	NewQuizInteractionEssay     = "essay"
+	NewQuizInteractionNumeric   = "numeric"
+)
+
+// NewQuiz represents a New Quizzes quiz. The quiz engine returns IDs as
+// strings.
+type NewQuiz struct {
+	ID                string           `json:"id"`
+	Title             string           `json:"title"`
+	Instructions      string           `json:"instructions,omitempty"`
+	AssignmentGroupID string           `json:"assignment_group_id,omitempty"`
+	PointsPossible    float64          `json:"points_possible"`
+	DueAt             *time.Time       `json:"due_at,omitempty"`
+	LockAt            *time.Time       `json:"lock_at,omitempty"`
+	UnlockAt          *time.Time       `json:"unlock_at,omitempty"`
+	Published         bool             `json:"published"`
+	GradingType       string           `json:"grading_type,omitempty"`
+	QuizSettings      *NewQuizSettings `json:"quiz_settings,omitempty"`
+}
+
+// NewQuizSettings holds the subset of New Quizzes settings managed by the CLI
+type NewQuizSettings struct {
+	ShuffleAnswers            *bool   `json:"shuffle_answers,omitempty"`
+	ShuffleQuestions          *bool   `json:"shuffle_questions,omitempty"`
+	HasTimeLimit              *bool   `json:"has_time_limit,omitempty"`
+	SessionTimeLimitInSeconds *int    `json:"session_time_limit_in_seconds,omitempty"`
+	OneAtATimeType            *string `json:"one_at_a_time_type,omitempty"` // none, question
+	AllowBacktracking         *bool   `json:"allow_backtracking,omitempty"`
+}
+
+// NewQuizParams holds parameters for creating or updating a New Quiz.
+// Empty or nil fields are left unchanged.
+type NewQuizParams struct {
+	Title             string
+	Instructions      string
+	AssignmentGroupID int64
+	PointsPossible    *float64
+	DueAt             string
+	LockAt            string
+	UnlockAt          string
+	GradingType       string
+	Settings          *NewQuizSettings
+}
+
+// NewQuizItem represents an item of a New Quiz, usually a question
+type NewQuizItem struct {
+	ID             string            `json:"id"`
+	Position       int               `json:"position"`
+	PointsPossible float64           `json:"points_possible"`
+	EntryType      string            `json:"entry_type"`
+	EntryEditable  bool              `json:"entry_editable,omitempty"`
+	Status         string            `json:"status,omitempty"`
+	Entry          *NewQuizItemEntry `json:"entry,omitempty"`
+}
+
+// NewQuizItemEntry is the question of a New Quiz item. The shape of
+// InteractionData, Properties and ScoringData depends on the interaction type.
+type NewQuizItemEntry struct {
+	Title               string                 `json:"title,omitempty"`
+	ItemBody            string                 `json:"item_body"`
+	CalculatorType      string                 `json:"calculator_type,omitempty"`
+	InteractionTypeSlug string                 `json:"interaction_type_slug"`
+	InteractionData     map[string]interface{} `json:"interaction_data"`
+	Properties          map[string]interface{} `json:"properties"`
+	ScoringData         map[string]interface{} `json:"scoring_data"`
+	ScoringAlgorithm    string                 `json:"scoring_algorithm"`
+	Feedback            map[string]interface{} `json:"feedback,omitempty"`
+}
+
+// NewQuizItemParams holds parameters for creating or updating a New Quiz item
+type NewQuizItemParams struct {
+	Position       int
+	PointsPossible *float64
+	Entry          *NewQuizItemEntry
+}
+
+// NewQuizAccommodation grants a student extra time, attempts or reduced
+// choices in New Quizzes
+type NewQuizAccommodation struct {
+	UserID               int64 `json:"user_id"`
+	ExtraTime            *int  `json:"extra_time,omitempty"`     // Minutes
+	ExtraAttempts        *int  `json:"extra_attempts,omitempty"` // Quiz accommodations only
+	ReduceChoicesEnabled *bool `json:"reduce_choices_enabled,omitempty"`
+}
+
+// NewQuizAccommodationResult reports which accommodations were applied
+type NewQuizAccommodationResult struct {
+	Message    string `json:"message"`
+	Successful []struct {
+		UserID int64 `json:"user_id"`
+	} `json:"successful"`
+	Failed []struct {
+		UserID int64  `json:"user_id"`
+		Error  string `json:"error"`
+	} `json:"failed"`
+}
+
+// List retrieves all New Quizzes in a course
+func (s *QuizEngineService) List(ctx context.Context, courseID int64) ([]NewQuiz, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes", courseID)
+
+	var quizzes []NewQuiz
+	if err := s.client.GetAllPages(ctx, path, &quizzes); err != nil {
+		return nil, err
+	}
+
+	return quizzes, nil
+}
+
+// Get retrieves a single New Quiz by its assignment ID
+func (s *QuizEngineService) Get(ctx context.Context, courseID, assignmentID int64) (*NewQuiz, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d", courseID, assignmentID)
+
+	var quiz NewQuiz
+	if err := s.client.GetJSON(ctx, path, &quiz); err != nil {
+		return nil, err
+	}
+
+	return &quiz, nil
+}
+
+// Create creates a New Quiz
+func (s *QuizEngineService) Create(ctx context.Context, courseID int64, params *NewQuizParams) (*NewQuiz, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes", courseID)
+
+	var quiz NewQuiz
+	if err := s.client.PostJSON(ctx, path, newQuizBody(params), &quiz); err != nil {
+		return nil, err
+	}
+
+	return &quiz, nil
+}
+
+// Update updates a New Quiz
+func (s *QuizEngineService) Update(ctx context.Context, courseID, assignmentID int64, params *NewQuizParams) (*NewQuiz, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d", courseID, assignmentID)
+
+	var quiz NewQuiz
+	if err := s.client.PatchJSON(ctx, path, newQuizBody(params), &quiz); err != nil {
+		return nil, err
+	}
+
+	return &quiz, nil
+}
+
+// Delete deletes a New Quiz
+func (s *QuizEngineService) Delete(ctx context.Context, courseID, assignmentID int64) error {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d", courseID, assignmentID)
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func newQuizBody(params *NewQuizParams) map[string]interface{} {
+	quiz := make(map[string]interface{})
+
+	if params.Title != "" {
+		quiz["title"] = params.Title
+	}
+
+	if params.Instructions != "" {
+		quiz["instructions"] = params.Instructions
+	}
+
+	if params.AssignmentGroupID > 0 {
+		quiz["assignment_group_id"] = strconv.FormatInt(params.AssignmentGroupID, 10)
+	}
+
+	if params.PointsPossible != nil {
+		quiz["points_possible"] = *params.PointsPossible
+	}
+
+	if params.DueAt != "" {
+		quiz["due_at"] = params.DueAt
+	}
+
+	if params.LockAt != "" {
+		quiz["lock_at"] = params.LockAt
+	}
+
+	if params.UnlockAt != "" {
+		quiz["unlock_at"] = params.UnlockAt
+	}
+
+	if params.GradingType != "" {
+		quiz["grading_type"] = params.GradingType
+	}
+
+	if params.Settings != nil {
+		quiz["quiz_settings"] = params.Settings
+	}
+
+	return map[string]interface{}{
+		"quiz": quiz,
+	}
+}
+
+// ListItems retrieves the items of a New Quiz
+func (s *QuizEngineService) ListItems(ctx context.Context, courseID, assignmentID int64) ([]NewQuizItem, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d/items", courseID, assignmentID)
+
+	var items []NewQuizItem
+	if err := s.client.GetAllPages(ctx, path, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// GetItem retrieves a single item of a New Quiz
+func (s *QuizEngineService) GetItem(ctx context.Context, courseID, assignmentID int64, itemID string) (*NewQuizItem, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d/items/%s", courseID, assignmentID, itemID)
+
+	var item NewQuizItem
+	if err := s.client.GetJSON(ctx, path, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// CreateItem creates a question item in a New Quiz
+func (s *QuizEngineService) CreateItem(ctx context.Context, courseID, assignmentID int64, params *NewQuizItemParams) (*NewQuizItem, error) {
+	if params.Entry == nil {
+		return nil, fmt.Errorf("item entry is required")
+	}
+
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d/items", courseID, assignmentID)
+
+	var item NewQuizItem
+	if err := s.client.PostJSON(ctx, path, newQuizItemBody(params), &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// UpdateItem updates an item of a New Quiz
+func (s *QuizEngineService) UpdateItem(ctx context.Context, courseID, assignmentID int64, itemID string, params *NewQuizItemParams) (*NewQuizItem, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d/items/%s", courseID, assignmentID, itemID)
+
+	var item NewQuizItem
+	if err := s.client.PatchJSON(ctx, path, newQuizItemBody(params), &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// DeleteItem deletes an item of a New Quiz
+func (s *QuizEngineService) DeleteItem(ctx context.Context, courseID, assignmentID int64, itemID string) error {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d/items/%s", courseID, assignmentID, itemID)
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func newQuizItemBody(params *NewQuizItemParams) map[string]interface{} {
+	item := make(map[string]interface{})
+
+	if params.Position > 0 {
+		item["position"] = params.Position
+	}
+
+	if params.PointsPossible != nil {
+		item["points_possible"] = *params.PointsPossible
+	}
+
+	if params.Entry != nil {
+		item["entry_type"] = "Item"
+		item["entry"] = params.Entry
+	}
+
+	return map[string]interface{}{
+		"item": item,
+	}
+}
+
+// NewChoiceEntry builds a multiple choice question from plain-text choices.
+// correct is the index of the correct choice.
+func NewChoiceEntry(title, body string, choices []string, correct int) (*NewQuizItemEntry, error) {
+	if len(choices) < 2 {
+		return nil, fmt.Errorf("a choice question needs at least 2 choices")
+	}
+	if correct < 0 || correct >= len(choices) {
+		return nil, fmt.Errorf("correct choice %d is out of range (1-%d)", correct+1, len(choices))
+	}
+
+	items := make([]map[string]interface{}, len(choices))
+	var correctID string
+	for i, choice := range choices {
+		id := uuid.NewString()
+		if i == correct {
+			correctID = id
+		}
+		items[i] = map[string]interface{}{
+			"id":        id,
+			"position":  i + 1,
+			"item_body": "<p>" + html.EscapeString(choice) + "</p>",
+		}
+	}
+
+	return &NewQuizItemEntry{
+		Title:               title,
+		ItemBody:            body,
+		InteractionTypeSlug: NewQuizInteractionChoice,
+		InteractionData:     map[string]interface{}{"choices": items},
+		Properties: map[string]interface{}{
+			"shuffle_rules":         map[string]interface{}{"choices": map[string]interface{}{"to_lock": []int{}, "shuffled": false}},
+			"vary_points_by_answer": false,
+		},
+		ScoringData:      map[string]interface{}{"value": correctID},
+		ScoringAlgorithm: "Equivalence",
+	}, nil
+}
+
+// NewTrueFalseEntry builds a true/false question
+func NewTrueFalseEntry(title, body string, answer bool) *NewQuizItemEntry {
+	return &NewQuizItemEntry{
+		Title:               title,
+		ItemBody:            body,
+		InteractionTypeSlug: NewQuizInteractionTrueFalse,
+		InteractionData:     map[string]interface{}{"true_choice": "True", "false_choice": "False"},
+		Properties:          map[string]interface{}{},
+		ScoringData:         map[string]interface{}{"value": answer},
+		ScoringAlgorithm:    "Equivalence",
+	}
+}
+
+// NewEssayEntry builds an essay question, which is graded manually
+func NewEssayEntry(title, body string) *NewQuizItemEntry {
+	return &NewQuizItemEntry{
+		Title:               title,
+		ItemBody:            body,
+		InteractionTypeSlug: NewQuizInteractionEssay,
+		InteractionData: map[string]interface{}{
+			"rce":                true,
+			"essay":              nil,
+			"word_count":         false,
+			"file_upload":        false,
+			"spell_check":        false,
+			"word_limit_enabled": false,
+		},
+		Properties:       map[string]interface{}{},
+		ScoringData:      map[string]interface{}{"value": ""},
+		ScoringAlgorithm: "None",
+	}
+}
+
+// NewNumericEntry builds a numeric question. With a margin greater than zero,
+// answers within answer ± margin are accepted.
+func NewNumericEntry(title, body string, answer, margin float64) *NewQuizItemEntry {
+	value := map[string]interface{}{
+		"id":    uuid.NewString(),
+		"type":  "exactResponse",
+		"value": strconv.FormatFloat(answer, 'f', -1, 64),
+	}
+	if margin > 0 {
+		value["type"] = "marginOfError"
+		value["margin"] = strconv.FormatFloat(margin, 'f', -1, 64)
+		value["margin_type"] = "absolute"
+	}
+
+	return &NewQuizItemEntry{
+		Title:               title,
+		ItemBody:            body,
+		InteractionTypeSlug: NewQuizInteractionNumeric,
+		InteractionData:     map[string]interface{}{},
+		Properties:          map[string]interface{}{},
+		ScoringData:         map[string]interface{}{"value": []interface{}{value}},
+		ScoringAlgorithm:    "Numeric",
+	}
+}
+
+// SetCourseAccommodations applies accommodations to every New Quiz of a course
+func (s *QuizEngineService) SetCourseAccommodations(ctx context.Context, courseID int64, accommodations []NewQuizAccommodation) (*NewQuizAccommodationResult, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/accommodations", courseID)
+	return s.setAccommodations(ctx, path, accommodations)
+}
+
+// SetQuizAccommodations applies accommodations to a single New Quiz
+func (s *QuizEngineService) SetQuizAccommodations(ctx context.Context, courseID, assignmentID int64, accommodations []NewQuizAccommodation) (*NewQuizAccommodationResult, error) {
+	path := fmt.Sprintf("/api/quiz/v1/courses/%d/quizzes/%d/accommodations", courseID, assignmentID)
+	return s.setAccommodations(ctx, path, accommodations)
+}
+
+func (s *QuizEngineService) setAccommodations(ctx context.Context, path string, accommodations []NewQuizAccommodation) (*NewQuizAccommodationResult, error) {
+	var result NewQuizAccommodationResult
+	if err := s.client.PostJSON(ctx, path, accommodations, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQuizEngineService_CreateItem(t *testing.T) {
+	var body map[string]map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/quiz/v1/courses/123/quizzes/456/items" {
+			t.Errorf("Expected path /api/quiz/v1/courses/123/quizzes/456/items, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"id":"77","position":1,"points_possible":2,"entry_type":"Item","entry":{"title":"Sum","interaction_type_slug":"choice"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	entry, err := NewChoiceEntry("Sum", "<p>2+2?</p>", []string{"3", "4"}, 1)
+	if err != nil {
+		t.Fatalf("NewChoiceEntry failed: %v", err)
+	}
+
+	points := 2.0
+	service := NewQuizEngineService(client)
+	item, err := service.CreateItem(context.Background(), 123, 456, &NewQuizItemParams{
+		PointsPossible: &points,
+		Entry:          entry,
+	})
+	if err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+
+	if item.ID != "77" || item.Entry == nil || item.Entry.Title != "Sum" {
+		t.Errorf("Unexpected item: %+v", item)
+	}
+
+	sent := body["item"]
+	if sent["entry_type"] != "Item" {
+		t.Errorf("Expected entry_type Item, got %v", sent["entry_type"])
+	}
+	if sent["points_possible"] != 2.0 {
+		t.Errorf("Expected points_possible 2, got %v", sent["points_possible"])
+	}
+	sentEntry, ok := sent["entry"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected entry object, got %v", sent["entry"])
+	}
+	if sentEntry["interaction_type_slug"] != "choice" {
+		t.Errorf("Expected choice interaction, got %v", sentEntry["interaction_type_slug"])
+	}
+}
+
+func TestQuizEngineService_CreateItem_NoEntry(t *testing.T) {
+	service := NewQuizEngineService(nil)
+	if _, err := service.CreateItem(context.Background(), 123, 456, &NewQuizItemParams{}); err == nil {
+		t.Error("Expected error for item without an entry")
+	}
+}
+
+func TestNewChoiceEntry(t *testing.T) {
+	entry, err := NewChoiceEntry("Capital", "Capital of France?", []string{"Lyon", "Paris", "Nice"}, 1)
+	if err != nil {
+		t.Fatalf("NewChoiceEntry failed: %v", err)
+	}
+
+	choices := entry.InteractionData["choices"].([]map[string]interface{})
+	if len(choices) != 3 {
+		t.Fatalf("Expected 3 choices, got %d", len(choices))
+	}
+	if entry.ScoringData["value"] != choices[1]["id"] {
+		t.Errorf("Expected scoring value %v, got %v", choices[1]["id"], entry.ScoringData["value"])
+	}
+	if choices[1]["item_body"] != "<p>Paris</p>" {
+		t.Errorf("Expected Paris as second choice, got %v", choices[1]["item_body"])
+	}
+
+	// Choices are plain text, so markup characters are escaped
+	entry, err = NewChoiceEntry("Compare", "Which is true?", []string{"1 < 2 & 3 > 2", "<b>none</b>"}, 0)
+	if err != nil {
+		t.Fatalf("NewChoiceEntry failed: %v", err)
+	}
+	choices = entry.InteractionData["choices"].([]map[string]interface{})
+	if choices[0]["item_body"] != "<p>1 &lt; 2 &amp; 3 &gt; 2</p>" || choices[1]["item_body"] != "<p>&lt;b&gt;none&lt;/b&gt;</p>" {
+		t.Errorf("Expected escaped choices, got %v and %v", choices[0]["item_body"], choices[1]["item_body"])
+	}
+
+	if _, err := NewChoiceEntry("Capital", "Capital of France?", []string{"Lyon", "Paris"}, 2); err == nil {
+		t.Error("Expected error for out of range correct choice")
+	}
+	if _, err := NewChoiceEntry("Capital", "Capital of France?", []string{"Paris"}, 0); err == nil {
+		t.Error("Expected error for a single choice")
+	}
+}
+
+func TestNewNumericEntry(t *testing.T) {
+	exact := NewNumericEntry("Pi", "Value of pi?", 3.14, 0)
+	value := exact.ScoringData["value"].([]interface{})[0].(map[string]interface{})
+	if value["type"] != "exactResponse" || value["value"] != "3.14" {
+		t.Errorf("Unexpected exact scoring value: %v", value)
+	}
+
+	margin := NewNumericEntry("Pi", "Value of pi?", 3.14, 0.01)
+	value = margin.ScoringData["value"].([]interface{})[0].(map[string]interface{})
+	if value["type"] != "marginOfError" || value["margin"] != "0.01" || value["margin_type"] != "absolute" {
+		t.Errorf("Unexpected margin scoring value: %v", value)
+	}
+}
+
+func TestQuizEngineService_SetQuizAccommodations(t *testing.T) {
+	var body []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/quiz/v1/courses/123/quizzes/456/accommodations" {
+			t.Errorf("Expected path /api/quiz/v1/courses/123/quizzes/456/accommodations, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"message":"done","successful":[{"user_id":7}],"failed":[{"user_id":8,"error":"not enrolled"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	extraTime := 30
+	service := NewQuizEngineService(client)
+	result, err := service.SetQuizAccommodations(context.Background(), 123, 456, []NewQuizAccommodation{
+		{UserID: 7, ExtraTime: &extraTime},
+		{UserID: 8, ExtraTime: &extraTime},
+	})
+	if err != nil {
+		t.Fatalf("SetQuizAccommodations failed: %v", err)
+	}
+
+	if len(body) != 2 || body[0]["extra_time"] != 30.0 {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if _, ok := body[0]["extra_attempts"]; ok {
+		t.Error("Expected extra_attempts to be omitted")
+	}
+	if len(result.Successful) != 1 || len(result.Failed) != 1 || result.Failed[0].Error != "not enrolled" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
@@ -1,5 +1,7 @@
 package api
 
+import "iter"
+
 // NormalizeCourse ensures consistent data structure for a course
 func NormalizeCourse(course *Course) *Course {
 	if course == nil {
@@ -206,3 +208,17 @@ func NormalizeEnrollments(enrollments []Enrollment) []Enrollment {
 
 	return enrollments
 }
+
+// normalizeSeq applies normalize to each item in the sequence
+func normalizeSeq[T any](seq iter.Seq2[T, error], normalize func(*T) *T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for item, err := range seq {
+			if err == nil {
+				item = *normalize(&item)
+			}
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ObserveesService handles observer (parent) pairing API calls
+type ObserveesService struct {
+	client *Client
+}
+
+// NewObserveesService creates a new observees service
+func NewObserveesService(client *Client) *ObserveesService {
+	return &ObserveesService{client: client}
+}
+
+// PairingCode is a code a student generates so an observer can link to them
+type PairingCode struct {
+	UserID        int64      `json:"user_id"`
+	Code          string     `json:"code"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	WorkflowState string     `json:"workflow_state"`
+}
+
+// ListObservees retrieves the students a user observes
+func (s *ObserveesService) ListObservees(ctx context.Context, observerID int64) ([]User, error) {
+	path := fmt.Sprintf("/api/v1/users/%d/observees", observerID)
+
+	var users []User
+	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// ListObservers retrieves the users observing a student
+func (s *ObserveesService) ListObservers(ctx context.Context, studentID int64) ([]User, error) {
+	path := fmt.Sprintf("/api/v1/users/%d/observers", studentID)
+
+	var users []User
+	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// AddObservee links an observer to a student. Linking an existing pair is a
+// no-op.
+func (s *ObserveesService) AddObservee(ctx context.Context, observerID, studentID int64) (*User, error) {
+	return s.AddObserveeByRef(ctx, strconv.FormatInt(observerID, 10), strconv.FormatInt(studentID, 10))
+}
+
+// AddObserveeByRef links an observer to a student identified by Canvas ID or
+// by an SIS-style ID such as sis_user_id:S123
+func (s *ObserveesService) AddObserveeByRef(ctx context.Context, observer, student string) (*User, error) {
+	path := fmt.Sprintf("/api/v1/users/%s/observees/%s", url.PathEscape(observer), url.PathEscape(student))
+
+	var user User
+	if err := s.client.PutJSON(ctx, path, nil, &user); err != nil {
+		return nil, err
+	}
+
+	s.evictObservers(student, &user)
+	return &user, nil
+}
+
+// AddObserveeWithPairingCode links an observer to the student who generated
+// the pairing code
+func (s *ObserveesService) AddObserveeWithPairingCode(ctx context.Context, observerID int64, code string) (*User, error) {
+	path := fmt.Sprintf("/api/v1/users/%d/observees", observerID)
+
+	body := map[string]interface{}{
+		"pairing_code": code,
+	}
+
+	var user User
+	if err := s.client.PostJSON(ctx, path, body, &user); err != nil {
+		return nil, err
+	}
+
+	s.evictObservers("", &user)
+	return &user, nil
+}
+
+// RemoveObservee unlinks an observer from a student. With rootAccountID set,
+// only the link in that root account is removed.
+func (s *ObserveesService) RemoveObservee(ctx context.Context, observerID, studentID, rootAccountID int64) (*User, error) {
+	path := fmt.Sprintf("/api/v1/users/%d/observees/%d", observerID, studentID)
+	if rootAccountID > 0 {
+		path += "?" + url.Values{"root_account_id": {strconv.FormatInt(rootAccountID, 10)}}.Encode()
+	}
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	s.evictObservers(strconv.FormatInt(studentID, 10), &user)
+	return &user, nil
+}
+
+// CreatePairingCode generates a pairing code for a student
+func (s *ObserveesService) CreatePairingCode(ctx context.Context, studentID int64) (*PairingCode, error) {
+	path := fmt.Sprintf("/api/v1/users/%d/observer_pairing_codes", studentID)
+
+	var code PairingCode
+	if err := s.client.PostJSON(ctx, path, nil, &code); err != nil {
+		return nil, err
+	}
+
+	return &code, nil
+}
+
+// evictObservers evicts the cached observer list of a student after a link
+// changed. The request path only evicts the observer's side of the pairing.
+// The student is evicted both as referenced and by the Canvas ID returned.
+func (s *ObserveesService) evictObservers(student string, user *User) {
+	if student != "" {
+		s.client.evictPrefix(fmt.Sprintf("/api/v1/users/%s/observers", url.PathEscape(student)))
+	}
+	if user.ID > 0 {
+		s.client.evictPrefix(fmt.Sprintf("/api/v1/users/%d/observers", user.ID))
+	}
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jjuanrivvera/canvas-cli/internal/cache"
+)
+
+func TestObserveesService_AddObservee(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/users/123/observees/456" {
+			t.Errorf("Expected path /api/v1/users/123/observees/456, got %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`{"id":456,"name":"Student One"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewObserveesService(client)
+	student, err := service.AddObservee(context.Background(), 123, 456)
+	if err != nil {
+		t.Fatalf("AddObservee failed: %v", err)
+	}
+
+	if student.ID != 456 || student.Name != "Student One" {
+		t.Errorf("Unexpected student: %+v", student)
+	}
+}
+
+func TestObserveesService_AddObserveeByRef_EvictsBothSides(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/users/sis_user_id:P100/observees/sis_user_id:S200" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Write([]byte(`{"id":456,"name":"Student One"}`))
+	}))
+	defer server.Close()
+
+	responses := cache.New(5 * time.Minute)
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		Cache:          responses,
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	stale := []string{
+		"/api/v1/users/sis_user_id:P100/observees",
+		"/api/v1/users/sis_user_id:S200/observers",
+		"/api/v1/users/456/observers",
+	}
+	for _, path := range stale {
+		responses.Set(server.URL+path, []byte(`[]`))
+	}
+
+	service := NewObserveesService(client)
+	if _, err := service.AddObserveeByRef(context.Background(), "sis_user_id:P100", "sis_user_id:S200"); err != nil {
+		t.Fatalf("AddObserveeByRef failed: %v", err)
+	}
+
+	for _, path := range stale {
+		if responses.Has(server.URL + path) {
+			t.Errorf("Expected %s to be evicted", path)
+		}
+	}
+}
+
+func TestObserveesService_AddObserveeWithPairingCode(t *testing.T) {
+	var body map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/users/123/observees" {
+			t.Errorf("Expected path /api/v1/users/123/observees, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"id":456,"name":"Student One"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewObserveesService(client)
+	if _, err := service.AddObserveeWithPairingCode(context.Background(), 123, "Ab3xYz"); err != nil {
+		t.Fatalf("AddObserveeWithPairingCode failed: %v", err)
+	}
+
+	if body["pairing_code"] != "Ab3xYz" {
+		t.Errorf("Expected pairing_code Ab3xYz, got %v", body["pairing_code"])
+	}
+}
+
+func TestObserveesService_RemoveObservee(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/users/123/observees/456" {
+			t.Errorf("Expected path /api/v1/users/123/observees/456, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("root_account_id"); got != "1" {
+			t.Errorf("Expected root_account_id 1, got %q", got)
+		}
+
+		w.Write([]byte(`{"id":456,"name":"Student One"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewObserveesService(client)
+	student, err := service.RemoveObservee(context.Background(), 123, 456, 1)
+	if err != nil {
+		t.Fatalf("RemoveObservee failed: %v", err)
+	}
+
+	if student.ID != 456 {
+		t.Errorf("Expected student 456, got %d", student.ID)
+	}
+}
@@ -1,9 +1,19 @@
 package api
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"math"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 var linkRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
@@ -77,3 +87,276 @@ func GetPerPage(url string) string {
 	perPageStr := strings.Split(parts[1], "&")[0]
 	return perPageStr
 }
+
+// maxPagePrefetchWorkers caps the number of pages fetched ahead concurrently
+const maxPagePrefetchWorkers = 4
+
+// nextPagePath returns the path and query of the next page from the Link header,
+// or an empty string when there are no more pages
+func nextPagePath(links *PaginationLinks) (string, error) {
+	if !links.HasNextPage() {
+		return "", nil
+	}
+
+	// Extract path from full URL
+	nextURL, err := url.Parse(links.Next)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse next URL: %w", err)
+	}
+
+	return requestPath(nextURL), nil
+}
+
+// requestPath returns the path and query of a URL for use with Client.Get
+func requestPath(u *url.URL) string {
+	// Handle empty query string properly to avoid trailing '?'
+	if u.RawQuery != "" {
+		return u.Path + "?" + u.RawQuery
+	}
+	return u.Path
+}
+
+// prefetchPagePaths returns the paths of the next through last pages when the
+// Link header exposes numeric page numbers for both, so they can be fetched
+// concurrently. It returns nil when pages must be followed one at a time, such
+// as with Canvas's opaque bookmark cursors. When wanted is positive, only the
+// pages needed to produce that many more items (at pageSize per page) are
+// returned.
+func prefetchPagePaths(links *PaginationLinks, pageSize, wanted int) []string {
+	if links.Next == "" || links.Last == "" {
+		return nil
+	}
+
+	nextURL, err := url.Parse(links.Next)
+	if err != nil {
+		return nil
+	}
+	lastURL, err := url.Parse(links.Last)
+	if err != nil {
+		return nil
+	}
+
+	first, err := strconv.Atoi(nextURL.Query().Get("page"))
+	if err != nil || first < 1 {
+		return nil
+	}
+	last, err := strconv.Atoi(lastURL.Query().Get("page"))
+	if err != nil {
+		return nil
+	}
+
+	if wanted > 0 && pageSize > 0 {
+		if needed := (wanted + pageSize - 1) / pageSize; first+needed-1 < last {
+			last = first + needed - 1
+		}
+	}
+
+	// A single remaining page gains nothing from prefetching
+	if last <= first {
+		return nil
+	}
+
+	paths := make([]string, 0, last-first+1)
+	for page := first; page <= last; page++ {
+		pageURL := *nextURL
+		query := nextURL.Query()
+		query.Set("page", strconv.Itoa(page))
+		pageURL.RawQuery = query.Encode()
+		paths = append(paths, requestPath(&pageURL))
+	}
+
+	return paths
+}
+
+// Pages returns an iterator over the items of a paginated endpoint.
+// Pages are fetched lazily as the Link header is followed, and items within a
+// page are decoded one at a time, so callers can process results before the
+// whole collection has been downloaded. When Canvas reports a numeric last
+// page, the remaining pages are prefetched concurrently and still yielded in
+// order. Iteration stops once the client's max results limit is reached.
+// An error is yielded once and ends iteration.
+func Pages[T any](c *Client, ctx context.Context, path string) iter.Seq2[T, error] {
+	return pagesFrom[T](c, ctx, path, nil)
+}
+
+// pagesFrom iterates over the pages of path like Pages. If first is not nil,
+// it is used as the response for the first page instead of requesting it.
+func pagesFrom[T any](c *Client, ctx context.Context, path string, first *http.Response) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		count := 0
+		emit := func(item T) bool {
+			if !yield(item, nil) {
+				return false
+			}
+			count++
+			return c.maxResults == 0 || count < c.maxResults
+		}
+
+		currentURL := path
+
+		for currentURL != "" {
+			resp := first
+			first = nil
+			if resp == nil {
+				var err error
+				if resp, err = c.Get(ctx, currentURL); err != nil {
+					yield(zero, err)
+					return
+				}
+			}
+
+			links := ParsePaginationLinks(resp)
+			before := count
+
+			stop, err := decodePage(resp.Body, emit)
+			resp.Body.Close()
+
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if stop {
+				return
+			}
+
+			wanted := 0
+			if c.maxResults > 0 {
+				wanted = c.maxResults - count
+			}
+			if paths := prefetchPagePaths(links, count-before, wanted); len(paths) > 0 {
+				for body, err := range c.fetchPages(ctx, paths) {
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+					stop, err := decodePage(bytes.NewReader(body), emit)
+					if err != nil {
+						yield(zero, err)
+						return
+					}
+					if stop {
+						return
+					}
+				}
+				return
+			}
+
+			currentURL, err = nextPagePath(links)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
+
+// fetchPages downloads the given pages concurrently and yields their bodies in
+// order. Every request still goes through the client's AdaptiveRateLimiter, and
+// the number of pages in flight or awaiting the consumer is capped by the
+// current rate so slowing down for quota also reduces parallelism.
+func (c *Client) fetchPages(ctx context.Context, paths []string) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		type pageResult struct {
+			body []byte
+			err  error
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer func() {
+			cancel()
+			wg.Wait()
+		}()
+
+		workers := int(math.Ceil(c.rateLimiter.GetCurrentRate()))
+		workers = max(1, min(workers, maxPagePrefetchWorkers))
+
+		// Slots are released when the consumer takes a page, bounding both
+		// in-flight requests and buffered pages
+		slots := make(chan struct{}, workers)
+		results := make([]chan pageResult, len(paths))
+		for i := range results {
+			results[i] = make(chan pageResult, 1)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i, path := range paths {
+				select {
+				case slots <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					body, err := c.fetchPageBody(ctx, path)
+					results[i] <- pageResult{body: body, err: err}
+				}()
+			}
+		}()
+
+		for i := range paths {
+			var result pageResult
+			select {
+			case result = <-results[i]:
+			case <-ctx.Done():
+				yield(nil, ctx.Err())
+				return
+			}
+			<-slots
+
+			if !yield(result.body, result.err) || result.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// fetchPageBody fetches a single page and reads its body
+func (c *Client) fetchPageBody(ctx context.Context, path string) ([]byte, error) {
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return body, nil
+}
+
+// decodePage streams the elements of a JSON array to fn.
+// Returns stop=true if fn asked to stop early.
+func decodePage[T any](r io.Reader, fn func(T) bool) (stop bool, err error) {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if token == nil {
+		return false, nil // null body is an empty page
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return false, fmt.Errorf("failed to decode response: expected JSON array")
+	}
+
+	for decoder.More() {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return false, fmt.Errorf("failed to decode response: %w", err)
+		}
+		if !fn(item) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
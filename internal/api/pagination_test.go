@@ -1,8 +1,14 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestParsePaginationLinks(t *testing.T) {
@@ -222,3 +228,295 @@ func TestGetPerPage(t *testing.T) {
 		})
 	}
 }
+
+func TestPages(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v1/items?page=2>; rel="next"`, server.URL))
+			w.Write([]byte(`[{"id":1},{"id":2}]`))
+		case "2":
+			w.Write([]byte(`[{"id":3}]`))
+		default:
+			t.Errorf("unexpected page request: %s", r.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	type item struct {
+		ID int64 `json:"id"`
+	}
+
+	t.Run("follows next links", func(t *testing.T) {
+		client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		var ids []int64
+		for it, err := range Pages[item](client, context.Background(), "/api/v1/items") {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids = append(ids, it.ID)
+		}
+
+		if len(ids) != 3 || ids[0] != 1 || ids[2] != 3 {
+			t.Errorf("expected ids [1 2 3], got %v", ids)
+		}
+	})
+
+	t.Run("respects max results", func(t *testing.T) {
+		client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100, MaxResults: 2})
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		count := 0
+		for _, err := range Pages[item](client, context.Background(), "/api/v1/items") {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			count++
+		}
+
+		if count != 2 {
+			t.Errorf("expected 2 items, got %d", count)
+		}
+	})
+
+	t.Run("stops when consumer breaks", func(t *testing.T) {
+		client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		count := 0
+		for range Pages[item](client, context.Background(), "/api/v1/items") {
+			count++
+			break
+		}
+
+		if count != 1 {
+			t.Errorf("expected 1 item, got %d", count)
+		}
+	})
+}
+
+func TestPages_DecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+		w.Write([]byte(`{"not":"an array"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	var gotErr error
+	for _, err := range Pages[map[string]any](client, context.Background(), "/api/v1/items") {
+		gotErr = err
+	}
+
+	if gotErr == nil {
+		t.Error("expected decode error for non-array response")
+	}
+}
+
+func TestPages_PrefetchWithLastLink(t *testing.T) {
+	const lastPage = 5
+
+	var mu sync.Mutex
+	requested := map[string]int{}
+	inFlight, maxInFlight := 0, 0
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		mu.Lock()
+		requested[strconv.Itoa(page)]++
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		// Later pages respond faster to prove results are reassembled in order
+		time.Sleep(time.Duration(lastPage-page) * 5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		links := fmt.Sprintf(`<%s/api/v1/items?page=%d&per_page=2>; rel="last"`, server.URL, lastPage)
+		if page < lastPage {
+			links += fmt.Sprintf(`, <%s/api/v1/items?page=%d&per_page=2>; rel="next"`, server.URL, page+1)
+		}
+		w.Header().Set("Link", links)
+		fmt.Fprintf(w, `[{"id":%d},{"id":%d}]`, page*2-1, page*2)
+	}))
+	defer server.Close()
+
+	type item struct {
+		ID int64 `json:"id"`
+	}
+
+	t.Run("fetches remaining pages and keeps order", func(t *testing.T) {
+		mu.Lock()
+		requested, maxInFlight = map[string]int{}, 0
+		mu.Unlock()
+
+		client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		items, err := GetAllPagesGeneric[item](client, context.Background(), "/api/v1/items?per_page=2")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(items) != lastPage*2 {
+			t.Fatalf("expected %d items, got %d", lastPage*2, len(items))
+		}
+		for i, it := range items {
+			if it.ID != int64(i+1) {
+				t.Fatalf("items out of order at %d: got id %d", i, it.ID)
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for page := 1; page <= lastPage; page++ {
+			if requested[strconv.Itoa(page)] != 1 {
+				t.Errorf("expected page %d to be requested once, got %d", page, requested[strconv.Itoa(page)])
+			}
+		}
+		if maxInFlight < 2 {
+			t.Errorf("expected concurrent page requests, max in flight was %d", maxInFlight)
+		}
+		if maxInFlight > maxPagePrefetchWorkers {
+			t.Errorf("expected at most %d concurrent requests, got %d", maxPagePrefetchWorkers, maxInFlight)
+		}
+	})
+
+	t.Run("only prefetches pages needed for max results", func(t *testing.T) {
+		mu.Lock()
+		requested = map[string]int{}
+		mu.Unlock()
+
+		client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100, MaxResults: 5})
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		items, err := GetAllPagesGeneric[item](client, context.Background(), "/api/v1/items?per_page=2")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(items) != 5 {
+			t.Fatalf("expected 5 items, got %d", len(items))
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if requested["4"] != 0 || requested["5"] != 0 {
+			t.Errorf("expected pages 4 and 5 not to be fetched, got %v", requested)
+		}
+	})
+}
+
+func TestPrefetchPagePaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		links    PaginationLinks
+		pageSize int
+		wanted   int
+		expected []string
+	}{
+		{
+			name: "numeric next and last",
+			links: PaginationLinks{
+				Next: "https://canvas.example.com/api/v1/users?page=2&per_page=10",
+				Last: "https://canvas.example.com/api/v1/users?page=4&per_page=10",
+			},
+			pageSize: 10,
+			expected: []string{
+				"/api/v1/users?page=2&per_page=10",
+				"/api/v1/users?page=3&per_page=10",
+				"/api/v1/users?page=4&per_page=10",
+			},
+		},
+		{
+			name: "limited by wanted results",
+			links: PaginationLinks{
+				Next: "https://canvas.example.com/api/v1/users?page=2&per_page=10",
+				Last: "https://canvas.example.com/api/v1/users?page=9&per_page=10",
+			},
+			pageSize: 10,
+			wanted:   15,
+			expected: []string{
+				"/api/v1/users?page=2&per_page=10",
+				"/api/v1/users?page=3&per_page=10",
+			},
+		},
+		{
+			name: "bookmark cursors",
+			links: PaginationLinks{
+				Next: "https://canvas.example.com/api/v1/users?page=bookmark:abc",
+				Last: "https://canvas.example.com/api/v1/users?page=bookmark:xyz",
+			},
+			pageSize: 10,
+		},
+		{
+			name: "no last link",
+			links: PaginationLinks{
+				Next: "https://canvas.example.com/api/v1/users?page=2",
+			},
+			pageSize: 10,
+		},
+		{
+			name: "next is last",
+			links: PaginationLinks{
+				Next: "https://canvas.example.com/api/v1/users?page=2",
+				Last: "https://canvas.example.com/api/v1/users?page=2",
+			},
+			pageSize: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := prefetchPagePaths(&tt.links, tt.pageSize, tt.wanted)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("path %d: expected %s, got %s", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
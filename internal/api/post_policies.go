@@ -0,0 +1,306 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PostPoliciesService handles grade posting through the Canvas GraphQL API.
+// Post policies and posting or hiding grades are not available over REST.
+type PostPoliciesService struct {
+	client  *Client
+	graphql *GraphQLService
+}
+
+// NewPostPoliciesService creates a new post policies service
+func NewPostPoliciesService(client *Client) *PostPoliciesService {
+	return &PostPoliciesService{client: client, graphql: NewGraphQLService(client)}
+}
+
+// PostPolicy controls whether grades are posted to students automatically as
+// they are entered or only when posted manually
+type PostPolicy struct {
+	CourseID     int64 `json:"course_id"`
+	AssignmentID int64 `json:"assignment_id,omitempty"`
+	PostManually bool  `json:"post_manually"`
+	// Inherited is true when an assignment has no policy of its own and
+	// follows the course policy
+	Inherited bool `json:"inherited,omitempty"`
+}
+
+// PostGradesOptions holds options for posting or hiding assignment grades
+type PostGradesOptions struct {
+	SectionIDs []int64 // Limit to these sections (default: all students)
+	GradedOnly bool    // Post only graded submissions (posting only)
+}
+
+// graphQLPostPolicy is the postPolicy selection used by the queries below
+type graphQLPostPolicy struct {
+	PostManually bool `json:"postManually"`
+}
+
+// graphQLMutationError is a validation error returned in a mutation payload
+type graphQLMutationError struct {
+	Attribute string `json:"attribute"`
+	Message   string `json:"message"`
+}
+
+// GetCoursePolicy retrieves the post policy of a course
+func (s *PostPoliciesService) GetCoursePolicy(ctx context.Context, courseID int64) (*PostPolicy, error) {
+	var data struct {
+		Course *struct {
+			PostPolicy *graphQLPostPolicy `json:"postPolicy"`
+		} `json:"course"`
+	}
+	err := s.query(ctx, `query CoursePostPolicy($courseId: ID!) {
+  course(id: $courseId) { postPolicy { postManually } }
+}`, map[string]interface{}{"courseId": strconv.FormatInt(courseID, 10)}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Course == nil {
+		return nil, fmt.Errorf("course %d not found", courseID)
+	}
+
+	policy := &PostPolicy{CourseID: courseID}
+	if data.Course.PostPolicy != nil {
+		policy.PostManually = data.Course.PostPolicy.PostManually
+	}
+
+	return policy, nil
+}
+
+// GetAssignmentPolicy retrieves the post policy of an assignment, falling
+// back to the course policy when the assignment has none of its own
+func (s *PostPoliciesService) GetAssignmentPolicy(ctx context.Context, assignmentID int64) (*PostPolicy, error) {
+	var data struct {
+		Assignment *struct {
+			PostPolicy *graphQLPostPolicy `json:"postPolicy"`
+			Course     struct {
+				ID         string             `json:"_id"`
+				PostPolicy *graphQLPostPolicy `json:"postPolicy"`
+			} `json:"course"`
+		} `json:"assignment"`
+	}
+	err := s.query(ctx, `query AssignmentPostPolicy($assignmentId: ID!) {
+  assignment(id: $assignmentId) {
+    postPolicy { postManually }
+    course { _id postPolicy { postManually } }
+  }
+}`, map[string]interface{}{"assignmentId": strconv.FormatInt(assignmentID, 10)}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Assignment == nil {
+		return nil, fmt.Errorf("assignment %d not found", assignmentID)
+	}
+
+	courseID, _ := strconv.ParseInt(data.Assignment.Course.ID, 10, 64)
+	policy := &PostPolicy{CourseID: courseID, AssignmentID: assignmentID}
+
+	switch {
+	case data.Assignment.PostPolicy != nil:
+		policy.PostManually = data.Assignment.PostPolicy.PostManually
+	case data.Assignment.Course.PostPolicy != nil:
+		policy.PostManually = data.Assignment.Course.PostPolicy.PostManually
+		policy.Inherited = true
+	default:
+		policy.Inherited = true
+	}
+
+	return policy, nil
+}
+
+// SetCoursePolicy sets the post policy of a course and every assignment that
+// does not override it
+func (s *PostPoliciesService) SetCoursePolicy(ctx context.Context, courseID int64, postManually bool) (*PostPolicy, error) {
+	var data struct {
+		SetCoursePostPolicy struct {
+			Errors []graphQLMutationError `json:"errors"`
+		} `json:"setCoursePostPolicy"`
+	}
+	err := s.query(ctx, `mutation SetCoursePostPolicy($courseId: ID!, $postManually: Boolean!) {
+  setCoursePostPolicy(input: {courseId: $courseId, postManually: $postManually}) {
+    postPolicy { postManually }
+    errors { attribute message }
+  }
+}`, map[string]interface{}{
+		"courseId":     strconv.FormatInt(courseID, 10),
+		"postManually": postManually,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mutationError(data.SetCoursePostPolicy.Errors); err != nil {
+		return nil, err
+	}
+
+	// Assignments report the policy they inherit as post_manually
+	s.client.evictPrefix(fmt.Sprintf("/api/v1/courses/%d/assignments", courseID))
+
+	return &PostPolicy{CourseID: courseID, PostManually: postManually}, nil
+}
+
+// SetAssignmentPolicy sets the post policy of a single assignment
+func (s *PostPoliciesService) SetAssignmentPolicy(ctx context.Context, assignmentID int64, postManually bool) (*PostPolicy, error) {
+	var data struct {
+		SetAssignmentPostPolicy struct {
+			Errors []graphQLMutationError `json:"errors"`
+		} `json:"setAssignmentPostPolicy"`
+	}
+	err := s.query(ctx, `mutation SetAssignmentPostPolicy($assignmentId: ID!, $postManually: Boolean!) {
+  setAssignmentPostPolicy(input: {assignmentId: $assignmentId, postManually: $postManually}) {
+    postPolicy { postManually }
+    errors { attribute message }
+  }
+}`, map[string]interface{}{
+		"assignmentId": strconv.FormatInt(assignmentID, 10),
+		"postManually": postManually,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mutationError(data.SetAssignmentPostPolicy.Errors); err != nil {
+		return nil, err
+	}
+
+	return &PostPolicy{AssignmentID: assignmentID, PostManually: postManually}, nil
+}
+
+// PostGrades makes the grades of an assignment visible to students. Canvas
+// posts them in the background; the returned progress can be polled with
+// ProgressService.
+func (s *PostPoliciesService) PostGrades(ctx context.Context, courseID, assignmentID int64, opts *PostGradesOptions) (*JobProgress, error) {
+	if opts == nil {
+		opts = &PostGradesOptions{}
+	}
+
+	input := map[string]interface{}{
+		"assignmentId": strconv.FormatInt(assignmentID, 10),
+	}
+	if opts.GradedOnly {
+		input["gradedOnly"] = true
+	}
+
+	mutation := "postAssignmentGrades"
+	if len(opts.SectionIDs) > 0 {
+		mutation = "postAssignmentGradesForSections"
+		input["sectionIds"] = formatGraphQLIDs(opts.SectionIDs)
+	}
+
+	return s.runGradesMutation(ctx, courseID, mutation, input)
+}
+
+// HideGrades hides the grades of an assignment from students. Canvas hides
+// them in the background; the returned progress can be polled with
+// ProgressService.
+func (s *PostPoliciesService) HideGrades(ctx context.Context, courseID, assignmentID int64, opts *PostGradesOptions) (*JobProgress, error) {
+	if opts == nil {
+		opts = &PostGradesOptions{}
+	}
+
+	input := map[string]interface{}{
+		"assignmentId": strconv.FormatInt(assignmentID, 10),
+	}
+
+	mutation := "hideAssignmentGrades"
+	if len(opts.SectionIDs) > 0 {
+		mutation = "hideAssignmentGradesForSections"
+		input["sectionIds"] = formatGraphQLIDs(opts.SectionIDs)
+	}
+
+	return s.runGradesMutation(ctx, courseID, mutation, input)
+}
+
+// runGradesMutation runs a post or hide mutation and returns its progress.
+// Cached assignment and submission reads of the course are evicted since
+// GraphQL requests do not evict the REST resources they change.
+func (s *PostPoliciesService) runGradesMutation(ctx context.Context, courseID int64, mutation string, input map[string]interface{}) (*JobProgress, error) {
+	// Input types are named after the mutation, e.g. PostAssignmentGradesInput
+	inputType := strings.ToUpper(mutation[:1]) + mutation[1:] + "Input"
+
+	query := fmt.Sprintf(`mutation Run($input: %s!) {
+  %s(input: $input) {
+    progress { _id state }
+    errors { attribute message }
+  }
+}`, inputType, mutation)
+
+	var data map[string]struct {
+		Progress *struct {
+			ID    string `json:"_id"`
+			State string `json:"state"`
+		} `json:"progress"`
+		Errors []graphQLMutationError `json:"errors"`
+	}
+	if err := s.query(ctx, query, map[string]interface{}{"input": input}, &data); err != nil {
+		return nil, err
+	}
+
+	payload := data[mutation]
+	if err := mutationError(payload.Errors); err != nil {
+		return nil, err
+	}
+
+	s.client.evictPrefix(fmt.Sprintf("/api/v1/courses/%d/assignments", courseID))
+	s.client.evictPrefix(fmt.Sprintf("/api/v1/courses/%d/students/submissions", courseID))
+
+	if payload.Progress == nil {
+		return nil, fmt.Errorf("%s returned no progress", mutation)
+	}
+
+	progressID, err := strconv.ParseInt(payload.Progress.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid progress ID %q", payload.Progress.ID)
+	}
+
+	return &JobProgress{ID: progressID, WorkflowState: payload.Progress.State}, nil
+}
+
+// query runs a GraphQL request and decodes its data into result
+func (s *PostPoliciesService) query(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	data, err := s.graphql.Query(ctx, &GraphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("failed to decode GraphQL response: %w", err)
+	}
+
+	return nil
+}
+
+// mutationError converts the validation errors of a mutation payload to an
+// APIError, or returns nil when there are none
+func mutationError(errs []graphQLMutationError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	converted := make([]GraphQLError, len(errs))
+	for i, e := range errs {
+		converted[i] = GraphQLError{Message: e.Message}
+		if e.Attribute != "" {
+			converted[i].Message = fmt.Sprintf("%s: %s", e.Attribute, e.Message)
+		}
+	}
+
+	return newGraphQLAPIError(converted)
+}
+
+// formatGraphQLIDs converts numeric IDs to the strings GraphQL ID arguments expect
+func formatGraphQLIDs(ids []int64) []string {
+	formatted := make([]string, len(ids))
+	for i, id := range ids {
+		formatted[i] = strconv.FormatInt(id, 10)
+	}
+	return formatted
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jjuanrivvera/canvas-cli/internal/cache"
+)
+
+func TestPostPoliciesService_PostGrades(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "postAssignmentGradesForSections(input: $input)") ||
+			!strings.Contains(req.Query, "PostAssignmentGradesForSectionsInput!") {
+			t.Errorf("Expected section mutation, got %s", req.Query)
+		}
+
+		input := req.Variables["input"].(map[string]interface{})
+		if input["assignmentId"] != "456" || input["gradedOnly"] != true {
+			t.Errorf("Unexpected input: %v", input)
+		}
+		sections := input["sectionIds"].([]interface{})
+		if len(sections) != 2 || sections[0] != "11" {
+			t.Errorf("Expected section IDs as strings, got %v", sections)
+		}
+
+		w.Write([]byte(`{"data":{"postAssignmentGradesForSections":{"progress":{"_id":"77","state":"queued"},"errors":null}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewPostPoliciesService(client)
+	progress, err := service.PostGrades(context.Background(), 123, 456, &PostGradesOptions{
+		SectionIDs: []int64{11, 12},
+		GradedOnly: true,
+	})
+	if err != nil {
+		t.Fatalf("PostGrades failed: %v", err)
+	}
+
+	if progress.ID != 77 || progress.WorkflowState != "queued" {
+		t.Errorf("Unexpected progress: %+v", progress)
+	}
+}
+
+func TestPostPoliciesService_PostGrades_EvictsCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		w.Write([]byte(`{"data":{"postAssignmentGrades":{"progress":{"_id":"77","state":"queued"},"errors":null}}}`))
+	}))
+	defer server.Close()
+
+	responses := cache.New(5 * time.Minute)
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+		Cache:          responses,
+		CacheEnabled:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	stale := []string{
+		"/api/v1/courses/123/assignments/456",
+		"/api/v1/courses/123/assignments/456/submissions?include[]=user",
+		"/api/v1/courses/123/students/submissions?student_ids[]=all",
+	}
+	for _, path := range append(stale, "/api/v1/courses/124/assignments/456/submissions") {
+		responses.Set(server.URL+path, []byte(`[]`))
+	}
+
+	service := NewPostPoliciesService(client)
+	if _, err := service.PostGrades(context.Background(), 123, 456, nil); err != nil {
+		t.Fatalf("PostGrades failed: %v", err)
+	}
+
+	for _, path := range stale {
+		if responses.Has(server.URL + path) {
+			t.Errorf("Expected %s to be evicted", path)
+		}
+	}
+	if !responses.Has(server.URL + "/api/v1/courses/124/assignments/456/submissions") {
+		t.Error("Expected other courses to stay cached")
+	}
+}
+
+func TestPostPoliciesService_HideGrades_MutationErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		w.Write([]byte(`{"data":{"hideAssignmentGrades":{"progress":null,"errors":[{"attribute":"assignmentId","message":"not found"}]}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewPostPoliciesService(client)
+	_, err = service.HideGrades(context.Background(), 123, 456, nil)
+	if err == nil {
+		t.Fatal("Expected error from mutation payload")
+	}
+	if !strings.Contains(err.Error(), "assignmentId: not found") {
+		t.Errorf("Expected attribute in error, got %v", err)
+	}
+}
+
+func TestPostPoliciesService_GetAssignmentPolicy_Inherited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		w.Write([]byte(`{"data":{"assignment":{"postPolicy":null,"course":{"_id":"123","postPolicy":{"postManually":true}}}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Token: "test-token", RequestsPerSec: 100})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewPostPoliciesService(client)
+	policy, err := service.GetAssignmentPolicy(context.Background(), 456)
+	if err != nil {
+		t.Fatalf("GetAssignmentPolicy failed: %v", err)
+	}
+
+	if !policy.Inherited || !policy.PostManually || policy.CourseID != 123 || policy.AssignmentID != 456 {
+		t.Errorf("Expected manual policy inherited from course 123, got %+v", policy)
+	}
+}
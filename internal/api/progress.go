@@ -0,0 +1,199 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+// Progress workflow states reported by Canvas for asynchronous jobs
+const (
+	ProgressStateQueued    = "queued"
+	ProgressStateRunning   = "running"
+	ProgressStateCompleted = "completed"
+	ProgressStateFailed    = "failed"
+)
+
+const (
+	defaultProgressPollInterval    = 1 * time.Second
+	defaultProgressMaxPollInterval = 10 * time.Second
+	progressBackoffFactor          = 1.5
+)
+
+// ProgressService handles Canvas Progress API calls for asynchronous jobs
+type ProgressService struct {
+	client *Client
+}
+
+// NewProgressService creates a new progress service
+func NewProgressService(client *Client) *ProgressService {
+	return &ProgressService{client: client}
+}
+
+// JobProgress represents a Canvas Progress object tracking an asynchronous job.
+// It is named JobProgress to avoid clashing with the course Progress type.
+type JobProgress struct {
+	ID            int64   `json:"id"`
+	ContextID     int64   `json:"context_id,omitempty"`
+	ContextType   string  `json:"context_type,omitempty"`
+	UserID        int64   `json:"user_id,omitempty"`
+	Tag           string  `json:"tag,omitempty"`
+	Completion    float64 `json:"completion"`
+	WorkflowState string  `json:"workflow_state"`
+	CreatedAt     string  `json:"created_at,omitempty"`
+	UpdatedAt     string  `json:"updated_at,omitempty"`
+	Message       string  `json:"message,omitempty"`
+	URL           string  `json:"url,omitempty"`
+}
+
+// IsCompleted returns true if the job finished successfully
+func (p *JobProgress) IsCompleted() bool {
+	return p.WorkflowState == ProgressStateCompleted
+}
+
+// IsFailed returns true if the job finished with an error
+func (p *JobProgress) IsFailed() bool {
+	return p.WorkflowState == ProgressStateFailed
+}
+
+// IsDone returns true if the job has reached a terminal state
+func (p *JobProgress) IsDone() bool {
+	return p.IsCompleted() || p.IsFailed()
+}
+
+// ProgressError is returned when an asynchronous job finishes in the failed state
+type ProgressError struct {
+	Progress *JobProgress
+}
+
+func (e *ProgressError) Error() string {
+	if e.Progress.Message != "" {
+		return fmt.Sprintf("job %d failed: %s", e.Progress.ID, e.Progress.Message)
+	}
+	return fmt.Sprintf("job %d failed", e.Progress.ID)
+}
+
+// WaitOptions configures how asynchronous jobs are polled
+type WaitOptions struct {
+	Timeout         time.Duration // Maximum time to wait (0 = no limit beyond ctx)
+	InitialInterval time.Duration // First poll delay (default 1s)
+	MaxInterval     time.Duration // Upper bound for backoff (default 10s)
+	OnUpdate        func(*JobProgress)
+}
+
+// ProgressFetcher returns the latest state of an asynchronous job
+type ProgressFetcher func(ctx context.Context) (*JobProgress, error)
+
+// Get retrieves a progress object by ID.
+// Progress is always fetched from the API, bypassing the response cache.
+func (s *ProgressService) Get(ctx context.Context, progressID int64) (*JobProgress, error) {
+	path := fmt.Sprintf("/api/v1/progress/%d", progressID)
+
+	resp, err := s.client.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var progress JobProgress
+	if err := json.NewDecoder(resp.Body).Decode(&progress); err != nil {
+		return nil, fmt.Errorf("failed to decode progress: %w", err)
+	}
+
+	return &progress, nil
+}
+
+// GetByURL retrieves a progress object from a progress_url returned by Canvas
+func (s *ProgressService) GetByURL(ctx context.Context, progressURL string) (*JobProgress, error) {
+	progressID, err := ProgressIDFromURL(progressURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, progressID)
+}
+
+// Wait polls a progress object until it completes, fails, or the timeout expires
+func (s *ProgressService) Wait(ctx context.Context, progressID int64, opts *WaitOptions) (*JobProgress, error) {
+	return PollProgress(ctx, opts, func(ctx context.Context) (*JobProgress, error) {
+		return s.Get(ctx, progressID)
+	})
+}
+
+// PollProgress repeatedly calls fetch with exponential backoff until the job
+// reaches a terminal state. A failed job is reported as a *ProgressError.
+func PollProgress(ctx context.Context, opts *WaitOptions, fetch ProgressFetcher) (*JobProgress, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	interval := opts.InitialInterval
+	if interval <= 0 {
+		interval = defaultProgressPollInterval
+	}
+
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultProgressMaxPollInterval
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	for {
+		progress, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("timed out waiting for job: %w", ctx.Err())
+			}
+			return nil, err
+		}
+
+		if opts.OnUpdate != nil {
+			opts.OnUpdate(progress)
+		}
+
+		if progress.IsFailed() {
+			return progress, &ProgressError{Progress: progress}
+		}
+
+		if progress.IsCompleted() {
+			return progress, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return progress, fmt.Errorf("timed out waiting for job %d (%s, %.0f%%): %w",
+				progress.ID, progress.WorkflowState, progress.Completion, ctx.Err())
+		case <-time.After(interval):
+		}
+
+		interval = time.Duration(float64(interval) * progressBackoffFactor)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// ProgressIDFromURL extracts the progress ID from a Canvas progress URL
+// such as https://canvas.example.com/api/v1/progress/42
+func ProgressIDFromURL(progressURL string) (int64, error) {
+	parsed, err := url.Parse(progressURL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid progress URL: %w", err)
+	}
+
+	id, err := strconv.ParseInt(path.Base(parsed.Path), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid progress URL %q: missing progress ID", progressURL)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestProgressService_Wait(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/progress/42" {
+			t.Errorf("expected /api/v1/progress/42, got %s", r.URL.Path)
+		}
+
+		calls++
+		progress := JobProgress{ID: 42, WorkflowState: ProgressStateRunning, Completion: 50}
+		if calls >= 3 {
+			progress.WorkflowState = ProgressStateCompleted
+			progress.Completion = 100
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(progress)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	var updates int
+	service := NewProgressService(client)
+	progress, err := service.Wait(context.Background(), 42, &WaitOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     5 * time.Millisecond,
+		OnUpdate:        func(*JobProgress) { updates++ },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !progress.IsCompleted() {
+		t.Errorf("expected completed, got %s", progress.WorkflowState)
+	}
+
+	if updates != 3 {
+		t.Errorf("expected 3 updates, got %d", updates)
+	}
+}
+
+func TestPollProgress_Failed(t *testing.T) {
+	_, err := PollProgress(context.Background(), nil, func(ctx context.Context) (*JobProgress, error) {
+		return &JobProgress{ID: 7, WorkflowState: ProgressStateFailed, Message: "boom"}, nil
+	})
+
+	var progressErr *ProgressError
+	if !errors.As(err, &progressErr) {
+		t.Fatalf("expected ProgressError, got %v", err)
+	}
+
+	if progressErr.Error() != "job 7 failed: boom" {
+		t.Errorf("unexpected error message: %s", progressErr.Error())
+	}
+}
+
+func TestPollProgress_Timeout(t *testing.T) {
+	_, err := PollProgress(context.Background(), &WaitOptions{
+		Timeout:         20 * time.Millisecond,
+		InitialInterval: 5 * time.Millisecond,
+	}, func(ctx context.Context) (*JobProgress, error) {
+		return &JobProgress{ID: 1, WorkflowState: ProgressStateQueued}, nil
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestProgressIDFromURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		want    int64
+		wantErr bool
+	}{
+		{"https://canvas.example.com/api/v1/progress/42", 42, false},
+		{"/api/v1/progress/7", 7, false},
+		{"https://canvas.example.com/api/v1/progress/", 0, true},
+		{"not a url", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ProgressIDFromURL(tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ProgressIDFromURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ProgressIDFromURL(%q) = %d, want %d", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestSISImport_ToProgress(t *testing.T) {
+	tests := []struct {
+		state string
+		want  string
+	}{
+		{"created", ProgressStateQueued},
+		{"importing", ProgressStateRunning},
+		{"imported_with_messages", ProgressStateCompleted},
+		{"failed_with_messages", ProgressStateFailed},
+	}
+
+	for _, tt := range tests {
+		progress := (&SISImport{ID: 1, WorkflowState: tt.state}).ToProgress()
+		if progress.WorkflowState != tt.want {
+			t.Errorf("state %s: expected %s, got %s", tt.state, tt.want, progress.WorkflowState)
+		}
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"math/rand/v2"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -14,14 +18,43 @@ const (
 	maxRetries     = 3
 	initialBackoff = 1 * time.Second
 	maxBackoff     = 8 * time.Second
+	maxRetryAfter  = 60 * time.Second
 )
 
+// DefaultRetryableMethods are the idempotent methods that are safe to retry
+// after a network error or server error
+var DefaultRetryableMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// DefaultRetryableStatuses are the response statuses that trigger a retry
+var DefaultRetryableStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
 // RetryPolicy defines retry behavior for HTTP requests
 type RetryPolicy struct {
 	MaxRetries     int
 	InitialBackoff time.Duration
 	MaxBackoff     time.Duration
 	Logger         *slog.Logger
+
+	// RetryableMethods are retried on network errors and any retryable status.
+	// Other methods (e.g. POST) are only retried on 429, where Canvas rejected
+	// the request without processing it. Nil means DefaultRetryableMethods.
+	RetryableMethods []string
+	// RetryableStatuses trigger a retry. Nil means DefaultRetryableStatuses.
+	RetryableStatuses []int
+	// MaxRetryAfter caps how long a Retry-After header can delay a retry
+	MaxRetryAfter time.Duration
 }
 
 // DefaultRetryPolicy returns the default retry policy
@@ -31,7 +64,47 @@ func DefaultRetryPolicy() *RetryPolicy {
 		InitialBackoff: initialBackoff,
 		MaxBackoff:     maxBackoff,
 		Logger:         slog.Default(),
+		MaxRetryAfter:  maxRetryAfter,
+	}
+}
+
+// newRetryPolicy returns the default retry policy with optional overrides
+// for the retryable methods and statuses
+func newRetryPolicy(methods []string, statuses []int) *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	if len(methods) > 0 {
+		policy.RetryableMethods = methods
+	}
+	if len(statuses) > 0 {
+		policy.RetryableStatuses = statuses
 	}
+	return policy
+}
+
+// IsRetryableMethod reports whether requests with this method may be retried
+// after failures where the server may already have processed them
+func (p *RetryPolicy) IsRetryableMethod(method string) bool {
+	methods := p.RetryableMethods
+	if methods == nil {
+		methods = DefaultRetryableMethods
+	}
+
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status should be retried
+func (p *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	statuses := p.RetryableStatuses
+	if statuses == nil {
+		statuses = DefaultRetryableStatuses
+	}
+
+	return slices.Contains(statuses, statusCode)
 }
 
 // ShouldRetry determines if a request should be retried based on the response
@@ -42,22 +115,11 @@ func (p *RetryPolicy) ShouldRetry(resp *http.Response, err error) bool {
 			return false
 		}
 
-		// Check if this is an API error - don't retry client errors (4xx)
+		// Check if this is an API error - only retry rate limiting and server errors,
+		// not other client errors (400, 401, 403, 404, 422, etc.)
 		var apiErr *APIError
 		if errors.As(err, &apiErr) {
-			// Only retry rate limiting and server errors
-			switch apiErr.StatusCode {
-			case http.StatusTooManyRequests:
-				return true
-			case http.StatusInternalServerError,
-				http.StatusBadGateway,
-				http.StatusServiceUnavailable,
-				http.StatusGatewayTimeout:
-				return true
-			default:
-				// Don't retry other API errors (400, 401, 403, 404, 422, etc.)
-				return false
-			}
+			return p.isRetryableStatus(apiErr.StatusCode)
 		}
 
 		// Retry on network errors (non-API errors like connection refused, DNS failure, etc.)
@@ -65,17 +127,34 @@ func (p *RetryPolicy) ShouldRetry(resp *http.Response, err error) bool {
 	}
 
 	// Retry on these status codes (when err is nil but response indicates retryable error)
-	switch resp.StatusCode {
-	case http.StatusTooManyRequests:
-		return true
-	case http.StatusInternalServerError,
-		http.StatusBadGateway,
-		http.StatusServiceUnavailable,
-		http.StatusGatewayTimeout:
+	return p.isRetryableStatus(resp.StatusCode)
+}
+
+// ShouldRetryRequest is like ShouldRetry but also applies idempotency rules:
+// a non-idempotent request is only retried when Canvas rate limited it,
+// since after a network or server error it may already have taken effect.
+func (p *RetryPolicy) ShouldRetryRequest(method string, resp *http.Response, err error) bool {
+	if !p.ShouldRetry(resp, err) {
+		return false
+	}
+
+	if p.IsRetryableMethod(method) {
 		return true
 	}
 
-	return false
+	return responseStatus(resp, err) == http.StatusTooManyRequests
+}
+
+// responseStatus returns the HTTP status of a response or API error, or 0
+func responseStatus(resp *http.Response, err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	if err == nil && resp != nil {
+		return resp.StatusCode
+	}
+	return 0
 }
 
 // GetBackoff calculates the backoff duration for a given attempt
@@ -88,8 +167,70 @@ func (p *RetryPolicy) GetBackoff(attempt int) time.Duration {
 	return backoff
 }
 
-// ExecuteWithRetry executes a function with retry logic
+// retryDelay returns how long to wait before the next attempt. A Retry-After
+// header from the server takes precedence over exponential backoff. Jitter is
+// added so parallel clients do not retry in lockstep.
+func (p *RetryPolicy) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if retryAfter, ok := parseRetryAfter(resp); ok {
+		if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+			retryAfter = p.MaxRetryAfter
+		}
+		// Never retry before the server asked, only up to 10% later
+		return retryAfter + randomDuration(retryAfter/10)
+	}
+
+	// Equal jitter: half the backoff plus a random share of the other half
+	backoff := p.GetBackoff(attempt)
+	return backoff/2 + randomDuration(backoff/2)
+}
+
+// randomDuration returns a random duration in [0, d)
+func randomDuration(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d)
+}
+
+// parseRetryAfter reads the Retry-After header as seconds or an HTTP date
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+
+	return 0, false
+}
+
+// ExecuteWithRetry executes a function with retry logic.
+// The request is treated as idempotent; use ExecuteRequestWithRetry to apply
+// the policy's method rules.
 func (p *RetryPolicy) ExecuteWithRetry(ctx context.Context, fn func() (*http.Response, error)) (*http.Response, error) {
+	return p.execute(ctx, p.ShouldRetry, fn)
+}
+
+// ExecuteRequestWithRetry executes a function with retry logic, only retrying
+// non-idempotent methods when it is safe to do so
+func (p *RetryPolicy) ExecuteRequestWithRetry(ctx context.Context, method string, fn func() (*http.Response, error)) (*http.Response, error) {
+	return p.execute(ctx, func(resp *http.Response, err error) bool {
+		return p.ShouldRetryRequest(method, resp, err)
+	}, fn)
+}
+
+// execute runs fn until shouldRetry declines or retries are exhausted
+func (p *RetryPolicy) execute(ctx context.Context, shouldRetry func(*http.Response, error) bool, fn func() (*http.Response, error)) (*http.Response, error) {
 	var resp *http.Response
 	var err error
 
@@ -97,7 +238,7 @@ func (p *RetryPolicy) ExecuteWithRetry(ctx context.Context, fn func() (*http.Res
 		resp, err = fn()
 
 		// Check if we should retry
-		if !p.ShouldRetry(resp, err) {
+		if !shouldRetry(resp, err) {
 			return resp, err
 		}
 
@@ -106,7 +247,12 @@ func (p *RetryPolicy) ExecuteWithRetry(ctx context.Context, fn func() (*http.Res
 			break
 		}
 
-		backoff := p.GetBackoff(attempt)
+		// Release the connection of a response we are about to discard
+		if err == nil && resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		backoff := p.retryDelay(attempt, resp)
 		p.Logger.Warn("Request failed, retrying",
 			"attempt", attempt+1,
 			"max_retries", p.MaxRetries,
@@ -327,3 +327,104 @@ func TestRetryPolicy_ExecuteWithRetry_NoRetryOnNonRetryableStatus(t *testing.T)
 		t.Errorf("expected 1 call, got %d", callCount)
 	}
 }
+
+func TestRetryPolicy_ShouldRetryRequest(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{"GET retries server error", http.MethodGet, &APIError{StatusCode: 503}, true},
+		{"PUT retries network error", http.MethodPut, errors.New("connection reset"), true},
+		{"POST does not retry server error", http.MethodPost, &APIError{StatusCode: 502}, false},
+		{"POST does not retry network error", http.MethodPost, errors.New("connection reset"), false},
+		{"POST retries rate limit", http.MethodPost, &APIError{StatusCode: 429}, true},
+		{"GET does not retry client error", http.MethodGet, &APIError{StatusCode: 404}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.ShouldRetryRequest(tt.method, nil, tt.err); got != tt.want {
+				t.Errorf("ShouldRetryRequest(%s) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_ConfiguredRules(t *testing.T) {
+	policy := newRetryPolicy([]string{"post"}, []int{409})
+
+	if !policy.ShouldRetryRequest(http.MethodPost, nil, &APIError{StatusCode: 409}) {
+		t.Error("expected POST to retry configured status 409")
+	}
+
+	if policy.ShouldRetryRequest(http.MethodGet, nil, &APIError{StatusCode: 503}) {
+		t.Error("expected 503 not to be retried when statuses are overridden")
+	}
+
+	if policy.ShouldRetryRequest(http.MethodGet, nil, errors.New("connection reset")) {
+		t.Error("expected GET not to retry network errors when methods are overridden")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"seconds", "5", 5 * time.Second, true},
+		{"past date", "Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+		{"missing", "", 0, false},
+		{"invalid", "soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			got, ok := parseRetryAfter(resp)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.header, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_RetryDelay(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		MaxRetryAfter:  10 * time.Second,
+	}
+
+	// Jittered backoff stays between half and the full exponential backoff
+	for i := 0; i < 20; i++ {
+		delay := policy.retryDelay(1, nil)
+		if delay < 100*time.Millisecond || delay >= 200*time.Millisecond {
+			t.Fatalf("retryDelay(1) = %v, want [100ms, 200ms)", delay)
+		}
+	}
+
+	// Retry-After is honored, with at most 10% added
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	delay := policy.retryDelay(0, resp)
+	if delay < 3*time.Second || delay >= 3300*time.Millisecond {
+		t.Errorf("retryDelay with Retry-After: 3 = %v, want [3s, 3.3s)", delay)
+	}
+
+	// Retry-After is capped by MaxRetryAfter
+	resp.Header.Set("Retry-After", "3600")
+	delay = policy.retryDelay(0, resp)
+	if delay < 10*time.Second || delay >= 11*time.Second {
+		t.Errorf("retryDelay with Retry-After: 3600 = %v, want [10s, 11s)", delay)
+	}
+}
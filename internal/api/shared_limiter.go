@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// sharedLockRetryInterval is how often a blocked process retries the lock file
+	sharedLockRetryInterval = 10 * time.Millisecond
+	// sharedLockStaleAfter is the age after which a lock file is considered
+	// abandoned by a crashed process and removed
+	sharedLockStaleAfter = 5 * time.Second
+	// sharedRemainingTTL is how long a reported X-Rate-Limit-Remaining value is trusted
+	sharedRemainingTTL = 1 * time.Minute
+)
+
+// SharedRateLimiter coordinates request pacing across processes talking to the
+// same Canvas instance. A token bucket and the last X-Rate-Limit-Remaining
+// value seen by any process are kept in a state file, guarded by a lock file,
+// so parallel canvas-cli runs (cron jobs, CI) share one budget instead of each
+// assuming the full quota.
+type SharedRateLimiter struct {
+	statePath string
+	lockPath  string
+	rate      float64 // Configured requests per second at full quota
+}
+
+// sharedLimiterState is the on-disk state shared between processes
+type sharedLimiterState struct {
+	Tokens      float64   `json:"tokens"`
+	LastRefill  time.Time `json:"last_refill"`
+	Remaining   float64   `json:"remaining,omitempty"`
+	Total       float64   `json:"total,omitempty"`
+	RemainingAt time.Time `json:"remaining_at,omitempty"`
+}
+
+// NewSharedRateLimiter creates a limiter whose state lives in dir, keyed by the
+// Canvas base URL so different instances do not share a budget
+func NewSharedRateLimiter(dir, baseURL string, requestsPerSecond float64) (*SharedRateLimiter, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create rate limit directory: %w", err)
+	}
+
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = defaultRequestsPerSecond
+	}
+
+	name := sharedLimiterName(baseURL)
+	return &SharedRateLimiter{
+		statePath: filepath.Join(dir, name+".json"),
+		lockPath:  filepath.Join(dir, name+".lock"),
+		rate:      requestsPerSecond,
+	}, nil
+}
+
+// sharedLimiterName turns a base URL into a readable file name
+func sharedLimiterName(baseURL string) string {
+	name := baseURL
+	if parsed, err := url.Parse(baseURL); err == nil && parsed.Host != "" {
+		name = parsed.Host + strings.TrimSuffix(parsed.Path, "/")
+	}
+	return strings.NewReplacer(":", "_", "/", "_", "\\", "_").Replace(name)
+}
+
+// Wait blocks until a token is available in the shared bucket
+func (l *SharedRateLimiter) Wait(ctx context.Context) error {
+	for {
+		var delay time.Duration
+
+		err := l.withState(ctx, func(state *sharedLimiterState) {
+			now := time.Now()
+			rate := l.currentRate(state, now)
+
+			// Refill the bucket (burst of 1, like AdaptiveRateLimiter)
+			if !state.LastRefill.IsZero() {
+				state.Tokens += now.Sub(state.LastRefill).Seconds() * rate
+			} else {
+				state.Tokens = 1
+			}
+			state.Tokens = min(state.Tokens, 1)
+			state.LastRefill = now
+
+			if state.Tokens >= 1 {
+				state.Tokens--
+				return
+			}
+
+			delay = time.Duration((1 - state.Tokens) / rate * float64(time.Second))
+		})
+		if err != nil {
+			return err
+		}
+
+		if delay == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Observe records the X-Rate-Limit-Remaining value from a response so every
+// process slows down when the shared quota runs low
+func (l *SharedRateLimiter) Observe(ctx context.Context, remaining, total float64) error {
+	return l.withState(ctx, func(state *sharedLimiterState) {
+		state.Remaining = remaining
+		state.Total = total
+		state.RemainingAt = time.Now()
+	})
+}
+
+// currentRate applies the same quota thresholds as AdaptiveRateLimiter to the
+// most recent remaining value reported by any process
+func (l *SharedRateLimiter) currentRate(state *sharedLimiterState, now time.Time) float64 {
+	if state.Total <= 0 || now.Sub(state.RemainingAt) > sharedRemainingTTL {
+		return l.rate
+	}
+
+	percentage := state.Remaining / state.Total
+	switch {
+	case percentage <= quotaCriticalThreshold:
+		return min(l.rate, verySlowRequestsPerSecond)
+	case percentage <= quotaWarningThreshold:
+		return min(l.rate, slowRequestsPerSecond)
+	default:
+		return l.rate
+	}
+}
+
+// withState runs fn with the shared state loaded under the lock and writes it back
+func (l *SharedRateLimiter) withState(ctx context.Context, fn func(*sharedLimiterState)) error {
+	unlock, err := l.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var state sharedLimiterState
+	if data, err := os.ReadFile(l.statePath); err == nil {
+		// A corrupt state file is simply reset
+		_ = json.Unmarshal(data, &state)
+	}
+
+	fn(&state)
+
+	data, err := json.Marshal(&state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rate limit state: %w", err)
+	}
+
+	if err := os.WriteFile(l.statePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write rate limit state: %w", err)
+	}
+
+	return nil
+}
+
+// lock acquires the lock file, breaking it first if a crashed process left it behind.
+// The lock is only held once this process's exclusive create succeeds.
+func (l *SharedRateLimiter) lock(ctx context.Context) (func(), error) {
+	for {
+		f, err := os.OpenFile(l.lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(l.lockPath) }, nil
+		}
+
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to acquire rate limit lock: %w", err)
+		}
+
+		if isStaleLock(l.lockPath) && l.breakStaleLock() {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(sharedLockRetryInterval):
+		}
+	}
+}
+
+// breakStaleLock removes a stale lock file and reports whether it tried.
+// Processes that find the same stale lock would otherwise race: one removes
+// it and acquires a fresh lock, which the other then removes as well. Breaking
+// is serialized through a second lock file, and the lock is checked again
+// under it, so a lock re-acquired after being broken is left alone.
+func (l *SharedRateLimiter) breakStaleLock() bool {
+	breakPath := l.lockPath + ".break"
+
+	f, err := os.OpenFile(breakPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		// Breaking takes microseconds, so an old break file was left by a
+		// process that crashed while breaking. Another process may remove
+		// it and create its own at any time, so only the file that was
+		// found stale is removed.
+		if info, err := os.Stat(breakPath); err == nil && time.Since(info.ModTime()) > sharedLockStaleAfter {
+			removeIfUnchanged(breakPath, info)
+		}
+		return false
+	}
+	f.Close()
+	defer os.Remove(breakPath)
+
+	if isStaleLock(l.lockPath) {
+		os.Remove(l.lockPath)
+	}
+	return true
+}
+
+// isStaleLock reports whether the lock file at path is old enough to have
+// been abandoned by a crashed process
+func isStaleLock(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && time.Since(info.ModTime()) > sharedLockStaleAfter
+}
+
+// removeIfUnchanged removes path if it is still the file described by info,
+// with the same identity and modification time. A file that was replaced in
+// the meantime belongs to another process and is left alone.
+func removeIfUnchanged(path string, info os.FileInfo) {
+	current, err := os.Stat(path)
+	if err != nil || !os.SameFile(info, current) || !current.ModTime().Equal(info.ModTime()) {
+		return
+	}
+	os.Remove(path)
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSharedRateLimiter_SharesBudgetAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+
+	// Two limiters for the same host behave like two processes
+	first, err := NewSharedRateLimiter(dir, "https://canvas.example.com", 20)
+	if err != nil {
+		t.Fatalf("failed to create limiter: %v", err)
+	}
+	second, err := NewSharedRateLimiter(dir, "https://canvas.example.com", 20)
+	if err != nil {
+		t.Fatalf("failed to create limiter: %v", err)
+	}
+
+	ctx := context.Background()
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for _, limiter := range []*SharedRateLimiter{first, second} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 3; i++ {
+				if err := limiter.Wait(ctx); err != nil {
+					t.Errorf("Wait failed: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 6 requests at 20 req/sec with a burst of 1 need at least 5 intervals of 50ms
+	if elapsed := time.Since(start); elapsed < 240*time.Millisecond {
+		t.Errorf("expected shared pacing of at least 250ms, took %v", elapsed)
+	}
+}
+
+func TestSharedRateLimiter_SeparateHosts(t *testing.T) {
+	dir := t.TempDir()
+
+	first, _ := NewSharedRateLimiter(dir, "https://one.example.com", 5)
+	second, _ := NewSharedRateLimiter(dir, "https://two.example.com", 5)
+
+	if first.statePath == second.statePath {
+		t.Errorf("expected separate state files per host, both use %s", first.statePath)
+	}
+}
+
+func TestSharedRateLimiter_ObserveSlowsDown(t *testing.T) {
+	dir := t.TempDir()
+
+	limiter, err := NewSharedRateLimiter(dir, "https://canvas.example.com", 10)
+	if err != nil {
+		t.Fatalf("failed to create limiter: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := limiter.Observe(ctx, 100, 700); err != nil {
+		t.Fatalf("Observe failed: %v", err)
+	}
+
+	var rate float64
+	if err := limiter.withState(ctx, func(state *sharedLimiterState) {
+		rate = limiter.currentRate(state, time.Now())
+	}); err != nil {
+		t.Fatalf("withState failed: %v", err)
+	}
+
+	if rate != verySlowRequestsPerSecond {
+		t.Errorf("expected rate %v at critical quota, got %v", verySlowRequestsPerSecond, rate)
+	}
+}
+
+func TestSharedRateLimiter_RemovesStaleLock(t *testing.T) {
+	dir := t.TempDir()
+
+	limiter, err := NewSharedRateLimiter(dir, "https://canvas.example.com", 10)
+	if err != nil {
+		t.Fatalf("failed to create limiter: %v", err)
+	}
+
+	// Simulate a lock left behind by a crashed process
+	if err := os.WriteFile(limiter.lockPath, nil, 0600); err != nil {
+		t.Fatalf("failed to create lock: %v", err)
+	}
+	old := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(limiter.lockPath, old, old); err != nil {
+		t.Fatalf("failed to age lock: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("expected stale lock to be recovered, got %v", err)
+	}
+}
+
+func TestSharedRateLimiter_BreaksStaleLockOnce(t *testing.T) {
+	dir := t.TempDir()
+
+	limiter, err := NewSharedRateLimiter(dir, "https://canvas.example.com", 10)
+	if err != nil {
+		t.Fatalf("failed to create limiter: %v", err)
+	}
+
+	old := time.Now().Add(-time.Minute)
+	if err := os.WriteFile(limiter.lockPath, nil, 0600); err != nil {
+		t.Fatalf("failed to create lock: %v", err)
+	}
+	os.Chtimes(limiter.lockPath, old, old)
+
+	if !limiter.breakStaleLock() {
+		t.Fatal("expected the stale lock to be broken")
+	}
+
+	// Another process acquires a fresh lock; a late break from a process that
+	// saw the stale one must re-check the lock and leave it alone
+	if err := os.WriteFile(limiter.lockPath, nil, 0600); err != nil {
+		t.Fatalf("failed to create lock: %v", err)
+	}
+	limiter.breakStaleLock()
+	if _, err := os.Stat(limiter.lockPath); err != nil {
+		t.Errorf("expected fresh lock to survive a late break, got %v", err)
+	}
+
+	// A break file left by a crashed breaker is cleared
+	breakPath := limiter.lockPath + ".break"
+	os.WriteFile(breakPath, nil, 0600)
+	os.Chtimes(breakPath, old, old)
+	if limiter.breakStaleLock() {
+		t.Error("expected breaking to wait for the stale break file to be cleared")
+	}
+	if _, err := os.Stat(breakPath); !os.IsNotExist(err) {
+		t.Errorf("expected stale break file to be removed, got %v", err)
+	}
+}
+
+func TestRemoveIfUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "limiter.lock.break")
+
+	old := time.Now().Add(-time.Minute)
+	os.WriteFile(path, nil, 0600)
+	os.Chtimes(path, old, old)
+	stale, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("failed to stat break file: %v", err)
+	}
+
+	// Another process clears the stale file and takes a fresh one
+	os.Remove(path)
+	os.WriteFile(path, nil, 0600)
+
+	removeIfUnchanged(path, stale)
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("expected the replaced break file to survive, got %v", err)
+	}
+
+	fresh, _ := os.Stat(path)
+	removeIfUnchanged(path, fresh)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the unchanged break file to be removed, got %v", err)
+	}
+}
@@ -367,3 +367,28 @@ func (s *SISImportsService) ListErrors(ctx context.Context, accountID, importID
 
 	return errors, nil
 }
+
+// ToProgress converts the import status into a JobProgress so it can be
+// polled with PollProgress like other asynchronous jobs
+func (i *SISImport) ToProgress() *JobProgress {
+	state := ProgressStateRunning
+	switch i.WorkflowState {
+	case "imported", "imported_with_messages", "restored", "partially_restored":
+		state = ProgressStateCompleted
+	case "aborted", "failed", "failed_with_messages":
+		state = ProgressStateFailed
+	case "initializing", "created":
+		state = ProgressStateQueued
+	}
+
+	return &JobProgress{
+		ID:            i.ID,
+		ContextType:   "SisBatch",
+		Tag:           "sis_import",
+		Completion:    i.Progress,
+		WorkflowState: state,
+		Message:       i.WorkflowState,
+		CreatedAt:     i.CreatedAt,
+		UpdatedAt:     i.UpdatedAt,
+	}
+}
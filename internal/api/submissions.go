@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -57,6 +58,23 @@ func (s *SubmissionsService) Get(ctx context.Context, courseID, assignmentID, us
 
 // List retrieves all submissions for an assignment
 func (s *SubmissionsService) List(ctx context.Context, courseID, assignmentID int64, opts *ListSubmissionsOptions) ([]Submission, error) {
+	path := assignmentSubmissionsPath(courseID, assignmentID, opts)
+
+	var submissions []Submission
+	if err := s.client.GetAllPages(ctx, path, &submissions); err != nil {
+		return nil, err
+	}
+
+	return NormalizeSubmissions(submissions), nil
+}
+
+// ListIter streams submissions for an assignment page by page
+func (s *SubmissionsService) ListIter(ctx context.Context, courseID, assignmentID int64, opts *ListSubmissionsOptions) iter.Seq2[Submission, error] {
+	return normalizeSeq(Pages[Submission](s.client, ctx, assignmentSubmissionsPath(courseID, assignmentID, opts)), NormalizeSubmission)
+}
+
+// assignmentSubmissionsPath builds the assignment submissions list path with query parameters
+func assignmentSubmissionsPath(courseID, assignmentID int64, opts *ListSubmissionsOptions) string {
 	path := fmt.Sprintf("/api/v1/courses/%d/assignments/%d/submissions", courseID, assignmentID)
 
 	if opts != nil {
@@ -119,12 +137,7 @@ func (s *SubmissionsService) List(ctx context.Context, courseID, assignmentID in
 		}
 	}
 
-	var submissions []Submission
-	if err := s.client.GetAllPages(ctx, path, &submissions); err != nil {
-		return nil, err
-	}
-
-	return NormalizeSubmissions(submissions), nil
+	return path
 }
 
 // ListMultiple retrieves submissions for multiple assignments and users
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// TabsService handles course navigation tab API calls
+type TabsService struct {
+	client *Client
+}
+
+// NewTabsService creates a new tabs service
+func NewTabsService(client *Client) *TabsService {
+	return &TabsService{client: client}
+}
+
+// Tab represents a course navigation tab
+type Tab struct {
+	ID         string `json:"id"` // e.g. home, modules, context_external_tool_42
+	HTMLURL    string `json:"html_url,omitempty"`
+	FullURL    string `json:"full_url,omitempty"`
+	Position   int    `json:"position"`
+	Hidden     bool   `json:"hidden,omitempty"`
+	Visibility string `json:"visibility,omitempty"` // public, members, admins, none
+	Label      string `json:"label"`
+	Type       string `json:"type"` // internal, external
+}
+
+// Fixed reports whether Canvas allows moving or hiding the tab. The home and
+// settings tabs always stay in place.
+func (t *Tab) Fixed() bool {
+	return t.ID == "home" || t.ID == "settings"
+}
+
+// UpdateTabParams holds parameters for updating a tab. Nil fields are left
+// unchanged.
+type UpdateTabParams struct {
+	Position *int  // 1-based; the home tab is always first
+	Hidden   *bool // Hide the tab from students
+}
+
+// ListCourse lists the navigation tabs of a course, including external tool
+// tabs, in navigation order
+func (s *TabsService) ListCourse(ctx context.Context, courseID int64) ([]Tab, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/tabs?include[]=external", courseID)
+
+	var tabs []Tab
+	if err := s.client.GetAllPages(ctx, path, &tabs); err != nil {
+		return nil, err
+	}
+
+	return tabs, nil
+}
+
+// UpdateCourse moves or hides a course navigation tab. Moving a tab shifts
+// the tabs at and after its new position down by one.
+func (s *TabsService) UpdateCourse(ctx context.Context, courseID int64, tabID string, params *UpdateTabParams) (*Tab, error) {
+	path := fmt.Sprintf("/api/v1/courses/%d/tabs/%s", courseID, url.PathEscape(tabID))
+
+	body := make(map[string]interface{})
+	if params.Position != nil {
+		body["position"] = *params.Position
+	}
+	if params.Hidden != nil {
+		body["hidden"] = *params.Hidden
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("no tab changes given")
+	}
+
+	var tab Tab
+	if err := s.client.PutJSON(ctx, path, body, &tab); err != nil {
+		return nil, err
+	}
+
+	return &tab, nil
+}
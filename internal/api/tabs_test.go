@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTabsService_ListCourse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/courses/123/tabs" {
+			t.Errorf("Expected path /api/v1/courses/123/tabs, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("include[]") != "external" {
+			t.Errorf("Expected include[]=external, got %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`[
+			{"id":"home","label":"Home","type":"internal","position":1},
+			{"id":"people","label":"People","type":"internal","position":2,"hidden":true,"visibility":"none"},
+			{"id":"settings","label":"Settings","type":"internal","position":3}
+		]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewTabsService(client)
+	tabs, err := service.ListCourse(context.Background(), 123)
+	if err != nil {
+		t.Fatalf("ListCourse failed: %v", err)
+	}
+
+	if len(tabs) != 3 {
+		t.Fatalf("Expected 3 tabs, got %d", len(tabs))
+	}
+	if !tabs[1].Hidden || tabs[1].Position != 2 {
+		t.Errorf("Unexpected people tab: %+v", tabs[1])
+	}
+	if !tabs[0].Fixed() || tabs[1].Fixed() || !tabs[2].Fixed() {
+		t.Errorf("Expected only home and settings to be fixed")
+	}
+}
+
+func TestTabsService_UpdateCourse(t *testing.T) {
+	var body map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/courses/123/tabs/context_external_tool_42" {
+			t.Errorf("Expected path /api/v1/courses/123/tabs/context_external_tool_42, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"id":"context_external_tool_42","label":"Zoom","type":"external","position":3,"hidden":true}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewTabsService(client)
+	position, hidden := 3, true
+	tab, err := service.UpdateCourse(context.Background(), 123, "context_external_tool_42", &UpdateTabParams{
+		Position: &position,
+		Hidden:   &hidden,
+	})
+	if err != nil {
+		t.Fatalf("UpdateCourse failed: %v", err)
+	}
+
+	if body["position"] != float64(3) || body["hidden"] != true {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if tab.Label != "Zoom" || !tab.Hidden {
+		t.Errorf("Unexpected tab: %+v", tab)
+	}
+
+	if _, err := service.UpdateCourse(context.Background(), 123, "people", &UpdateTabParams{}); err == nil {
+		t.Error("Expected error for an empty update")
+	}
+}
@@ -0,0 +1,304 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// TermEnrollmentTypes are the enrollment types that can have term date overrides
+var TermEnrollmentTypes = []string{"StudentEnrollment", "TeacherEnrollment", "TaEnrollment", "DesignerEnrollment"}
+
+// TermsService handles enrollment term API calls
+type TermsService struct {
+	client *Client
+}
+
+// NewTermsService creates a new terms service
+func NewTermsService(client *Client) *TermsService {
+	return &TermsService{client: client}
+}
+
+// ListTermsOptions holds options for listing enrollment terms
+type ListTermsOptions struct {
+	WorkflowState []string // active, deleted, all
+	Include       []string // overrides, course_count
+	TermName      string   // Partial name match
+	PerPage       int
+}
+
+// termsResponse is the wrapper Canvas returns around a page of terms
+type termsResponse struct {
+	EnrollmentTerms []Term `json:"enrollment_terms"`
+}
+
+// List retrieves the enrollment terms of a root account, following pagination
+func (s *TermsService) List(ctx context.Context, accountID int64, opts *ListTermsOptions) ([]Term, error) {
+	return s.list(ctx, accountID, opts, s.client.GetMaxResults())
+}
+
+// list retrieves terms, stopping after maxResults when it is positive
+func (s *TermsService) list(ctx context.Context, accountID int64, opts *ListTermsOptions, maxResults int) ([]Term, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/terms", accountID)
+
+	if opts != nil {
+		query := url.Values{}
+
+		for _, state := range opts.WorkflowState {
+			query.Add("workflow_state[]", state)
+		}
+
+		for _, inc := range opts.Include {
+			query.Add("include[]", inc)
+		}
+
+		if opts.TermName != "" {
+			query.Add("term_name", opts.TermName)
+		}
+
+		if opts.PerPage > 0 {
+			query.Add("per_page", strconv.Itoa(opts.PerPage))
+		}
+
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	// Terms are wrapped in an object, so follow the Link header here rather
+	// than through GetAllPages
+	terms := []Term{}
+	for path != "" {
+		resp, err := s.client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var page termsResponse
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode terms: %w", err)
+		}
+
+		for i := range page.EnrollmentTerms {
+			terms = append(terms, *NormalizeTerm(&page.EnrollmentTerms[i]))
+		}
+
+		if maxResults > 0 && len(terms) >= maxResults {
+			return terms[:maxResults], nil
+		}
+
+		path, err = nextPagePath(ParsePaginationLinks(resp))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return terms, nil
+}
+
+// Get retrieves a single enrollment term. termID may be a Canvas ID or an
+// SIS reference such as "sis_term_id:2026FA".
+func (s *TermsService) Get(ctx context.Context, accountID int64, termID string) (*Term, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/terms/%s", accountID, url.PathEscape(termID))
+
+	var term Term
+	if err := s.client.GetJSON(ctx, path, &term); err != nil {
+		return nil, err
+	}
+
+	return NormalizeTerm(&term), nil
+}
+
+// TermOverrideParams holds date overrides for one enrollment type
+type TermOverrideParams struct {
+	StartAt string
+	EndAt   string
+}
+
+// CreateTermParams holds parameters for creating an enrollment term
+type CreateTermParams struct {
+	Name      string
+	StartAt   string
+	EndAt     string
+	SISTermID string
+	Overrides map[string]TermOverrideParams // Keyed by enrollment type, e.g. StudentEnrollment
+}
+
+// Create creates a new enrollment term
+func (s *TermsService) Create(ctx context.Context, accountID int64, params *CreateTermParams) (*Term, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/terms", accountID)
+
+	termData := make(map[string]interface{})
+
+	if params.Name != "" {
+		termData["name"] = params.Name
+	}
+
+	if params.StartAt != "" {
+		termData["start_at"] = params.StartAt
+	}
+
+	if params.EndAt != "" {
+		termData["end_at"] = params.EndAt
+	}
+
+	if params.SISTermID != "" {
+		termData["sis_term_id"] = params.SISTermID
+	}
+
+	if len(params.Overrides) > 0 {
+		termData["overrides"] = termOverridesBody(params.Overrides)
+	}
+
+	body := map[string]interface{}{
+		"enrollment_term": termData,
+	}
+
+	var term Term
+	if err := s.client.PostJSON(ctx, path, body, &term); err != nil {
+		return nil, err
+	}
+
+	return NormalizeTerm(&term), nil
+}
+
+// UpdateTermParams holds parameters for updating an enrollment term
+type UpdateTermParams struct {
+	Name      *string
+	StartAt   *string
+	EndAt     *string
+	SISTermID *string
+	Overrides map[string]TermOverrideParams // Keyed by enrollment type, e.g. StudentEnrollment
+}
+
+// Update updates an existing enrollment term
+func (s *TermsService) Update(ctx context.Context, accountID, termID int64, params *UpdateTermParams) (*Term, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/terms/%d", accountID, termID)
+
+	termData := make(map[string]interface{})
+
+	if params.Name != nil {
+		termData["name"] = *params.Name
+	}
+
+	if params.StartAt != nil {
+		termData["start_at"] = *params.StartAt
+	}
+
+	if params.EndAt != nil {
+		termData["end_at"] = *params.EndAt
+	}
+
+	if params.SISTermID != nil {
+		termData["sis_term_id"] = *params.SISTermID
+	}
+
+	if len(params.Overrides) > 0 {
+		termData["overrides"] = termOverridesBody(params.Overrides)
+	}
+
+	body := map[string]interface{}{
+		"enrollment_term": termData,
+	}
+
+	var term Term
+	if err := s.client.PutJSON(ctx, path, body, &term); err != nil {
+		return nil, err
+	}
+
+	return NormalizeTerm(&term), nil
+}
+
+// Delete deletes an enrollment term
+func (s *TermsService) Delete(ctx context.Context, accountID, termID int64) (*Term, error) {
+	path := fmt.Sprintf("/api/v1/accounts/%d/terms/%d", accountID, termID)
+
+	resp, err := s.client.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var term Term
+	if err := json.NewDecoder(resp.Body).Decode(&term); err != nil {
+		return nil, err
+	}
+
+	return NormalizeTerm(&term), nil
+}
+
+// Resolve finds a term by Canvas ID, SIS term ID or name (case-insensitive).
+// A selector that matches several terms by name is an error so a bulk
+// operation never silently picks the wrong term.
+func (s *TermsService) Resolve(ctx context.Context, accountID int64, selector string) (*Term, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return nil, fmt.Errorf("term selector is empty")
+	}
+
+	// Explicit SIS reference
+	if strings.HasPrefix(selector, "sis_term_id:") {
+		return s.Get(ctx, accountID, selector)
+	}
+
+	// Numeric selectors are tried as Canvas IDs first
+	if _, err := strconv.ParseInt(selector, 10, 64); err == nil {
+		term, err := s.Get(ctx, accountID, selector)
+		if err == nil {
+			return term, nil
+		}
+		if !IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	// Search every term regardless of the global --limit
+	terms, err := s.list(ctx, accountID, &ListTermsOptions{WorkflowState: []string{"all"}}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Term
+	for _, term := range terms {
+		if term.SISTermID == selector {
+			return &term, nil
+		}
+		if strings.EqualFold(term.Name, selector) {
+			matches = append(matches, term)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no term matches %q (use a term ID, SIS term ID or exact name)", selector)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, term := range matches {
+			ids[i] = strconv.FormatInt(term.ID, 10)
+		}
+		return nil, fmt.Errorf("%d terms are named %q (IDs: %s); use the term ID instead", len(matches), selector, strings.Join(ids, ", "))
+	}
+}
+
+// termOverridesBody builds the overrides request body keyed by enrollment type
+func termOverridesBody(overrides map[string]TermOverrideParams) map[string]interface{} {
+	body := make(map[string]interface{}, len(overrides))
+	for enrollmentType, override := range overrides {
+		dates := make(map[string]interface{})
+		if override.StartAt != "" {
+			dates["start_at"] = override.StartAt
+		}
+		if override.EndAt != "" {
+			dates["end_at"] = override.EndAt
+		}
+		body[enrollmentType] = dates
+	}
+	return body
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTermsService_List(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.URL.Path != "/api/v1/accounts/1/terms" {
+			t.Errorf("Expected path /api/v1/accounts/1/terms, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"enrollment_terms":[{"id":3,"name":"Spring 2027"}]}`))
+			return
+		}
+
+		if got := r.URL.Query()["workflow_state[]"]; len(got) != 1 || got[0] != "all" {
+			t.Errorf("Expected workflow_state[]=all, got %v", got)
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(`<%s/api/v1/accounts/1/terms?page=2>; rel="next"`, server.URL))
+		w.Write([]byte(`{"enrollment_terms":[{"id":1,"name":"Default Term"},{"id":2,"name":"Fall 2026","sis_term_id":"2026FA"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewTermsService(client)
+	terms, err := service.List(context.Background(), 1, &ListTermsOptions{WorkflowState: []string{"all"}})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if len(terms) != 3 {
+		t.Fatalf("Expected 3 terms across pages, got %d", len(terms))
+	}
+
+	if terms[1].SISTermID != "2026FA" {
+		t.Errorf("Expected SIS term ID '2026FA', got '%s'", terms[1].SISTermID)
+	}
+}
+
+func TestTermsService_CreateWithOverrides(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/accounts/1/terms" {
+			t.Errorf("Expected POST /api/v1/accounts/1/terms, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			EnrollmentTerm struct {
+				Name      string                       `json:"name"`
+				StartAt   string                       `json:"start_at"`
+				Overrides map[string]map[string]string `json:"overrides"`
+			} `json:"enrollment_term"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		if body.EnrollmentTerm.Name != "Fall 2026" {
+			t.Errorf("Expected name 'Fall 2026', got '%s'", body.EnrollmentTerm.Name)
+		}
+
+		teacher := body.EnrollmentTerm.Overrides["TeacherEnrollment"]
+		if teacher["start_at"] != "2026-08-10" {
+			t.Errorf("Expected teacher override start, got %v", teacher)
+		}
+		if _, ok := teacher["end_at"]; ok {
+			t.Errorf("Expected empty end_at to be omitted, got %v", teacher)
+		}
+
+		w.Write([]byte(`{"id":5,"name":"Fall 2026","overrides":{"TeacherEnrollment":{"start_at":"2026-08-10T00:00:00Z"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewTermsService(client)
+	term, err := service.Create(context.Background(), 1, &CreateTermParams{
+		Name:    "Fall 2026",
+		StartAt: "2026-08-24",
+		Overrides: map[string]TermOverrideParams{
+			"TeacherEnrollment": {StartAt: "2026-08-10"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if term.ID != 5 {
+		t.Errorf("Expected term ID 5, got %d", term.ID)
+	}
+}
+
+func TestTermsService_Resolve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/accounts":
+			handleVersionDetection(w)
+		case "/api/v1/accounts/1/terms/2026":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"message":"The specified resource does not exist."}]}`))
+		case "/api/v1/accounts/1/terms":
+			w.Write([]byte(`{"enrollment_terms":[
+				{"id":1,"name":"Fall 2026","sis_term_id":"2026FA"},
+				{"id":2,"name":"Spring","sis_term_id":"2026"},
+				{"id":3,"name":"Summer"},
+				{"id":4,"name":"summer"}
+			]}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewTermsService(client)
+	ctx := context.Background()
+
+	tests := []struct {
+		selector string
+		wantID   int64
+		wantErr  string
+	}{
+		{selector: "fall 2026", wantID: 1},
+		{selector: "2026FA", wantID: 1},
+		{selector: "2026", wantID: 2},
+		{selector: "Summer", wantErr: "IDs: 3, 4"},
+		{selector: "Winter", wantErr: "no term matches"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			term, err := service.Resolve(ctx, 1, tt.selector)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve failed: %v", err)
+			}
+			if term.ID != tt.wantID {
+				t.Errorf("Expected term ID %d, got %d", tt.wantID, term.ID)
+			}
+		})
+	}
+}
@@ -262,6 +262,7 @@ type Term struct {
 	GradingPeriodGroupID int64                   `json:"grading_period_group_id"`
 	SISTermID            string                  `json:"sis_term_id"`
 	SISImportID          int64                   `json:"sis_import_id"`
+	CourseCount          int                     `json:"course_count,omitempty"`
 	Overrides            map[string]TermOverride `json:"overrides,omitempty"`
 }
 
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUsageRightsService_SetCourse(t *testing.T) {
+	var body map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/courses/123/usage_rights" {
+			t.Errorf("Expected path /api/v1/courses/123/usage_rights, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"use_justification":"creative_commons","license":"cc_by","file_ids":[55,56]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewUsageRightsService(client)
+	rights, err := service.SetCourse(context.Background(), 123, &SetUsageRightsParams{
+		FileIDs:          []int64{55},
+		FolderIDs:        []int64{10},
+		UseJustification: "creative_commons",
+		License:          "cc_by",
+		Publish:          true,
+	})
+	if err != nil {
+		t.Fatalf("SetCourse failed: %v", err)
+	}
+
+	usage, _ := body["usage_rights"].(map[string]interface{})
+	if usage["use_justification"] != "creative_commons" || usage["license"] != "cc_by" {
+		t.Errorf("Unexpected usage rights: %v", body["usage_rights"])
+	}
+	if body["publish"] != true {
+		t.Errorf("Expected publish, got %v", body)
+	}
+	if len(rights.FileIDs) != 2 {
+		t.Errorf("Unexpected result: %+v", rights)
+	}
+}
+
+func TestUsageRightsService_RemoveCourse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts" {
+			handleVersionDetection(w)
+			return
+		}
+
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		query := r.URL.Query()
+		if query.Get("file_ids[]") != "55" || query.Get("folder_ids[]") != "10" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"message":"2 files updated","file_ids":[55,56]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		Token:          "test-token",
+		RequestsPerSec: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	service := NewUsageRightsService(client)
+	result, err := service.RemoveCourse(context.Background(), 123, []int64{55}, []int64{10})
+	if err != nil {
+		t.Fatalf("RemoveCourse failed: %v", err)
+	}
+
+	if len(result.FileIDs) != 2 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
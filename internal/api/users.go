@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -61,6 +62,23 @@ func (s *UsersService) Get(ctx context.Context, userID int64, include []string)
 
 // List retrieves users for an account
 func (s *UsersService) List(ctx context.Context, accountID int64, opts *ListUsersOptions) ([]User, error) {
+	path := accountUsersPath(accountID, opts)
+
+	var users []User
+	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
+		return nil, err
+	}
+
+	return NormalizeUsers(users), nil
+}
+
+// ListIter streams users for an account page by page
+func (s *UsersService) ListIter(ctx context.Context, accountID int64, opts *ListUsersOptions) iter.Seq2[User, error] {
+	return normalizeSeq(Pages[User](s.client, ctx, accountUsersPath(accountID, opts)), NormalizeUser)
+}
+
+// accountUsersPath builds the account users list path with query parameters
+func accountUsersPath(accountID int64, opts *ListUsersOptions) string {
 	path := fmt.Sprintf("/api/v1/accounts/%d/users", accountID)
 
 	if opts != nil {
@@ -95,12 +113,7 @@ func (s *UsersService) List(ctx context.Context, accountID int64, opts *ListUser
 		}
 	}
 
-	var users []User
-	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
-		return nil, err
-	}
-
-	return NormalizeUsers(users), nil
+	return path
 }
 
 // CreateUserParams holds parameters for creating a user
@@ -275,6 +288,23 @@ func (s *UsersService) Update(ctx context.Context, userID int64, params *UpdateU
 
 // ListCourseUsers retrieves users enrolled in a course
 func (s *UsersService) ListCourseUsers(ctx context.Context, courseID int64, opts *ListUsersOptions) ([]User, error) {
+	path := courseUsersPath(courseID, opts)
+
+	var users []User
+	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
+		return nil, err
+	}
+
+	return NormalizeUsers(users), nil
+}
+
+// ListCourseUsersIter streams users enrolled in a course page by page
+func (s *UsersService) ListCourseUsersIter(ctx context.Context, courseID int64, opts *ListUsersOptions) iter.Seq2[User, error] {
+	return normalizeSeq(Pages[User](s.client, ctx, courseUsersPath(courseID, opts)), NormalizeUser)
+}
+
+// courseUsersPath builds the course users list path with query parameters
+func courseUsersPath(courseID int64, opts *ListUsersOptions) string {
 	path := fmt.Sprintf("/api/v1/courses/%d/users", courseID)
 
 	if opts != nil {
@@ -309,12 +339,7 @@ func (s *UsersService) ListCourseUsers(ctx context.Context, courseID int64, opts
 		}
 	}
 
-	var users []User
-	if err := s.client.GetAllPages(ctx, path, &users); err != nil {
-		return nil, err
-	}
-
-	return NormalizeUsers(users), nil
+	return path
 }
 
 // Search searches for users across the entire Canvas instance
@@ -3,6 +3,7 @@ package cache
 import (
 	"encoding/json"
 	"errors"
+	"strings"
 	"sync"
 	"time"
 )
@@ -18,8 +19,10 @@ type Cache struct {
 
 // item represents a cached item with expiration
 type item struct {
-	value      []byte
-	expiration time.Time
+	value        []byte
+	expiration   time.Time
+	etag         string
+	lastModified string
 }
 
 // New creates a new cache with the specified default TTL
@@ -70,6 +73,29 @@ func (c *Cache) Get(key string) []byte {
 	return item.value
 }
 
+// GetEntry retrieves a value with its validators, including expired entries
+// that are still retained for revalidation
+func (c *Cache) GetEntry(key string) (*Entry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	item, exists := c.items[key]
+	if !exists {
+		return nil, false
+	}
+
+	if !retained(item.expiration, item.etag != "" || item.lastModified != "", time.Now()) {
+		return nil, false
+	}
+
+	return &Entry{
+		Value:        item.value,
+		ETag:         item.etag,
+		LastModified: item.lastModified,
+		Expiration:   item.expiration,
+	}, true
+}
+
 // GetJSON retrieves and unmarshals a JSON value from the cache
 func (c *Cache) GetJSON(key string, v interface{}) error {
 	data := c.Get(key)
@@ -107,6 +133,20 @@ func (c *Cache) SetWithTTL(key string, value []byte, ttl time.Duration) {
 	}
 }
 
+// SetWithValidators stores a value with the default TTL along with the
+// ETag and Last-Modified headers of the response it came from
+func (c *Cache) SetWithValidators(key string, value []byte, etag, lastModified string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items[key] = &item{
+		value:        value,
+		expiration:   time.Now().Add(c.ttl),
+		etag:         etag,
+		lastModified: lastModified,
+	}
+}
+
 // Delete removes a key from the cache
 func (c *Cache) Delete(key string) {
 	c.mu.Lock()
@@ -115,6 +155,18 @@ func (c *Cache) Delete(key string) {
 	delete(c.items, key)
 }
 
+// DeletePrefix removes every key starting with prefix
+func (c *Cache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.items, key)
+		}
+	}
+}
+
 // Clear removes all items from the cache
 func (c *Cache) Clear() {
 	c.mu.Lock()
@@ -163,7 +215,7 @@ func (c *Cache) removeExpired() {
 
 	now := time.Now()
 	for key, item := range c.items {
-		if now.After(item.expiration) {
+		if !retained(item.expiration, item.etag != "" || item.lastModified != "", now) {
 			delete(c.items, key)
 		}
 	}
@@ -65,6 +65,23 @@ func TestCache_Delete(t *testing.T) {
 	}
 }
 
+func TestCache_DeletePrefix(t *testing.T) {
+	cache := New(5 * time.Minute)
+
+	cache.Set("https://canvas.test/api/v1/courses/1/assignments", []byte("list"))
+	cache.Set("https://canvas.test/api/v1/courses/1/assignments/5", []byte("item"))
+	cache.Set("https://canvas.test/api/v1/courses/2/assignments", []byte("other"))
+
+	cache.DeletePrefix("https://canvas.test/api/v1/courses/1/assignments")
+
+	if cache.Has("https://canvas.test/api/v1/courses/1/assignments") || cache.Has("https://canvas.test/api/v1/courses/1/assignments/5") {
+		t.Error("expected keys under prefix to be deleted")
+	}
+	if !cache.Has("https://canvas.test/api/v1/courses/2/assignments") {
+		t.Error("expected key outside prefix to remain")
+	}
+}
+
 func TestCache_Clear(t *testing.T) {
 	cache := New(5 * time.Minute)
 
@@ -1,19 +1,32 @@
 package cache
 
 import (
+	"bufio"
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 )
 
+// indexFile lists the keys stored in a cache directory, one per line, so
+// DeletePrefix can find matching entries without decoding every cache file.
+// Lines are only appended by writers; stale and duplicate lines are dropped
+// when the index is compacted.
+const indexFile = "keys.index"
+
 // DiskCache represents a disk-based cache with TTL support
 type DiskCache struct {
 	dir string
 	ttl time.Duration
+
+	// indexMu serializes index appends and compaction within the process.
+	// Appends from other processes rely on O_APPEND writes being atomic.
+	indexMu sync.Mutex
 }
 
 // NewDiskCache creates a new disk-based cache
@@ -28,42 +41,73 @@ func NewDiskCache(dir string, ttl time.Duration) (*DiskCache, error) {
 		ttl: ttl,
 	}
 
+	// Build the key index the first time a directory is opened
+	if _, err := os.Stat(c.indexPath()); os.IsNotExist(err) {
+		if err := c.buildIndex(); err != nil {
+			return nil, fmt.Errorf("failed to index cache directory: %w", err)
+		}
+	}
+
 	// Clean up expired files on startup
 	go c.cleanup()
 
 	return c, nil
 }
 
-// diskItem represents a cached item stored on disk
+// diskItem represents a cached item stored on disk.
+// The key is stored alongside the value since file names are hashed.
 type diskItem struct {
-	Value      json.RawMessage `json:"value"`
-	Expiration time.Time       `json:"expiration"`
+	Key          string          `json:"key,omitempty"`
+	Value        json.RawMessage `json:"value"`
+	Expiration   time.Time       `json:"expiration"`
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"last_modified,omitempty"`
+}
+
+// hasValidators returns true if the item can be revalidated
+func (i *diskItem) hasValidators() bool {
+	return i.ETag != "" || i.LastModified != ""
 }
 
 // Get retrieves a value from the disk cache
 func (c *DiskCache) Get(key string) []byte {
+	entry, ok := c.GetEntry(key)
+	if !ok || entry.IsExpired() {
+		return nil
+	}
+
+	return entry.Value
+}
+
+// GetEntry retrieves a value with its validators, including expired entries
+// that are still retained for revalidation
+func (c *DiskCache) GetEntry(key string) (*Entry, bool) {
 	path := c.keyPath(key)
 
 	// Read the file
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil
+		return nil, false
 	}
 
 	// Unmarshal the item
 	var item diskItem
 	if err := json.Unmarshal(data, &item); err != nil {
-		return nil
+		return nil, false
 	}
 
-	// Check if expired
-	if time.Now().After(item.Expiration) {
-		// Delete expired file
+	// Delete expired files that can no longer be revalidated
+	if !retained(item.Expiration, item.hasValidators(), time.Now()) {
 		os.Remove(path)
-		return nil
+		return nil, false
 	}
 
-	return []byte(item.Value)
+	return &Entry{
+		Value:        []byte(item.Value),
+		ETag:         item.ETag,
+		LastModified: item.LastModified,
+		Expiration:   item.Expiration,
+	}, true
 }
 
 // GetJSON retrieves and unmarshals a JSON value from the disk cache
@@ -93,13 +137,31 @@ func (c *DiskCache) SetJSON(key string, v interface{}) error {
 
 // SetWithTTL stores a value in the disk cache with a custom TTL
 func (c *DiskCache) SetWithTTL(key string, value []byte, ttl time.Duration) error {
-	path := c.keyPath(key)
-
-	// Create the item
-	item := diskItem{
+	return c.write(diskItem{
+		Key:        key,
 		Value:      json.RawMessage(value),
 		Expiration: time.Now().Add(ttl),
-	}
+	})
+}
+
+// SetWithValidators stores a value with the default TTL along with the
+// ETag and Last-Modified headers of the response it came from
+func (c *DiskCache) SetWithValidators(key string, value []byte, etag, lastModified string) error {
+	return c.write(diskItem{
+		Key:          key,
+		Value:        json.RawMessage(value),
+		Expiration:   time.Now().Add(c.ttl),
+		ETag:         etag,
+		LastModified: lastModified,
+	})
+}
+
+// write stores an item in its key's cache file
+func (c *DiskCache) write(item diskItem) error {
+	path := c.keyPath(item.Key)
+
+	// Keys whose file already exists are in the index
+	_, statErr := os.Stat(path)
 
 	// Marshal the item
 	data, err := json.Marshal(item)
@@ -112,6 +174,10 @@ func (c *DiskCache) SetWithTTL(key string, value []byte, ttl time.Duration) erro
 		return fmt.Errorf("failed to write cache file: %w", err)
 	}
 
+	if os.IsNotExist(statErr) {
+		return c.appendIndex(item.Key)
+	}
+
 	return nil
 }
 
@@ -124,6 +190,164 @@ func (c *DiskCache) Delete(key string) error {
 	return nil
 }
 
+// DeletePrefix removes every key starting with prefix from the disk cache.
+// Matching keys are looked up in the index rather than by reading every file.
+func (c *DiskCache) DeletePrefix(prefix string) error {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+
+	keys, lines, err := c.readIndex()
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			remaining = append(remaining, key)
+			continue
+		}
+
+		if err := os.Remove(c.keyPath(key)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// Compact once most lines are duplicates or deleted keys. Keys appended
+	// by another process while compacting may be dropped from the index;
+	// they still expire with their TTL.
+	if lines > 2*len(remaining)+64 {
+		return c.writeIndex(remaining)
+	}
+
+	return nil
+}
+
+// readIndex returns the unique keys in the index and its total line count
+func (c *DiskCache) readIndex() ([]string, int, error) {
+	f, err := os.Open(c.indexPath())
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	var keys []string
+	seen := make(map[string]bool)
+	lines := 0
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines++
+		key := scanner.Text()
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	return keys, lines, scanner.Err()
+}
+
+// appendIndex adds a key to the index
+func (c *DiskCache) appendIndex(key string) error {
+	// Keys are stored one per line
+	if strings.ContainsAny(key, "\r\n") {
+		return nil
+	}
+
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+
+	f, err := os.OpenFile(c.indexPath(), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open cache index: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(key + "\n"); err != nil {
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+
+	return nil
+}
+
+// writeIndex replaces the index with keys
+func (c *DiskCache) writeIndex(keys []string) error {
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key)
+		b.WriteByte('\n')
+	}
+
+	tmp, err := os.CreateTemp(c.dir, indexFile+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(b.String()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), c.indexPath())
+}
+
+// buildIndex indexes the keys of existing cache files. Entries written
+// before keys were stored on disk cannot be matched by prefix, so they are
+// removed rather than left to serve stale data after a mutation.
+func (c *DiskCache) buildIndex() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		if !isCacheFile(entry) {
+			continue
+		}
+
+		path := filepath.Join(c.dir, entry.Name())
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		var item diskItem
+		if err := json.Unmarshal(data, &item); err != nil || item.Key == "" {
+			os.Remove(path)
+			continue
+		}
+
+		keys = append(keys, item.Key)
+	}
+
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+
+	return c.writeIndex(keys)
+}
+
+// indexPath returns the path of the key index
+func (c *DiskCache) indexPath() string {
+	return filepath.Join(c.dir, indexFile)
+}
+
+// isCacheFile reports whether a directory entry is a cache item
+func isCacheFile(entry os.DirEntry) bool {
+	return !entry.IsDir() && strings.HasSuffix(entry.Name(), ".cache")
+}
+
 // Clear removes all items from the disk cache
 func (c *DiskCache) Clear() error {
 	entries, err := os.ReadDir(c.dir)
@@ -165,7 +389,7 @@ func (c *DiskCache) removeExpired() {
 	now := time.Now()
 
 	for _, entry := range entries {
-		if entry.IsDir() {
+		if !isCacheFile(entry) {
 			continue
 		}
 
@@ -185,8 +409,8 @@ func (c *DiskCache) removeExpired() {
 			continue
 		}
 
-		// Delete if expired
-		if now.After(item.Expiration) {
+		// Delete if expired and no longer retained for revalidation
+		if !retained(item.Expiration, item.hasValidators(), now) {
 			os.Remove(path)
 		}
 	}
@@ -211,7 +435,7 @@ func (c *DiskCache) Stats() (Stats, error) {
 	var total, expired, active int
 
 	for _, entry := range entries {
-		if entry.IsDir() {
+		if !isCacheFile(entry) {
 			continue
 		}
 
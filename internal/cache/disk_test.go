@@ -3,6 +3,7 @@ package cache
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -178,6 +179,160 @@ func TestDiskCache_Delete(t *testing.T) {
 	}
 }
 
+func TestDiskCache_DeletePrefix(t *testing.T) {
+	tempDir := t.TempDir()
+	cache, err := NewDiskCache(tempDir, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+
+	for _, key := range []string{"/courses/1/assignments", "/courses/1/assignments/5", "/courses/2/assignments"} {
+		if err := cache.Set(key, []byte(`"value"`)); err != nil {
+			t.Fatalf("Set failed: %v", err)
+		}
+	}
+
+	if err := cache.DeletePrefix("/courses/1/"); err != nil {
+		t.Fatalf("DeletePrefix failed: %v", err)
+	}
+
+	if cache.Has("/courses/1/assignments") || cache.Has("/courses/1/assignments/5") {
+		t.Error("expected keys under prefix to be deleted")
+	}
+	if !cache.Has("/courses/2/assignments") {
+		t.Error("expected key outside prefix to remain")
+	}
+}
+
+func TestDiskCache_DeletePrefix_UsesIndex(t *testing.T) {
+	tempDir := t.TempDir()
+	cache, err := NewDiskCache(tempDir, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+
+	// Rewriting a key must not duplicate it in the index
+	for i := 0; i < 3; i++ {
+		cache.Set("/courses/1/assignments", []byte(`"value"`))
+	}
+	cache.Set("/courses/2/assignments", []byte(`"value"`))
+
+	keys, lines, err := cache.readIndex()
+	if err != nil {
+		t.Fatalf("readIndex failed: %v", err)
+	}
+	if len(keys) != 2 || lines != 2 {
+		t.Errorf("expected 2 indexed keys, got %v (%d lines)", keys, lines)
+	}
+
+	// A reopened cache finds the keys through the existing index
+	reopened, err := NewDiskCache(tempDir, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	if err := reopened.DeletePrefix("/courses/1"); err != nil {
+		t.Fatalf("DeletePrefix failed: %v", err)
+	}
+
+	if cache.Has("/courses/1/assignments") {
+		t.Error("expected indexed key to be deleted")
+	}
+	if !cache.Has("/courses/2/assignments") {
+		t.Error("expected key outside prefix to remain")
+	}
+}
+
+func TestDiskCache_DeletePrefix_CompactsIndex(t *testing.T) {
+	tempDir := t.TempDir()
+	cache, err := NewDiskCache(tempDir, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		cache.Set(fmt.Sprintf("/courses/1/assignments/%d", i), []byte(`"value"`))
+	}
+	cache.Set("/courses/2/assignments", []byte(`"value"`))
+
+	if err := cache.DeletePrefix("/courses/1/"); err != nil {
+		t.Fatalf("DeletePrefix failed: %v", err)
+	}
+
+	keys, lines, err := cache.readIndex()
+	if err != nil {
+		t.Fatalf("readIndex failed: %v", err)
+	}
+	if lines != 1 || len(keys) != 1 || keys[0] != "/courses/2/assignments" {
+		t.Errorf("expected compacted index, got %v (%d lines)", keys, lines)
+	}
+}
+
+func TestNewDiskCache_RemovesLegacyEntries(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Entries written before keys were stored on disk
+	legacy := filepath.Join(tempDir, "legacy.cache")
+	os.WriteFile(legacy, []byte(`{"value":"old","expiration":"2999-01-01T00:00:00Z"}`), 0600)
+
+	hash := md5.Sum([]byte("/courses/1"))
+	keyed := filepath.Join(tempDir, hex.EncodeToString(hash[:])+".cache")
+	os.WriteFile(keyed, []byte(`{"key":"/courses/1","value":"new","expiration":"2999-01-01T00:00:00Z"}`), 0600)
+
+	cache, err := NewDiskCache(tempDir, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Error("expected legacy entry to be removed")
+	}
+	if !cache.Has("/courses/1") {
+		t.Fatal("expected keyed entry to remain")
+	}
+
+	if err := cache.DeletePrefix("/courses/"); err != nil {
+		t.Fatalf("DeletePrefix failed: %v", err)
+	}
+	if cache.Has("/courses/1") {
+		t.Error("expected keyed entry to be indexed and deleted")
+	}
+}
+
+func TestDiskCache_SetWithValidators(t *testing.T) {
+	tempDir := t.TempDir()
+	cache, err := NewDiskCache(tempDir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+
+	if err := cache.SetWithValidators("course", []byte(`{"id":1}`), `"abc"`, "Wed, 21 Oct 2015 07:28:00 GMT"); err != nil {
+		t.Fatalf("SetWithValidators failed: %v", err)
+	}
+	if err := cache.Set("plain", []byte(`"value"`)); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Expired entries are no longer served by Get
+	if cache.Get("course") != nil {
+		t.Error("expected Get to miss for expired entry")
+	}
+
+	// But entries with validators are retained for revalidation
+	entry, ok := cache.GetEntry("course")
+	if !ok {
+		t.Fatal("expected expired entry with validators to be retained")
+	}
+	if !entry.IsExpired() || entry.ETag != `"abc"` || entry.LastModified == "" || string(entry.Value) != `{"id":1}` {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+
+	if _, ok := cache.GetEntry("plain"); ok {
+		t.Error("expected expired entry without validators to be dropped")
+	}
+}
+
 func TestDiskCache_Clear(t *testing.T) {
 	tempDir := t.TempDir()
 	cache, err := NewDiskCache(tempDir, 5*time.Minute)
@@ -5,14 +5,50 @@ import (
 	"time"
 )
 
+// StaleRetention is how long an expired entry with HTTP validators is kept
+// after expiring so it can be revalidated with a conditional request or
+// served while it is refreshed in the background
+const StaleRetention = 24 * time.Hour
+
+// Entry is a cached value together with the HTTP validators of the response
+// it came from. Unlike Get, GetEntry also returns expired entries that are
+// still within StaleRetention.
+type Entry struct {
+	Value        []byte
+	ETag         string
+	LastModified string
+	Expiration   time.Time
+}
+
+// IsExpired returns true if the entry is past its TTL
+func (e *Entry) IsExpired() bool {
+	return time.Now().After(e.Expiration)
+}
+
+// HasValidators returns true if the entry can be revalidated with a conditional request
+func (e *Entry) HasValidators() bool {
+	return e.ETag != "" || e.LastModified != ""
+}
+
+// retained reports whether an item expiring at expiration should still be kept
+func retained(expiration time.Time, hasValidators bool, now time.Time) bool {
+	if !now.After(expiration) {
+		return true
+	}
+	return hasValidators && now.Before(expiration.Add(StaleRetention))
+}
+
 // CacheInterface defines the interface for cache implementations
 type CacheInterface interface {
 	Get(key string) []byte
 	GetJSON(key string, v interface{}) error
+	GetEntry(key string) (*Entry, bool)
 	Set(key string, value []byte)
 	SetJSON(key string, v interface{}) error
 	SetWithTTL(key string, value []byte, ttl time.Duration)
+	SetWithValidators(key string, value []byte, etag, lastModified string)
 	Delete(key string)
+	DeletePrefix(prefix string)
 	Clear()
 	Has(key string) bool
 	Stats() Stats
@@ -64,6 +100,24 @@ func (c *MultiTierCache) GetJSON(key string, v interface{}) error {
 	return json.Unmarshal(data, v)
 }
 
+// GetEntry retrieves an entry with its validators (memory first, then disk)
+func (c *MultiTierCache) GetEntry(key string) (*Entry, bool) {
+	if entry, ok := c.memory.GetEntry(key); ok && !entry.IsExpired() {
+		return entry, true
+	}
+
+	entry, ok := c.disk.GetEntry(key)
+	if !ok {
+		// Fall back to a stale memory entry, if any
+		return c.memory.GetEntry(key)
+	}
+
+	if !entry.IsExpired() {
+		c.memory.SetWithValidators(key, entry.Value, entry.ETag, entry.LastModified)
+	}
+	return entry, true
+}
+
 // Set stores a value in both memory and disk caches
 func (c *MultiTierCache) Set(key string, value []byte) {
 	c.memory.Set(key, value)
@@ -87,12 +141,24 @@ func (c *MultiTierCache) SetWithTTL(key string, value []byte, ttl time.Duration)
 	_ = c.disk.SetWithTTL(key, value, ttl) // Best-effort disk cache
 }
 
+// SetWithValidators stores a value and its HTTP validators in both caches
+func (c *MultiTierCache) SetWithValidators(key string, value []byte, etag, lastModified string) {
+	c.memory.SetWithValidators(key, value, etag, lastModified)
+	_ = c.disk.SetWithValidators(key, value, etag, lastModified) // Best-effort disk cache
+}
+
 // Delete removes a key from both caches
 func (c *MultiTierCache) Delete(key string) {
 	c.memory.Delete(key)
 	_ = c.disk.Delete(key) // Best-effort disk cache
 }
 
+// DeletePrefix removes every key starting with prefix from both caches
+func (c *MultiTierCache) DeletePrefix(prefix string) {
+	c.memory.DeletePrefix(prefix)
+	_ = c.disk.DeletePrefix(prefix) // Best-effort disk cache
+}
+
 // Clear removes all items from both caches
 func (c *MultiTierCache) Clear() {
 	c.memory.Clear()
@@ -148,6 +148,53 @@ func TestMultiTierCache_Delete(t *testing.T) {
 	}
 }
 
+func TestMultiTierCache_DeletePrefix(t *testing.T) {
+	tempDir := t.TempDir()
+
+	multiCache, err := NewMultiTierCache(5*time.Minute, tempDir, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("NewMultiTierCache failed: %v", err)
+	}
+
+	multiCache.Set("/courses/1/pages", []byte(`"a"`))
+	multiCache.Set("/courses/2/pages", []byte(`"b"`))
+
+	multiCache.DeletePrefix("/courses/1")
+
+	if multiCache.Has("/courses/1/pages") {
+		t.Error("expected key under prefix to be deleted from both tiers")
+	}
+	if !multiCache.Has("/courses/2/pages") {
+		t.Error("expected key outside prefix to remain")
+	}
+}
+
+func TestMultiTierCache_GetEntry(t *testing.T) {
+	tempDir := t.TempDir()
+
+	multiCache, err := NewMultiTierCache(5*time.Minute, tempDir, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("NewMultiTierCache failed: %v", err)
+	}
+
+	multiCache.SetWithValidators("course", []byte(`{"id":1}`), `"etag"`, "")
+
+	// Drop the memory tier to force a disk read
+	multiCache.memory.Clear()
+
+	entry, ok := multiCache.GetEntry("course")
+	if !ok {
+		t.Fatal("expected entry from disk tier")
+	}
+	if entry.ETag != `"etag"` || !entry.HasValidators() {
+		t.Errorf("expected validators to round-trip, got %+v", entry)
+	}
+
+	if _, ok := multiCache.memory.GetEntry("course"); !ok {
+		t.Error("expected disk hit to populate memory tier")
+	}
+}
+
 func TestMultiTierCache_Clear(t *testing.T) {
 	tempDir := t.TempDir()
 
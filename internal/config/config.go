@@ -76,14 +76,17 @@ func (i *Instance) HasDefaultAccountID() bool {
 
 // Settings holds global application settings
 type Settings struct {
-	DefaultOutputFormat   string  `yaml:"default_output_format"`
-	RequestsPerSecond     float64 `yaml:"requests_per_second"`
-	CacheEnabled          bool    `yaml:"cache_enabled"`
-	CacheTTL              int     `yaml:"cache_ttl_minutes"`
-	TelemetryEnabled      bool    `yaml:"telemetry_enabled"`
-	LogLevel              string  `yaml:"log_level"`
-	AutoUpdateEnabled     bool    `yaml:"auto_update_enabled"`
-	AutoUpdateIntervalMin int     `yaml:"auto_update_interval_minutes"`
+	DefaultOutputFormat   string   `yaml:"default_output_format"`
+	RequestsPerSecond     float64  `yaml:"requests_per_second"`
+	SharedRateLimit       bool     `yaml:"shared_rate_limit"` // Share the rate limit across processes using the same instance
+	CacheEnabled          bool     `yaml:"cache_enabled"`
+	CacheTTL              int      `yaml:"cache_ttl_minutes"`
+	TelemetryEnabled      bool     `yaml:"telemetry_enabled"`
+	LogLevel              string   `yaml:"log_level"`
+	AutoUpdateEnabled     bool     `yaml:"auto_update_enabled"`
+	AutoUpdateIntervalMin int      `yaml:"auto_update_interval_minutes"`
+	RetryMethods          []string `yaml:"retry_methods,omitempty"`  // Methods retried after network/server errors (default: idempotent methods)
+	RetryStatuses         []int    `yaml:"retry_statuses,omitempty"` // Statuses that trigger a retry (default: 429, 500, 502, 503, 504)
 }
 
 // DefaultSettings returns the default settings
@@ -100,6 +103,15 @@ func DefaultSettings() *Settings {
 	}
 }
 
+// GetConfigDir returns the directory holding the config file and other state
+func GetConfigDir() (string, error) {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(configPath), nil
+}
+
 // GetConfigPath returns the path to the config file
 func GetConfigPath() (string, error) {
 	home, err := os.UserHomeDir()
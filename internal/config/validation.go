@@ -144,14 +144,15 @@ func ValidateSettings(settings *Settings) error {
 
 	// Validate output format
 	validFormats := map[string]bool{
-		"table": true,
-		"json":  true,
-		"yaml":  true,
-		"csv":   true,
+		"table":  true,
+		"json":   true,
+		"ndjson": true,
+		"yaml":   true,
+		"csv":    true,
 	}
 
 	if !validFormats[settings.DefaultOutputFormat] {
-		return fmt.Errorf("invalid output format: %q (must be one of: table, json, yaml, csv)", settings.DefaultOutputFormat)
+		return fmt.Errorf("invalid output format: %q (must be one of: table, json, ndjson, yaml, csv)", settings.DefaultOutputFormat)
 	}
 
 	// Validate requests per second
@@ -180,6 +181,29 @@ func ValidateSettings(settings *Settings) error {
 		return fmt.Errorf("invalid log level: %q (must be one of: debug, info, warn, error)", settings.LogLevel)
 	}
 
+	// Validate retry rules
+	validMethods := map[string]bool{
+		"GET":     true,
+		"HEAD":    true,
+		"OPTIONS": true,
+		"PUT":     true,
+		"DELETE":  true,
+		"POST":    true,
+		"PATCH":   true,
+	}
+
+	for _, method := range settings.RetryMethods {
+		if !validMethods[strings.ToUpper(method)] {
+			return fmt.Errorf("invalid retry method: %q (must be one of: GET, HEAD, OPTIONS, PUT, DELETE, POST, PATCH)", method)
+		}
+	}
+
+	for _, status := range settings.RetryStatuses {
+		if status < 100 || status > 599 {
+			return fmt.Errorf("invalid retry status: %d (must be between 100 and 599)", status)
+		}
+	}
+
 	return nil
 }
 
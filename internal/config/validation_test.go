@@ -460,6 +460,39 @@ func TestValidateSettings(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid log level",
 		},
+		{
+			name: "valid retry rules",
+			settings: &Settings{
+				DefaultOutputFormat: "table",
+				RequestsPerSecond:   10,
+				LogLevel:            "info",
+				RetryMethods:        []string{"get", "POST"},
+				RetryStatuses:       []int{429, 503},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid retry method",
+			settings: &Settings{
+				DefaultOutputFormat: "table",
+				RequestsPerSecond:   10,
+				LogLevel:            "info",
+				RetryMethods:        []string{"FETCH"},
+			},
+			wantErr: true,
+			errMsg:  "invalid retry method",
+		},
+		{
+			name: "invalid retry status",
+			settings: &Settings{
+				DefaultOutputFormat: "table",
+				RequestsPerSecond:   10,
+				LogLevel:            "info",
+				RetryStatuses:       []int{42},
+			},
+			wantErr: true,
+			errMsg:  "invalid retry status",
+		},
 	}
 
 	for _, tt := range tests {
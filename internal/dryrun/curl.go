@@ -1,10 +1,29 @@
 package dryrun
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
+// RedactedToken is the placeholder used in place of credentials
+const RedactedToken = "[REDACTED]"
+
+// sensitiveQueryParams are query parameters that carry credentials.
+// verifier is the file access token on Canvas download and preview URLs.
+var sensitiveQueryParams = []string{"access_token", "client_secret", "refresh_token", "code", "verifier"}
+
+// sensitiveBodyKeys are JSON body keys that carry credentials
+var sensitiveBodyKeys = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"access_token":  true,
+	"client_secret": true,
+	"refresh_token": true,
+}
+
 // Header represents an HTTP header key-value pair
 type Header struct {
 	Key   string
@@ -31,8 +50,8 @@ func GenerateCurl(opts CurlOptions) string {
 	for _, h := range opts.Headers {
 		value := h.Value
 		// Redact Authorization header if not showing token
-		if h.Key == "Authorization" && !opts.ShowToken {
-			value = "Bearer [REDACTED]"
+		if !opts.ShowToken {
+			value = RedactHeader(h.Key, value)
 		}
 		parts = append(parts, fmt.Sprintf("-H '%s: %s'", h.Key, escapeSingleQuotes(value)))
 	}
@@ -46,6 +65,88 @@ func GenerateCurl(opts CurlOptions) string {
 	return strings.Join(parts, " \\\n  ")
 }
 
+// RedactHeader returns the header value with credentials replaced by a placeholder.
+// Non-sensitive headers are returned unchanged.
+func RedactHeader(key, value string) string {
+	if strings.EqualFold(key, "Authorization") {
+		return "Bearer " + RedactedToken
+	}
+	return value
+}
+
+// RedactURL returns the URL with credential-bearing query parameters redacted
+func RedactURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.RawQuery == "" {
+		return rawURL
+	}
+
+	query := parsed.Query()
+	changed := false
+	for _, param := range sensitiveQueryParams {
+		if query.Has(param) {
+			query.Set(param, RedactedToken)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return rawURL
+	}
+
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+// RedactBody returns a JSON request body with credential-bearing keys
+// redacted at any depth. Bodies that are not JSON, or carry no credentials,
+// are returned unchanged.
+func RedactBody(body []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	if !redactValue(value) {
+		return body
+	}
+
+	redacted, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactValue redacts sensitive keys in a decoded JSON value in place and
+// reports whether anything was changed
+func redactValue(value interface{}) bool {
+	changed := false
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if sensitiveBodyKeys[strings.ToLower(key)] {
+				v[key] = RedactedToken
+				changed = true
+			} else if redactValue(child) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if redactValue(child) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
 // escapeURL escapes single quotes in URLs for shell safety
 func escapeURL(url string) string {
 	return escapeSingleQuotes(url)
@@ -230,3 +230,55 @@ func TestGenerateCurl_MultilineFormat(t *testing.T) {
 		t.Errorf("Expected multi-line format with continuation, got: %s", result)
 	}
 }
+
+func TestRedactHeader(t *testing.T) {
+	if got := RedactHeader("Authorization", "Bearer secret"); got != "Bearer [REDACTED]" {
+		t.Errorf("expected Authorization to be redacted, got %q", got)
+	}
+	if got := RedactHeader("authorization", "Bearer secret"); got != "Bearer [REDACTED]" {
+		t.Errorf("expected case-insensitive match, got %q", got)
+	}
+	if got := RedactHeader("Accept", "application/json"); got != "application/json" {
+		t.Errorf("expected Accept to be unchanged, got %q", got)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	got := RedactURL("/api/v1/courses?access_token=secret&per_page=10")
+	if strings.Contains(got, "secret") {
+		t.Errorf("expected access_token to be redacted, got %q", got)
+	}
+	if !strings.Contains(got, "per_page=10") {
+		t.Errorf("expected other params to be kept, got %q", got)
+	}
+
+	download := RedactURL("https://canvas.example.com/files/55/download?download_frd=1&verifier=file-secret")
+	if strings.Contains(download, "file-secret") {
+		t.Errorf("expected verifier to be redacted, got %q", download)
+	}
+	if !strings.Contains(download, "download_frd=1") {
+		t.Errorf("expected other params to be kept, got %q", download)
+	}
+
+	plain := "/api/v1/courses?per_page=10"
+	if got := RedactURL(plain); got != plain {
+		t.Errorf("expected URL without credentials to be unchanged, got %q", got)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	body := `{"user":{"name":"Jane"},"pseudonym":{"unique_id":"jane","password":"hunter2"},"tokens":[{"token":"abc"}],"points":1.50}`
+	got := string(RedactBody([]byte(body)))
+	if strings.Contains(got, "hunter2") || strings.Contains(got, "abc") {
+		t.Errorf("expected credentials to be redacted, got %s", got)
+	}
+	if !strings.Contains(got, `"unique_id":"jane"`) || !strings.Contains(got, `"points":1.50`) {
+		t.Errorf("expected other values to be kept, got %s", got)
+	}
+
+	for _, plain := range []string{`{"name":"Jane"}`, "name=Jane", ""} {
+		if got := string(RedactBody([]byte(plain))); got != plain {
+			t.Errorf("expected %q to be unchanged, got %q", plain, got)
+		}
+	}
+}
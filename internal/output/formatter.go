@@ -22,10 +22,11 @@ type Formatter interface {
 type FormatType string
 
 const (
-	FormatJSON  FormatType = "json"
-	FormatYAML  FormatType = "yaml"
-	FormatCSV   FormatType = "csv"
-	FormatTable FormatType = "table"
+	FormatJSON   FormatType = "json"
+	FormatNDJSON FormatType = "ndjson"
+	FormatYAML   FormatType = "yaml"
+	FormatCSV    FormatType = "csv"
+	FormatTable  FormatType = "table"
 )
 
 // NewFormatter creates a new formatter for the specified format type
@@ -38,6 +39,8 @@ func NewFormatterWithOptions(format FormatType, verbose bool) (Formatter, error)
 	switch format {
 	case FormatJSON:
 		return &JSONFormatter{}, nil
+	case FormatNDJSON:
+		return &NDJSONFormatter{}, nil
 	case FormatYAML:
 		return &YAMLFormatter{}, nil
 	case FormatCSV:
@@ -72,6 +75,44 @@ func (f *JSONFormatter) Format(data interface{}) (string, error) {
 	return string(bytes), nil
 }
 
+// NDJSONFormatter formats output as newline-delimited JSON (one object per line)
+type NDJSONFormatter struct{}
+
+// Format formats data as NDJSON. Slices produce one line per element.
+func (f *NDJSONFormatter) Format(data interface{}) (string, error) {
+	if data == nil {
+		return "", nil
+	}
+
+	var builder strings.Builder
+	writer := NewNDJSONWriter(&builder)
+	for _, item := range toSlice(data) {
+		if err := writer.Write(item); err != nil {
+			return "", err
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// NDJSONWriter streams items as newline-delimited JSON as they arrive
+type NDJSONWriter struct {
+	encoder *json.Encoder
+}
+
+// NewNDJSONWriter creates a writer that emits one JSON object per line to w
+func NewNDJSONWriter(w io.Writer) *NDJSONWriter {
+	return &NDJSONWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write encodes a single item followed by a newline
+func (w *NDJSONWriter) Write(item interface{}) error {
+	if err := w.encoder.Encode(item); err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	return nil
+}
+
 // YAMLFormatter formats output as YAML
 type YAMLFormatter struct{}
 
@@ -175,6 +216,44 @@ var keyFieldsMap = map[string][]string{
 	"Account": {"id", "name", "workflow_state", "parent_account_id", "root_account_id", "default_time_zone"},
 	// PageRevision fields
 	"PageRevision": {"revision_id", "updated_at", "title", "edited_by"},
+	// GradeChangeEvent fields - who changed which grade and how
+	"GradeChangeEvent": {"created_at", "event_type", "assignment_name", "student_name", "grader_name", "grade_before", "grade_after", "excused_after"},
+	// CourseEvent fields
+	"CourseEvent": {"created_at", "event_type", "event_source", "course_name", "user_name"},
+	// Login fields - the identifiers a user signs in and syncs with
+	"Login": {"id", "user_id", "unique_id", "sis_user_id", "integration_id", "authentication_provider_type", "workflow_state"},
+	// Feature fields - a feature and its flag state in the listed context
+	"Feature": {"feature", "display_name", "applies_to", "state", "locked", "beta"},
+	// FeatureFlag fields
+	"FeatureFlag": {"feature", "context_type", "context_id", "state", "locked"},
+	// FeatureDiff fields - flag states of the first and second context
+	"FeatureDiff": {"feature", "display_name", "state_a", "state_b"},
+	// GradingStandard fields
+	"GradingStandard": {"id", "title", "context_type", "context_id", "points_based"},
+	// AppointmentGroup fields
+	"AppointmentGroup": {"id", "title", "start_at", "end_at", "location_name", "workflow_state", "appointments_count", "participant_count"},
+	// ProvisionalGrade fields - one row per grader per student
+	"ProvisionalGrade": {"provisional_grade_id", "student_id", "scorer_id", "score", "grade", "final", "graded_at"},
+	// PostPolicy fields
+	"PostPolicy": {"course_id", "assignment_id", "post_manually", "inherited"},
+	// NewQuiz fields
+	"NewQuiz": {"id", "title", "points_possible", "due_at", "published"},
+	// NewQuizItem fields
+	"NewQuizItem": {"id", "position", "points_possible", "entry_type", "status"},
+	// CoursePace fields
+	"CoursePace": {"id", "course_section_id", "user_id", "workflow_state", "end_date", "hard_end_dates"},
+	// MediaObject fields
+	"MediaObject": {"media_id", "title", "media_type", "can_add_captions"},
+	// MediaTrack fields
+	"MediaTrack": {"id", "locale", "kind", "updated_at"},
+	// PairingCode fields
+	"PairingCode": {"user_id", "code", "expires_at", "workflow_state"},
+	// Tab fields
+	"Tab": {"id", "label", "position", "hidden", "type"},
+	// License fields
+	"License": {"id", "name", "url"},
+	// UsageRights fields
+	"UsageRights": {"use_justification", "legal_copyright", "license", "message"},
 }
 
 // Format formats data as a table
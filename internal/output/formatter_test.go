@@ -18,6 +18,7 @@ func TestNewFormatter(t *testing.T) {
 		shouldError bool
 	}{
 		{FormatJSON, false},
+		{FormatNDJSON, false},
 		{FormatYAML, false},
 		{FormatCSV, false},
 		{FormatTable, false},
@@ -91,6 +92,45 @@ func TestJSONFormatter_FormatSlice(t *testing.T) {
 	}
 }
 
+func TestNDJSONFormatter_Format(t *testing.T) {
+	formatter := &NDJSONFormatter{}
+
+	data := []TestStruct{
+		{Name: "John", Age: 30, Email: "john@example.com"},
+		{Name: "Jane", Age: 25, Email: "jane@example.com"},
+	}
+
+	output, err := formatter.Format(data)
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), output)
+	}
+
+	if lines[0] != `{"name":"John","age":30,"email":"john@example.com"}` {
+		t.Errorf("unexpected first line: %s", lines[0])
+	}
+}
+
+func TestNDJSONWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewNDJSONWriter(&buf)
+
+	if err := writer.Write(TestStruct{Name: "John"}); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if err := writer.Write(TestStruct{Name: "Jane"}); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	if got := strings.Count(buf.String(), "\n"); got != 2 {
+		t.Errorf("expected 2 newline-terminated records, got %d", got)
+	}
+}
+
 func TestYAMLFormatter_Format(t *testing.T) {
 	formatter := &YAMLFormatter{}
 
@@ -108,7 +108,7 @@ All commands support the following global flags:
 |------|-------------|
 | ` + "`--config`" + ` | Config file path (default: $HOME/.canvas-cli/config.yaml) |
 | ` + "`--instance`" + ` | Canvas instance URL (overrides config) |
-| ` + "`-o, --output`" + ` | Output format: table, json, yaml, csv |
+| ` + "`-o, --output`" + ` | Output format: table, json, ndjson, yaml, csv |
 | ` + "`-v, --verbose`" + ` | Enable verbose output |
 | ` + "`--as-user`" + ` | Masquerade as another user (admin feature) |
 | ` + "`--no-cache`" + ` | Disable caching of API responses |